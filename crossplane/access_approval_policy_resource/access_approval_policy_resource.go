@@ -169,7 +169,7 @@ func (r *accessApprovalPolicyResource) Create(ctx context.Context, req resource.
 		return
 	}
 
-	projectDetail, err := r.client.GetProjectById(infisical.GetProjectByIdRequest{
+	projectDetail, err := r.client.GetProjectById(ctx, infisical.GetProjectByIdRequest{
 		ID: plan.ProjectID.ValueString(),
 	})
 
@@ -203,7 +203,7 @@ func (r *accessApprovalPolicyResource) Create(ctx context.Context, req resource.
 		environments = append(environments, envSlugs...)
 	}
 
-	accessApprovalPolicy, err := r.client.CreateAccessApprovalPolicy(infisical.CreateAccessApprovalPolicyRequest{
+	accessApprovalPolicy, err := r.client.CreateAccessApprovalPolicy(ctx, infisical.CreateAccessApprovalPolicyRequest{
 		Name:                  plan.Name.ValueString(),
 		ProjectSlug:           projectDetail.Slug,
 		Environments:          environments,
@@ -257,21 +257,19 @@ func (r *accessApprovalPolicyResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
-	accessApprovalPolicy, err := r.client.GetAccessApprovalPolicyByID(infisical.GetAccessApprovalPolicyByIDRequest{
+	accessApprovalPolicy, err := r.client.GetAccessApprovalPolicyByID(ctx, infisical.GetAccessApprovalPolicyByIDRequest{
 		ID: state.ID.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
-			return
-		} else {
-			resp.Diagnostics.AddError(
-				"Error fetching access approval policy from your project",
-				"Couldn't read access approval policy from Infisical, unexpected error: "+err.Error(),
-			)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
+		resp.Diagnostics.AddError(
+			"Error fetching access approval policy from your project",
+			"Couldn't read access approval policy from Infisical, unexpected error: "+err.Error(),
+		)
+		return
 	}
 
 	policy := accessApprovalPolicy.AccessApprovalPolicy
@@ -428,7 +426,7 @@ func (r *accessApprovalPolicyResource) Update(ctx context.Context, req resource.
 
 	environments := infisicaltf.StringListToGoStringSlice(ctx, resp.Diagnostics, plan.EnvironmentSlugs)
 
-	_, err := r.client.UpdateAccessApprovalPolicy(infisical.UpdateAccessApprovalPolicyRequest{
+	_, err := r.client.UpdateAccessApprovalPolicy(ctx, infisical.UpdateAccessApprovalPolicyRequest{
 		ID:                    plan.ID.ValueString(),
 		Name:                  plan.Name.ValueString(),
 		SecretPath:            plan.SecretPath.ValueString(),
@@ -474,7 +472,7 @@ func (r *accessApprovalPolicyResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
-	_, err := r.client.DeleteAccessApprovalPolicy(infisical.DeleteAccessApprovalPolicyRequest{
+	_, err := r.client.DeleteAccessApprovalPolicy(ctx, infisical.DeleteAccessApprovalPolicyRequest{
 		ID: state.ID.ValueString(),
 	})
 
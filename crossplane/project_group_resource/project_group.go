@@ -7,6 +7,7 @@ import (
 	"net/url"
 	infisical "terraform-provider-infisical/internal/client"
 	pkg "terraform-provider-infisical/internal/pkg/modifiers"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -172,7 +173,7 @@ func (r *ProjectGroupResource) Create(ctx context.Context, req resource.CreateRe
 		request.GroupIdOrName = url.QueryEscape(plan.GroupName.ValueString())
 	}
 
-	projectGroupResponse, err := r.client.CreateProjectGroup(request)
+	projectGroupResponse, err := r.client.CreateProjectGroup(ctx, request)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -213,28 +214,26 @@ func (r *ProjectGroupResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Resolve group_id from group_name if not present (endpoint only accepts UUID)
-	groupID, errMsg := r.resolveGroupID(state.GroupID.ValueString(), state.GroupName.ValueString())
+	groupID, errMsg := r.resolveGroupID(ctx, state.GroupID.ValueString(), state.GroupName.ValueString())
 	if errMsg != "" {
 		resp.Diagnostics.AddError("Error resolving group by name", errMsg)
 		return
 	}
 
-	projectGroupMembership, err := r.client.GetProjectGroupMembership(infisical.GetProjectGroupMembershipRequest{
+	projectGroupMembership, err := r.client.GetProjectGroupMembership(ctx, infisical.GetProjectGroupMembershipRequest{
 		ProjectId: state.ProjectID.ValueString(),
 		GroupId:   groupID,
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
-			return
-		} else {
-			resp.Diagnostics.AddError(
-				"Error reading project group membership",
-				"Couldn't read project group membership from Infisical, unexpected error: "+err.Error(),
-			)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
+		resp.Diagnostics.AddError(
+			"Error reading project group membership",
+			"Couldn't read project group membership from Infisical, unexpected error: "+err.Error(),
+		)
+		return
 	}
 
 	planRoles := make([]ProjectGroupRole, 0, len(projectGroupMembership.Membership.Roles))
@@ -339,7 +338,7 @@ func (r *ProjectGroupResource) Update(ctx context.Context, req resource.UpdateRe
 		})
 	}
 
-	_, err = r.client.UpdateProjectGroup(infisical.UpdateProjectGroupRequest{
+	_, err = r.client.UpdateProjectGroup(ctx, infisical.UpdateProjectGroupRequest{
 		ProjectId: state.ProjectID.ValueString(),
 		GroupId:   state.GroupID.ValueString(),
 		Roles:     roles,
@@ -374,13 +373,13 @@ func (r *ProjectGroupResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
-	groupID, errMsg := r.resolveGroupID(state.GroupID.ValueString(), state.GroupName.ValueString())
+	groupID, errMsg := r.resolveGroupID(ctx, state.GroupID.ValueString(), state.GroupName.ValueString())
 	if errMsg != "" {
 		resp.Diagnostics.AddError("Error resolving group by name", errMsg)
 		return
 	}
 
-	_, err := r.client.DeleteProjectGroup(infisical.DeleteProjectGroupRequest{
+	_, err := r.client.DeleteProjectGroup(ctx, infisical.DeleteProjectGroupRequest{
 		ProjectId: state.ProjectID.ValueString(),
 		GroupId:   groupID,
 	})
@@ -395,15 +394,15 @@ func (r *ProjectGroupResource) Delete(ctx context.Context, req resource.DeleteRe
 
 // resolveGroupID returns the group UUID for the given state, looking up by name if group_id is not set.
 // Returns ("", error message) if the group cannot be resolved.
-func (r *ProjectGroupResource) resolveGroupID(groupID, groupName string) (string, string) {
+func (r *ProjectGroupResource) resolveGroupID(ctx context.Context, groupID, groupName string) (string, string) {
 	if groupID != "" {
 		return groupID, ""
 	}
-	groups, err := r.client.GetGroups()
+	groups, err := r.client.GetGroups(ctx, infisical.ListOptions{})
 	if err != nil {
 		return "", "Couldn't list groups to resolve group_name to group_id: " + err.Error()
 	}
-	for _, g := range groups {
+	for _, g := range groups.Items {
 		if g.Name == groupName {
 			return g.ID, ""
 		}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	infisical "terraform-provider-infisical/internal/client"
 	pkg "terraform-provider-infisical/internal/pkg/modifiers"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -143,7 +144,7 @@ func (r *ProjectIdentityResource) Create(ctx context.Context, req resource.Creat
 		})
 	}
 
-	_, err = r.client.CreateProjectIdentity(infisical.CreateProjectIdentityRequest{
+	_, err = r.client.CreateProjectIdentity(ctx, infisical.CreateProjectIdentityRequest{
 		ProjectID:  plan.ProjectID.ValueString(),
 		IdentityID: plan.IdentityID.ValueString(),
 		Roles:      roles,
@@ -157,7 +158,7 @@ func (r *ProjectIdentityResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	projectIdentityDetails, err := r.client.GetProjectIdentityByID(infisical.GetProjectIdentityByIDRequest{
+	projectIdentityDetails, err := r.client.GetProjectIdentityByID(ctx, infisical.GetProjectIdentityByIDRequest{
 		ProjectID:  plan.ProjectID.ValueString(),
 		IdentityID: plan.IdentityID.ValueString(),
 	})
@@ -200,14 +201,13 @@ func (r *ProjectIdentityResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	projectIdentityDetails, err := r.client.GetProjectIdentityByID(infisical.GetProjectIdentityByIDRequest{
+	projectIdentityDetails, err := r.client.GetProjectIdentityByID(ctx, infisical.GetProjectIdentityByIDRequest{
 		ProjectID:  state.ProjectID.ValueString(),
 		IdentityID: state.IdentityID.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
 
@@ -308,7 +308,7 @@ func (r *ProjectIdentityResource) Update(ctx context.Context, req resource.Updat
 		})
 	}
 
-	_, err = r.client.UpdateProjectIdentity(infisical.UpdateProjectIdentityRequest{
+	_, err = r.client.UpdateProjectIdentity(ctx, infisical.UpdateProjectIdentityRequest{
 		ProjectID:  plan.ProjectID.ValueString(),
 		IdentityID: plan.IdentityID.ValueString(),
 		Roles:      roles,
@@ -322,7 +322,7 @@ func (r *ProjectIdentityResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	projectIdentityDetails, err := r.client.GetProjectIdentityByID(infisical.GetProjectIdentityByIDRequest{
+	projectIdentityDetails, err := r.client.GetProjectIdentityByID(ctx, infisical.GetProjectIdentityByIDRequest{
 		ProjectID:  plan.ProjectID.ValueString(),
 		IdentityID: plan.IdentityID.ValueString(),
 	})
@@ -359,7 +359,7 @@ func (r *ProjectIdentityResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
-	_, err := r.client.DeleteProjectIdentity(infisical.DeleteProjectIdentityRequest{
+	_, err := r.client.DeleteProjectIdentity(ctx, infisical.DeleteProjectIdentityRequest{
 		ProjectID:  state.ProjectID.ValueString(),
 		IdentityID: state.IdentityID.ValueString(),
 	})
@@ -140,7 +140,7 @@ func (r *projectRoleResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	// Permissions V2
-	project, err := r.client.GetProject(infisical.GetProjectRequest{
+	project, err := r.client.GetProject(ctx, infisical.GetProjectRequest{
 		Slug: plan.ProjectSlug.ValueString(),
 	})
 
@@ -224,7 +224,7 @@ func (r *projectRoleResource) Create(ctx context.Context, req resource.CreateReq
 		permissions[i] = permMap
 	}
 
-	newProjectRole, err := r.client.CreateProjectRoleV2(infisical.CreateProjectRoleV2Request{
+	newProjectRole, err := r.client.CreateProjectRoleV2(ctx, infisical.CreateProjectRoleV2Request{
 		ProjectId:   project.ID,
 		Slug:        plan.Slug.ValueString(),
 		Name:        plan.Name.ValueString(),
@@ -267,7 +267,7 @@ func (r *projectRoleResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	project, err := r.client.GetProject(infisical.GetProjectRequest{
+	project, err := r.client.GetProject(ctx, infisical.GetProjectRequest{
 		Slug: state.ProjectSlug.ValueString(),
 	})
 
@@ -279,14 +279,13 @@ func (r *projectRoleResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	projectRole, err := r.client.GetProjectRoleBySlugV2(infisical.GetProjectRoleBySlugV2Request{
+	projectRole, err := r.client.GetProjectRoleBySlugV2(ctx, infisical.GetProjectRoleBySlugV2Request{
 		ProjectId: project.ID,
 		RoleSlug:  state.Slug.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
 		resp.Diagnostics.AddError(
@@ -385,7 +384,7 @@ func (r *projectRoleResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	project, err := r.client.GetProject(infisical.GetProjectRequest{
+	project, err := r.client.GetProject(ctx, infisical.GetProjectRequest{
 		Slug: plan.ProjectSlug.ValueString(),
 	})
 
@@ -468,7 +467,7 @@ func (r *projectRoleResource) Update(ctx context.Context, req resource.UpdateReq
 		permissions[i] = permMap
 	}
 
-	_, err = r.client.UpdateProjectRoleV2(infisical.UpdateProjectRoleV2Request{
+	_, err = r.client.UpdateProjectRoleV2(ctx, infisical.UpdateProjectRoleV2Request{
 		ProjectId:   project.ID,
 		RoleId:      plan.ID.ValueString(),
 		Slug:        plan.Slug.ValueString(),
@@ -510,7 +509,7 @@ func (r *projectRoleResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	_, err := r.client.DeleteProjectRole(infisical.DeleteProjectRoleRequest{
+	_, err := r.client.DeleteProjectRole(ctx, infisical.DeleteProjectRoleRequest{
 		ProjectSlug: state.ProjectSlug.ValueString(),
 		RoleId:      state.ID.ValueString(),
 	})
@@ -3,6 +3,7 @@ package resource
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
 	infisical "terraform-provider-infisical/internal/client"
@@ -212,7 +213,7 @@ func (r *ProjectTemplateResource) Create(ctx context.Context, req resource.Creat
 		}
 	}
 
-	res, err := r.client.CreateProjectTemplate(infisical.CreateProjectTemplateRequest{
+	res, err := r.client.CreateProjectTemplate(ctx, infisical.CreateProjectTemplateRequest{
 		Name:         plan.Name.ValueString(),
 		Description:  plan.Description.ValueString(),
 		Type:         plan.Type.ValueString(),
@@ -277,11 +278,10 @@ func (r *ProjectTemplateResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	// fetch the project template from Infisical
-	template, err := r.client.GetProjectTemplateById(state.ID.ValueString())
+	template, err := r.client.GetProjectTemplateById(ctx, state.ID.ValueString())
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
 		resp.Diagnostics.AddError(
@@ -408,7 +408,7 @@ func (r *ProjectTemplateResource) Update(ctx context.Context, req resource.Updat
 		}
 	}
 
-	apiResp, err := r.client.UpdateProjectTemplate(infisical.UpdateProjectTemplateRequest{
+	apiResp, err := r.client.UpdateProjectTemplate(ctx, infisical.UpdateProjectTemplateRequest{
 		ID:           plan.ID.ValueString(),
 		Name:         plan.Name.ValueString(),
 		Description:  plan.Description.ValueString(),
@@ -451,10 +451,10 @@ func (r *ProjectTemplateResource) Delete(ctx context.Context, req resource.Delet
 	}
 
 	// Call the Infisical API to delete the project template
-	_, err := r.client.DeleteProjectTemplate(state.ID.ValueString())
+	_, err := r.client.DeleteProjectTemplate(ctx, state.ID.ValueString())
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
+		if errors.Is(err, infisical.ErrNotFound) {
 			return
 		}
 
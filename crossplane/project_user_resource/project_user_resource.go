@@ -6,6 +6,7 @@ import (
 	"fmt"
 	infisical "terraform-provider-infisical/internal/client"
 	pkg "terraform-provider-infisical/internal/pkg/modifiers"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -141,7 +142,7 @@ func (r *ProjectUserResource) Create(ctx context.Context, req resource.CreateReq
 		})
 	}
 
-	invitedUser, err := r.client.InviteUsersToProject(infisical.InviteUsersToProjectRequest{
+	invitedUser, err := r.client.InviteUsersToProject(ctx, infisical.InviteUsersToProjectRequest{
 		ProjectID: plan.ProjectID.ValueString(),
 		Usernames: []string{plan.Username.ValueString()},
 	})
@@ -155,7 +156,7 @@ func (r *ProjectUserResource) Create(ctx context.Context, req resource.CreateReq
 
 	var membershipId string
 	if len(invitedUser) == 0 {
-		projectMember, err := r.client.GetProjectUserByUsername(infisical.GetProjectUserByUserNameRequest{
+		projectMember, err := r.client.GetProjectUserByUsername(ctx, infisical.GetProjectUserByUserNameRequest{
 			ProjectID: plan.ProjectID.ValueString(),
 			Username:  plan.Username.ValueString(),
 		})
@@ -173,7 +174,7 @@ func (r *ProjectUserResource) Create(ctx context.Context, req resource.CreateReq
 		membershipId = invitedUser[0].ID
 	}
 
-	_, err = r.client.UpdateProjectUser(infisical.UpdateProjectUserRequest{
+	_, err = r.client.UpdateProjectUser(ctx, infisical.UpdateProjectUserRequest{
 		ProjectID:    plan.ProjectID.ValueString(),
 		MembershipID: membershipId,
 		Roles:        roles,
@@ -186,7 +187,7 @@ func (r *ProjectUserResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	projectUserDetails, err := r.client.GetProjectUserByUsername(infisical.GetProjectUserByUserNameRequest{
+	projectUserDetails, err := r.client.GetProjectUserByUsername(ctx, infisical.GetProjectUserByUserNameRequest{
 		ProjectID: plan.ProjectID.ValueString(),
 		Username:  plan.Username.ValueString(),
 	})
@@ -230,14 +231,13 @@ func (r *ProjectUserResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	projectUserDetails, err := r.client.GetProjectUserByUsername(infisical.GetProjectUserByUserNameRequest{
+	projectUserDetails, err := r.client.GetProjectUserByUsername(ctx, infisical.GetProjectUserByUserNameRequest{
 		ProjectID: state.ProjectID.ValueString(),
 		Username:  state.Username.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
 
@@ -332,7 +332,7 @@ func (r *ProjectUserResource) Update(ctx context.Context, req resource.UpdateReq
 		})
 	}
 
-	_, err = r.client.UpdateProjectUser(infisical.UpdateProjectUserRequest{
+	_, err = r.client.UpdateProjectUser(ctx, infisical.UpdateProjectUserRequest{
 		ProjectID:    plan.ProjectID.ValueString(),
 		MembershipID: plan.MembershipId.ValueString(),
 		Roles:        roles,
@@ -346,7 +346,7 @@ func (r *ProjectUserResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	projectUserDetails, err := r.client.GetProjectUserByUsername(infisical.GetProjectUserByUserNameRequest{
+	projectUserDetails, err := r.client.GetProjectUserByUsername(ctx, infisical.GetProjectUserByUserNameRequest{
 		ProjectID: plan.ProjectID.ValueString(),
 		Username:  plan.Username.ValueString(),
 	})
@@ -384,7 +384,7 @@ func (r *ProjectUserResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	_, err := r.client.DeleteProjectUser(infisical.DeleteProjectUserRequest{
+	_, err := r.client.DeleteProjectUser(ctx, infisical.DeleteProjectUserRequest{
 		ProjectID: state.ProjectID.ValueString(),
 		Username:  []string{state.Username.ValueString()},
 	})
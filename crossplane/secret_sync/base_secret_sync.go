@@ -8,6 +8,7 @@ import (
 	"sort"
 	"strings"
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -15,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -50,6 +52,25 @@ type SecretSyncBaseResourceModel struct {
 	SyncOptions       types.Object `tfsdk:"sync_options"`
 	AutoSyncEnabled   types.Bool   `tfsdk:"auto_sync_enabled"`
 	DestinationConfig types.Object `tfsdk:"destination_config"`
+
+	Timeouts *infisicaltf.Timeouts `tfsdk:"timeouts"`
+}
+
+// apiFields maps the fields of the secret sync payload to the resource's attributes. The sync
+// options and the destination config are JSON strings, so their problems are reported against
+// the whole attribute.
+func (r *SecretSyncBaseResource) apiFields() infisicaltf.APIFields {
+	return infisicaltf.APIFields{
+		"name":              path.Root("name"),
+		"description":       path.Root("description"),
+		"projectId":         path.Root("project_id"),
+		"connectionId":      path.Root("connection_id"),
+		"environment":       path.Root("environment"),
+		"secretPath":        path.Root("secret_path"),
+		"isAutoSyncEnabled": path.Root("auto_sync_enabled"),
+		"syncOptions":       path.Root("sync_options"),
+		"destinationConfig": path.Root("destination_config"),
+	}
 }
 
 // Metadata returns the resource type name.
@@ -114,6 +135,9 @@ func (r *SecretSyncBaseResource) Schema(_ context.Context, _ resource.SchemaRequ
 				),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": infisicaltf.TimeoutsBlock(),
+		},
 	}
 }
 
@@ -137,6 +161,80 @@ func (r *SecretSyncBaseResource) Configure(_ context.Context, req resource.Confi
 	r.client = client
 }
 
+// ValidateConfig reports an invalid destination config when planning rather than on apply,
+// whatever the authentication method. A destination config that is not known yet is checked
+// again on apply.
+func (r *SecretSyncBaseResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var destConfigJSON types.String
+	if diags := req.Config.GetAttribute(ctx, path.Root("destination_config"), &destConfigJSON); diags.HasError() {
+		return
+	}
+	if destConfigJSON.IsNull() || destConfigJSON.IsUnknown() {
+		return
+	}
+
+	var tempDestConfigMap map[string]interface{}
+	if err := json.Unmarshal([]byte(destConfigJSON.ValueString()), &tempDestConfigMap); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("destination_config"), "Invalid JSON", fmt.Sprintf("Failed to parse destination_config: %s", err.Error()))
+		return
+	}
+
+	destConfigObj, diags := mapToTypesObject(tempDestConfigMap, r.DestinationConfigAttributes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	destConfigObj, diags = r.withDestinationConfigDefaults(ctx, destConfigObj)
+	if diags.HasError() {
+		return
+	}
+
+	tempConfig := SecretSyncBaseResourceModel{
+		DestinationConfig: destConfigObj,
+		SyncOptions:       types.ObjectNull(nil),
+	}
+
+	_, diags = r.ReadDestinationConfigForCreateFromPlan(ctx, tempConfig)
+	resp.Diagnostics.Append(diags...)
+}
+
+// withDestinationConfigDefaults fills in the destination config attributes left out of the
+// configuration with their defaults, as the plan of the non-Crossplane resource does.
+func (r *SecretSyncBaseResource) withDestinationConfigDefaults(ctx context.Context, destinationConfig types.Object) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	values := destinationConfig.Attributes()
+
+	for name, attribute := range r.DestinationConfigAttributes {
+		value, ok := values[name]
+		if !ok || !value.IsNull() {
+			continue
+		}
+
+		attributePath := path.Root("destination_config").AtName(name)
+		switch a := attribute.(type) {
+		case schema.StringAttribute:
+			if a.Default != nil {
+				var defaultResp defaults.StringResponse
+				a.Default.DefaultString(ctx, defaults.StringRequest{Path: attributePath}, &defaultResp)
+				diags.Append(defaultResp.Diagnostics...)
+				values[name] = defaultResp.PlanValue
+			}
+		case schema.BoolAttribute:
+			if a.Default != nil {
+				var defaultResp defaults.BoolResponse
+				a.Default.DefaultBool(ctx, defaults.BoolRequest{Path: attributePath}, &defaultResp)
+				diags.Append(defaultResp.Diagnostics...)
+				values[name] = defaultResp.PlanValue
+			}
+		}
+	}
+
+	withDefaults, objectDiags := types.ObjectValue(destinationConfig.AttributeTypes(ctx), values)
+	diags.Append(objectDiags...)
+	return withDefaults, diags
+}
+
 func (r *SecretSyncBaseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() || r.client == nil || !r.client.Config.IsMachineIdentityAuth {
 		return
@@ -186,7 +284,7 @@ func (r *SecretSyncBaseResource) ModifyPlan(ctx context.Context, req resource.Mo
 		ExcludeSyncId:     excludeSyncId,
 	}
 
-	duplicateCheck, err := r.client.CheckDuplicateDestination(checkRequest)
+	duplicateCheck, err := r.client.CheckDuplicateDestination(ctx, checkRequest)
 	if err != nil {
 		resp.Diagnostics.AddWarning(
 			"Unable to check for duplicate destinations",
@@ -241,9 +339,19 @@ func (r *SecretSyncBaseResource) Create(ctx context.Context, req resource.Create
 	var syncOptions map[string]interface{}
 	var destinationConfigMap map[string]interface{}
 
+	var timeouts *infisicaltf.Timeouts
+	diags := req.Plan.GetAttribute(ctx, path.Root("timeouts"), &timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeouts.CreateContext(ctx, infisicaltf.DefaultTimeout)
+	defer cancel()
+
 	// first we parse sync_options and destination_config from the plan
 	var syncOptsJSON types.String
-	diags := req.Plan.GetAttribute(ctx, path.Root("sync_options"), &syncOptsJSON)
+	diags = req.Plan.GetAttribute(ctx, path.Root("sync_options"), &syncOptsJSON)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -330,7 +438,7 @@ func (r *SecretSyncBaseResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	secretSync, err := r.client.CreateSecretSync(infisical.CreateSecretSyncRequest{
+	secretSync, err := r.client.CreateSecretSync(ctx, infisical.CreateSecretSyncRequest{
 		App:               r.App,
 		Name:              planName.ValueString(),
 		Description:       planDescription.ValueString(),
@@ -344,10 +452,7 @@ func (r *SecretSyncBaseResource) Create(ctx context.Context, req resource.Create
 	})
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating secret sync",
-			"Couldn't create secret sync, unexpected error: "+err.Error(),
-		)
+		infisicaltf.AddAPIError(&resp.Diagnostics, "Error creating secret sync", "Couldn't create secret sync, unexpected error: ", err, r.apiFields())
 		return
 	}
 
@@ -383,7 +488,7 @@ func (r *SecretSyncBaseResource) Create(ctx context.Context, req resource.Create
 	resp.State.SetAttribute(ctx, path.Root("environment"), plan.Environment)
 	resp.State.SetAttribute(ctx, path.Root("secret_path"), plan.SecretPath)
 	resp.State.SetAttribute(ctx, path.Root("auto_sync_enabled"), plan.AutoSyncEnabled)
-
+	resp.State.SetAttribute(ctx, path.Root("timeouts"), timeouts)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -403,6 +508,16 @@ func (r *SecretSyncBaseResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	var timeouts *infisicaltf.Timeouts
+	diags = req.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeouts.ReadContext(ctx, infisicaltf.DefaultTimeout)
+	defer cancel()
+
 	// Crossplane will attempt to call the Read() function even before the resource is created.
 	// When that happens, the state ID will be empty, which means it will try to call "api/v1/secret-syncs/${APP}/${stateId}" <- This will fail.
 	// It will fail because ${stateId} is empty. This makes it call the LIST secret syncs endpoint, which requires a project ID.
@@ -412,22 +527,20 @@ func (r *SecretSyncBaseResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	secretSync, err := r.client.GetSecretSyncById(infisical.GetSecretSyncByIdRequest{
+	secretSync, err := r.client.GetSecretSyncById(ctx, infisical.GetSecretSyncByIdRequest{
 		App: r.App,
 		ID:  stateID.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
-			return
-		} else {
-			resp.Diagnostics.AddError(
-				"Error reading secret sync",
-				"Couldn't read secret sync, unexpected error: "+err.Error(),
-			)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
+		resp.Diagnostics.AddError(
+			"Error reading secret sync",
+			"Couldn't read secret sync, unexpected error: "+err.Error(),
+		)
+		return
 	}
 
 	// get current state values to preserve structure
@@ -527,6 +640,16 @@ func (r *SecretSyncBaseResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	var timeouts *infisicaltf.Timeouts
+	diags = req.Plan.GetAttribute(ctx, path.Root("timeouts"), &timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeouts.UpdateContext(ctx, infisicaltf.DefaultTimeout)
+	defer cancel()
+
 	// parse sync_options and transform keys (snake to camel for API calls)
 	var syncOptsJSON types.String
 	diags = req.Plan.GetAttribute(ctx, path.Root("sync_options"), &syncOptsJSON)
@@ -629,7 +752,7 @@ func (r *SecretSyncBaseResource) Update(ctx context.Context, req resource.Update
 	req.Plan.GetAttribute(ctx, path.Root("secret_path"), &planSecretPath)
 	req.Plan.GetAttribute(ctx, path.Root("auto_sync_enabled"), &planAutoSyncEnabled)
 
-	_, err := r.client.UpdateSecretSync(infisical.UpdateSecretSyncRequest{
+	_, err := r.client.UpdateSecretSync(ctx, infisical.UpdateSecretSyncRequest{
 		App:               r.App,
 		ID:                stateID.ValueString(),
 		Name:              planName.ValueString(),
@@ -644,10 +767,7 @@ func (r *SecretSyncBaseResource) Update(ctx context.Context, req resource.Update
 	})
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating secret sync",
-			"Couldn't update secret sync, unexpected error: "+err.Error(),
-		)
+		infisicaltf.AddAPIError(&resp.Diagnostics, "Error updating secret sync", "Couldn't update secret sync, unexpected error: ", err, r.apiFields())
 		return
 	}
 
@@ -685,7 +805,17 @@ func (r *SecretSyncBaseResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
-	_, err := r.client.DeleteSecretSync(infisical.DeleteSecretSyncRequest{
+	var timeouts *infisicaltf.Timeouts
+	diags = req.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeouts.DeleteContext(ctx, infisicaltf.DefaultTimeout)
+	defer cancel()
+
+	_, err := r.client.DeleteSecretSync(ctx, infisical.DeleteSecretSyncRequest{
 		App: r.App,
 		ID:  stateID.ValueString(),
 	})
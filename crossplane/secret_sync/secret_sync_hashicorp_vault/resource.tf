@@ -0,0 +1,33 @@
+terraform {
+  required_providers {
+    infisical = {
+      # version = <latest version>
+      source = "infisical/infisical"
+    }
+  }
+}
+
+provider "infisical" {
+  host = "https://app.infisical.com" # Only required if using self hosted instance of Infisical, default is https://app.infisical.com
+  auth = {
+    universal = {
+      client_id     = "<machine-identity-client-id>"
+      client_secret = "<machine-identity-client-secret>"
+    }
+  }
+}
+
+resource "infisical_secret_sync_hashicorp_vault" "example" {
+  name          = "vault-secret-sync"
+  description   = "Keep Vault KV populated while migrating to Infisical"
+  project_id    = "<your-infisical-project-id>"
+  connection_id = "<app-connection-id>" # The ID of your HashiCorp Vault App Connection
+  environment   = "<env-slug>"
+  secret_path   = "<infisical-secret-path>"
+
+  auto_sync_enabled = true
+
+  sync_options = "{\"initial_sync_behavior\":\"import-prioritize-destination\",\"disable_secret_deletion\":true,\"key_schema\":\"{{secretKey}}\"}"
+
+  destination_config = "{\"mount\":\"secret\",\"path\":\"dev/my-app\"}"
+}
\ No newline at end of file
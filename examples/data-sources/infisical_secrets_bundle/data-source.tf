@@ -0,0 +1,43 @@
+terraform {
+  required_providers {
+    infisical = {
+      # version = <latest version>
+      source = "infisical/infisical"
+    }
+  }
+}
+
+provider "infisical" {
+  host = "https://app.infisical.com" # Only required if using self hosted instance of Infisical, default is https://app.infisical.com
+  auth = {
+    universal = {
+      client_id     = "<machine-identity-client-id>"
+      client_secret = "<machine-identity-client-secret>"
+    }
+  }
+}
+
+data "infisical_secrets_bundle" "app_env" {
+  env_slug     = "prod"
+  workspace_id = "<project id>"
+  folder_path  = "/app"
+  format       = "dotenv"
+}
+
+data "infisical_secrets_bundle" "app_config" {
+  env_slug     = "prod"
+  workspace_id = "<project id>"
+  folder_path  = "/app"
+  format       = "template"
+  template     = <<-EOT
+    {{- range . }}
+    export {{ .Key }}='{{ .Value }}'
+    {{- end }}
+    DATABASE_URL={{ with getSecretByName "<project id>" "prod" "/database" "DATABASE_URL" }}{{ .Value }}{{ end }}
+  EOT
+}
+
+resource "local_sensitive_file" "app_env" {
+  filename = "${path.module}/app.env"
+  content  = data.infisical_secrets_bundle.app_env.content
+}
@@ -0,0 +1,31 @@
+terraform {
+  required_providers {
+    infisical = {
+      # version = <latest version>
+      source = "infisical/infisical"
+    }
+  }
+}
+
+provider "infisical" {
+  host = "https://app.infisical.com" # Only required if using self hosted instance of Infisical, default is https://app.infisical.com
+  auth = {
+    universal = {
+      client_id     = "<machine-identity-client-id>"
+      client_secret = "<machine-identity-client-secret>"
+    }
+  }
+}
+
+ephemeral "infisical_secrets_bundle" "app_env" {
+  env_slug     = "prod"
+  workspace_id = "PROJECT_ID"
+  folder_path  = "/app"
+  format       = "json"
+}
+
+resource "aws_secretsmanager_secret_version" "app" {
+  secret_id                = "<secret-arn>"
+  secret_string_wo         = ephemeral.infisical_secrets_bundle.app_env.content
+  secret_string_wo_version = 1
+}
@@ -47,3 +47,48 @@ provider "infisical" {
 # Authenticate via AWS IAM Auth:
 # export INFISICAL_AUTH_METHOD="aws_iam"
 # export INFISICAL_MACHINE_IDENTITY_ID="<machine-identity-id>"
+#
+# Authenticate via GCP ID Token Auth (fetches the instance identity token from the GCP metadata service):
+# export INFISICAL_AUTH_METHOD="gcp_id_token"
+# export INFISICAL_MACHINE_IDENTITY_ID="<machine-identity-id>"
+#
+# Authenticate via GCP IAM Auth:
+# export INFISICAL_AUTH_METHOD="gcp_iam"
+# export INFISICAL_MACHINE_IDENTITY_ID="<machine-identity-id>"
+# # Optional, without it the JWT is signed as the instance's service account:
+# # export INFISICAL_GCP_IAM_SERVICE_ACCOUNT_KEY_FILE_PATH="<path-to-service-account-key-file>"
+#
+# Authenticate via Azure Auth (fetches the managed identity token from the Azure metadata service):
+# export INFISICAL_AUTH_METHOD="azure"
+# export INFISICAL_MACHINE_IDENTITY_ID="<machine-identity-id>"
+#
+# Authenticate via JWT Auth:
+# export INFISICAL_AUTH_METHOD="jwt"
+# export INFISICAL_MACHINE_IDENTITY_ID="<machine-identity-id>"
+# export INFISICAL_JWT_AUTH_TOKEN="<jwt>"
+# # Or read the JWT from a file:
+# # export INFISICAL_JWT_AUTH_TOKEN_PATH="<path-to-jwt>"
+#
+# Authenticate via TLS Certificate Auth:
+# export INFISICAL_AUTH_METHOD="tls_cert"
+# export INFISICAL_MACHINE_IDENTITY_ID="<machine-identity-id>"
+# export INFISICAL_TLS_CERT_AUTH_CERTIFICATE_PATH="<path-to-client-certificate>"
+# export INFISICAL_TLS_CERT_AUTH_PRIVATE_KEY_PATH="<path-to-client-private-key>"
+#
+# Authenticate via LDAP Auth:
+# export INFISICAL_AUTH_METHOD="ldap"
+# export INFISICAL_MACHINE_IDENTITY_ID="<machine-identity-id>"
+# export INFISICAL_LDAP_AUTH_USERNAME="<ldap-username>"
+# export INFISICAL_LDAP_AUTH_PASSWORD="<ldap-password>"
+#
+# Connect to a self-hosted instance behind an internal PKI and a corporate proxy:
+# export INFISICAL_HOST="https://infisical.internal"
+# export INFISICAL_CA_CERTIFICATE_PATH="<path-to-ca-bundle>"
+# export INFISICAL_PROXY_URL="http://proxy.internal:3128"
+# export INFISICAL_REQUEST_TIMEOUT="30s"
+# export INFISICAL_HEADERS="X-Gateway-Token=<gateway-token>"
+#
+# Trace the provider's API calls to an OpenTelemetry collector (spans join the trace of the
+# TRACEPARENT variable when the pipeline running Terraform sets it):
+# export OTEL_EXPORTER_OTLP_ENDPOINT="http://otel-collector.internal:4318"
+# export OTEL_EXPORTER_OTLP_HEADERS="authorization=Bearer <collector-token>"
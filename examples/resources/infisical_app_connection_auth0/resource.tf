@@ -0,0 +1,30 @@
+terraform {
+  required_providers {
+    infisical = {
+      # version = <latest version>
+      source = "infisical/infisical"
+    }
+  }
+}
+
+provider "infisical" {
+  host = "https://app.infisical.com" # Only required if using self hosted instance of Infisical, default is https://app.infisical.com
+  auth = {
+    universal = {
+      client_id     = "<machine-identity-client-id>"
+      client_secret = "<machine-identity-client-secret>"
+    }
+  }
+}
+
+resource "infisical_app_connection_auth0" "auth0-demo" {
+  name        = "auth0-demo"
+  description = "This is a demo Auth0 connection."
+  method      = "client-credentials"
+  credentials = {
+    domain        = "example.us.auth0.com"
+    client_id     = "<client-id>"
+    client_secret = "<client-secret>"
+    audience      = "https://example.us.auth0.com/api/v2/"
+  }
+}
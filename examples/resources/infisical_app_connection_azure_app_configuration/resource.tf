@@ -27,3 +27,13 @@ resource "infisical_app_connection_azure_app_configuration" "app_connection_azur
   }
   description = "I am a test Azure app configuration app connection using client credentials"
 }
+
+resource "infisical_app_connection_azure_app_configuration" "app_connection_azure_app_configuration_federated" {
+  name   = "app-connection-azure-app-configuration-federated"
+  method = "federated-credential"
+  credentials = {
+    tenant_id = "<azure-tenant-id>"
+    client_id = "<azure-client-id>" # An application with a federated identity credential trusting Infisical
+  }
+  description = "I am a test Azure app configuration app connection using a federated credential"
+}
@@ -27,3 +27,13 @@ resource "infisical_app_connection_azure_client_secrets" "app_connection_azure_c
   }
   description = "I am a test Azure app connection using client credentials"
 }
+
+resource "infisical_app_connection_azure_client_secrets" "app_connection_azure_client_secret_federated" {
+  name   = "app_connection_azure_client_secret_federated"
+  method = "federated-credential"
+  credentials = {
+    tenant_id = "<azure-tenant-id>"
+    client_id = "<azure-client-id>" # An application with a federated identity credential trusting Infisical
+  }
+  description = "I am a test Azure app connection using a federated credential"
+}
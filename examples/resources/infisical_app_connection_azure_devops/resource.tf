@@ -37,4 +37,15 @@ resource "infisical_app_connection_azure_devops" "app_connection_azure_devops_ac
     access_token      = "<azure-devops-access-token>"
   }
   description = "I am a test Azure DevOps app connection using access token"
-}
\ No newline at end of file
+}
+
+resource "infisical_app_connection_azure_devops" "app_connection_azure_devops_federated" {
+  name   = "app-connection-azure-devops-federated"
+  method = "federated-credential"
+  credentials = {
+    organization_name = "<azure-devops-organization-name>"
+    tenant_id         = "<azure-tenant-id>"
+    client_id         = "<azure-client-id>" # An application with a federated identity credential trusting Infisical
+  }
+  description = "I am a test Azure DevOps app connection using a federated credential"
+}
@@ -27,3 +27,13 @@ resource "infisical_app_connection_azure_key_vault" "app_connection_azure_key_va
   }
   description = "I am a test Azure key vault app connection using client credentials"
 }
+
+resource "infisical_app_connection_azure_key_vault" "app_connection_azure_key_vault_federated" {
+  name   = "app-connection-azure-key-vault-federated"
+  method = "federated-credential"
+  credentials = {
+    tenant_id = "<azure-tenant-id>"
+    client_id = "<azure-client-id>" # An application with a federated identity credential trusting Infisical
+  }
+  description = "I am a test Azure key vault app connection using a federated credential"
+}
@@ -25,3 +25,15 @@ resource "infisical_app_connection_gcp" "app-connection-gcp" {
   }
   description = "I am a test app connection"
 }
+
+resource "infisical_app_connection_gcp" "app-connection-gcp-federated" {
+  name   = "gcp-federated-app-connection"
+  method = "workload-identity-federation"
+  credentials = {
+    service_account_email         = "infisical-sync@my-project.iam.gserviceaccount.com"
+    project_number                = "<google-cloud-project-number>"
+    workload_identity_pool_id     = "<workload-identity-pool-id>"
+    workload_identity_provider_id = "<workload-identity-provider-id>"
+  }
+  description = "I am a test app connection using workload identity federation"
+}
@@ -28,3 +28,18 @@ resource "infisical_app_connection_github" "github_connection" {
     # host = "github.mycompany.com"  # Required when instance_type is "server"
   }
 }
+
+resource "infisical_app_connection_github" "github_app_connection" {
+  name        = "github-app-connection"
+  description = "GitHub connection authenticating as a GitHub App installation"
+  method      = "github-app"
+
+  credentials = {
+    app_id                 = "<github-app-id>"
+    installation_id        = "<github-app-installation-id>"
+    private_key_wo         = file("<path-to-github-app-private-key.pem>") # Write-only: never stored in state
+    private_key_wo_version = 1                                            # Increment to push a rotated private key
+    instance_type          = "server"
+    host                   = "github.mycompany.com"
+  }
+}
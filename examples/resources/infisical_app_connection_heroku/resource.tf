@@ -0,0 +1,28 @@
+terraform {
+  required_providers {
+    infisical = {
+      # version = <latest version>
+      source = "infisical/infisical"
+    }
+  }
+}
+
+provider "infisical" {
+  host = "https://app.infisical.com" # Only required if using self hosted instance of Infisical, default is https://app.infisical.com
+  auth = {
+    universal = {
+      client_id     = "<machine-identity-client-id>"
+      client_secret = "<machine-identity-client-secret>"
+    }
+  }
+}
+
+resource "infisical_app_connection_heroku" "example" {
+  name        = "heroku-connection"
+  description = "I am a test app connection"
+  method      = "auth-token"
+
+  credentials = {
+    auth_token = "<your-heroku-api-key>"
+  }
+}
\ No newline at end of file
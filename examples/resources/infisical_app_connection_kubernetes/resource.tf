@@ -0,0 +1,42 @@
+terraform {
+  required_providers {
+    infisical = {
+      # version = <latest version>
+      source = "infisical/infisical"
+    }
+  }
+}
+
+provider "infisical" {
+  host = "https://app.infisical.com" # Only required if using self hosted instance of Infisical, default is https://app.infisical.com
+  auth = {
+    universal = {
+      client_id     = "<machine-identity-client-id>"
+      client_secret = "<machine-identity-client-secret>"
+    }
+  }
+}
+
+resource "infisical_app_connection_kubernetes" "service_account_token" {
+  name        = "kubernetes-connection"
+  description = "I am a test app connection"
+  method      = "service-account-token"
+
+  credentials = {
+    url                   = "https://kubernetes.example.com:6443"
+    service_account_token = "<service-account-token>"
+    ssl_certificate       = "<api-server-ca-certificate>"
+  }
+}
+
+# Reach a private cluster through an Infisical gateway deployed next to it
+resource "infisical_app_connection_kubernetes" "kubeconfig" {
+  name        = "kubernetes-kubeconfig-connection"
+  description = "I am a test app connection"
+  method      = "kubeconfig"
+  gateway_id  = "<gateway-id>"
+
+  credentials = {
+    kubeconfig = file("<path-to-kubeconfig>")
+  }
+}
@@ -0,0 +1,31 @@
+terraform {
+  required_providers {
+    infisical = {
+      # version = <latest version>
+      source = "infisical/infisical"
+    }
+  }
+}
+
+provider "infisical" {
+  host = "https://app.infisical.com" # Only required if using self hosted instance of Infisical, default is https://app.infisical.com
+  auth = {
+    universal = {
+      client_id     = "<machine-identity-client-id>"
+      client_secret = "<machine-identity-client-secret>"
+    }
+  }
+}
+
+resource "infisical_app_connection_mongodb" "mongodb-demo" {
+  name        = "mongodb-demo"
+  description = "This is a demo MongoDB connection."
+  method      = "username-and-password"
+  credentials = {
+    host     = "mongodb.example.com"
+    port     = 27017
+    database = "admin"
+    username = "root"
+    password = "<password>"
+  }
+}
@@ -0,0 +1,28 @@
+terraform {
+  required_providers {
+    infisical = {
+      # version = <latest version>
+      source = "infisical/infisical"
+    }
+  }
+}
+
+provider "infisical" {
+  host = "https://app.infisical.com" # Only required if using self hosted instance of Infisical, default is https://app.infisical.com
+  auth = {
+    universal = {
+      client_id     = "<machine-identity-client-id>"
+      client_secret = "<machine-identity-client-secret>"
+    }
+  }
+}
+
+resource "infisical_app_connection_okta" "okta-demo" {
+  name        = "okta-demo"
+  description = "This is a demo Okta connection."
+  method      = "api-token"
+  credentials = {
+    instance_url = "https://example.okta.com"
+    api_token    = "<api-token>"
+  }
+}
@@ -0,0 +1,31 @@
+terraform {
+  required_providers {
+    infisical = {
+      # version = <latest version>
+      source = "infisical/infisical"
+    }
+  }
+}
+
+provider "infisical" {
+  host = "https://app.infisical.com" # Only required if using self hosted instance of Infisical, default is https://app.infisical.com
+  auth = {
+    universal = {
+      client_id     = "<machine-identity-client-id>"
+      client_secret = "<machine-identity-client-secret>"
+    }
+  }
+}
+
+resource "infisical_app_connection_redis" "redis-demo" {
+  name        = "redis-demo"
+  description = "This is a demo Redis connection."
+  method      = "username-and-password"
+  credentials = {
+    host        = "redis.example.com"
+    port        = 6379
+    username    = "default"
+    password    = "<password>"
+    ssl_enabled = false
+  }
+}
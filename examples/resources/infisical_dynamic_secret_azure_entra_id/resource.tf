@@ -0,0 +1,42 @@
+terraform {
+  required_providers {
+    infisical = {
+      # version = <latest version>
+      source = "infisical/infisical"
+    }
+  }
+}
+
+provider "infisical" {
+  host = "https://app.infisical.com" # Only required if using self hosted instance of Infisical, default is https://app.infisical.com
+  auth = {
+    universal = {
+      client_id     = "<machine-identity-client-id>"
+      client_secret = "<machine-identity-client-secret>"
+    }
+  }
+}
+
+resource "infisical_app_connection_azure_client_secrets" "azure" {
+  name   = "azure-client-secrets-connection"
+  method = "client-secret"
+  credentials = {
+    tenant_id     = "your-tenant-id"
+    client_id     = "your-client-id"
+    client_secret = "your-client-secret"
+  }
+}
+
+resource "infisical_dynamic_secret_azure_entra_id" "azure-entra-id" {
+  name             = "azure-entra-id-dynamic-secret-example"
+  project_slug     = "your-project-slug"
+  environment_slug = "dev"
+  path             = "/"
+  default_ttl      = "1h"
+  max_ttl          = "24h"
+
+  configuration = {
+    connection_id = infisical_app_connection_azure_client_secrets.azure.id
+    user_id       = "your-user-object-id"
+  }
+}
@@ -0,0 +1,39 @@
+terraform {
+  required_providers {
+    infisical = {
+      # version = <latest version>
+      source = "infisical/infisical"
+    }
+  }
+}
+
+provider "infisical" {
+  host = "https://app.infisical.com" # Only required if using self hosted instance of Infisical, default is https://app.infisical.com
+  auth = {
+    universal = {
+      client_id     = "<machine-identity-client-id>"
+      client_secret = "<machine-identity-client-secret>"
+    }
+  }
+}
+
+resource "infisical_dynamic_secret_cassandra" "cassandra" {
+  name             = "cassandra-dynamic-secret-example"
+  project_slug     = "your-project-slug"
+  environment_slug = "dev"
+  path             = "/"
+  default_ttl      = "1h"
+  max_ttl          = "24h"
+
+  configuration = {
+    host              = "cassandra-1.example.com,cassandra-2.example.com"
+    port              = 9042
+    local_data_center = "datacenter1"
+    keyspace          = "app"
+    username          = "cassandra"
+    password          = "your-password"
+
+    creation_statement   = "CREATE ROLE \"{{username}}\" WITH PASSWORD = '{{password}}' AND LOGIN = true; GRANT SELECT ON KEYSPACE app TO \"{{username}}\";"
+    revocation_statement = "DROP ROLE \"{{username}}\";"
+  }
+}
@@ -0,0 +1,40 @@
+terraform {
+  required_providers {
+    infisical = {
+      # version = <latest version>
+      source = "infisical/infisical"
+    }
+  }
+}
+
+provider "infisical" {
+  host = "https://app.infisical.com" # Only required if using self hosted instance of Infisical, default is https://app.infisical.com
+  auth = {
+    universal = {
+      client_id     = "<machine-identity-client-id>"
+      client_secret = "<machine-identity-client-secret>"
+    }
+  }
+}
+
+resource "infisical_app_connection_gcp" "gcp" {
+  name   = "gcp-connection"
+  method = "service-account-impersonation"
+  credentials = {
+    service_account_email = "infisical@your-project.iam.gserviceaccount.com"
+  }
+}
+
+resource "infisical_dynamic_secret_gcp_iam" "gcp-iam" {
+  name             = "gcp-iam-dynamic-secret-example"
+  project_slug     = "your-project-slug"
+  environment_slug = "dev"
+  path             = "/"
+  default_ttl      = "1h"
+  max_ttl          = "12h"
+
+  configuration = {
+    connection_id         = infisical_app_connection_gcp.gcp.id
+    service_account_email = "app@your-project.iam.gserviceaccount.com"
+  }
+}
@@ -0,0 +1,45 @@
+terraform {
+  required_providers {
+    infisical = {
+      # version = <latest version>
+      source = "infisical/infisical"
+    }
+  }
+}
+
+provider "infisical" {
+  host = "https://app.infisical.com" # Only required if using self hosted instance of Infisical, default is https://app.infisical.com
+  auth = {
+    universal = {
+      client_id     = "<machine-identity-client-id>"
+      client_secret = "<machine-identity-client-secret>"
+    }
+  }
+}
+
+resource "infisical_dynamic_secret_ldap" "ldap" {
+  name             = "ldap-dynamic-secret-example"
+  project_slug     = "your-project-slug"
+  environment_slug = "dev"
+  path             = "/"
+  default_ttl      = "1h"
+  max_ttl          = "24h"
+
+  configuration = {
+    connection_id = "your-ldap-app-connection-id"
+
+    creation_ldif = <<-EOT
+      dn: cn={{Username}},ou=users,dc=example,dc=com
+      objectClass: person
+      objectClass: top
+      cn: {{Username}}
+      sn: {{Username}}
+      userPassword: {{Password}}
+    EOT
+
+    revocation_ldif = <<-EOT
+      dn: cn={{Username}},ou=users,dc=example,dc=com
+      changetype: delete
+    EOT
+  }
+}
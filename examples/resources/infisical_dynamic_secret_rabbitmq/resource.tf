@@ -0,0 +1,42 @@
+terraform {
+  required_providers {
+    infisical = {
+      # version = <latest version>
+      source = "infisical/infisical"
+    }
+  }
+}
+
+provider "infisical" {
+  host = "https://app.infisical.com" # Only required if using self hosted instance of Infisical, default is https://app.infisical.com
+  auth = {
+    universal = {
+      client_id     = "<machine-identity-client-id>"
+      client_secret = "<machine-identity-client-secret>"
+    }
+  }
+}
+
+resource "infisical_dynamic_secret_rabbitmq" "rabbitmq" {
+  name             = "rabbitmq-dynamic-secret-example"
+  project_slug     = "your-project-slug"
+  environment_slug = "dev"
+  path             = "/"
+  default_ttl      = "1h"
+  max_ttl          = "24h"
+
+  configuration = {
+    host     = "https://rabbitmq.example.com"
+    port     = 15672
+    username = "admin"
+    password = "your-password"
+    tags     = ["management"]
+
+    virtual_host = {
+      name      = "/"
+      read      = ".*"
+      write     = ".*"
+      configure = ".*"
+    }
+  }
+}
@@ -0,0 +1,37 @@
+terraform {
+  required_providers {
+    infisical = {
+      # version = <latest version>
+      source = "infisical/infisical"
+    }
+  }
+}
+
+provider "infisical" {
+  host = "https://app.infisical.com" # Only required if using self hosted instance of Infisical, default is https://app.infisical.com
+  auth = {
+    universal = {
+      client_id     = "<machine-identity-client-id>"
+      client_secret = "<machine-identity-client-secret>"
+    }
+  }
+}
+
+resource "infisical_dynamic_secret_redis" "redis" {
+  name             = "redis-dynamic-secret-example"
+  project_slug     = "your-project-slug"
+  environment_slug = "dev"
+  path             = "/"
+  default_ttl      = "1h"
+  max_ttl          = "24h"
+
+  configuration = {
+    host     = "redis.example.com"
+    port     = 6379
+    username = "default"
+    password = "your-password"
+
+    creation_statement   = "ACL SETUSER {{username}} on >{{password}} ~* &* +@all"
+    revocation_statement = "ACL DELUSER {{username}}"
+  }
+}
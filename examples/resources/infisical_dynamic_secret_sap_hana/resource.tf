@@ -0,0 +1,37 @@
+terraform {
+  required_providers {
+    infisical = {
+      # version = <latest version>
+      source = "infisical/infisical"
+    }
+  }
+}
+
+provider "infisical" {
+  host = "https://app.infisical.com" # Only required if using self hosted instance of Infisical, default is https://app.infisical.com
+  auth = {
+    universal = {
+      client_id     = "<machine-identity-client-id>"
+      client_secret = "<machine-identity-client-secret>"
+    }
+  }
+}
+
+resource "infisical_dynamic_secret_sap_hana" "sap-hana" {
+  name             = "sap-hana-dynamic-secret-example"
+  project_slug     = "your-project-slug"
+  environment_slug = "dev"
+  path             = "/"
+  default_ttl      = "1h"
+  max_ttl          = "24h"
+
+  configuration = {
+    host     = "hana.example.com"
+    port     = 30015
+    username = "SYSTEM"
+    password = "your-password"
+
+    creation_statement   = "CREATE USER {{username}} PASSWORD \"{{password}}\" NO FORCE_FIRST_PASSWORD_CHANGE VALID UNTIL '{{expiration}}'; GRANT \"MONITORING\" TO {{username}};"
+    revocation_statement = "REVOKE \"MONITORING\" FROM {{username}}; DROP USER {{username}};"
+  }
+}
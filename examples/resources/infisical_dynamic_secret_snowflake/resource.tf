@@ -0,0 +1,46 @@
+terraform {
+  required_providers {
+    infisical = {
+      # version = <latest version>
+      source = "infisical/infisical"
+    }
+  }
+}
+
+provider "infisical" {
+  host = "https://app.infisical.com" # Only required if using self hosted instance of Infisical, default is https://app.infisical.com
+  auth = {
+    universal = {
+      client_id     = "<machine-identity-client-id>"
+      client_secret = "<machine-identity-client-secret>"
+    }
+  }
+}
+
+variable "snowflake_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "infisical_dynamic_secret_snowflake" "snowflake" {
+  name             = "snowflake-dynamic-secret-example"
+  project_slug     = "your-project-slug"
+  environment_slug = "dev"
+  path             = "/"
+  default_ttl      = "1h"
+  max_ttl          = "24h"
+
+  configuration = {
+    org_id     = "your-org-id"
+    account_id = "your-account-id"
+    username   = "infisical"
+
+    # Keeps the password out of the Terraform state. Requires Terraform 1.11+.
+    password_wo         = var.snowflake_password
+    password_wo_version = 1
+
+    creation_statement   = "CREATE USER {{username}} PASSWORD = '{{password}}' DEFAULT_ROLE = public DAYS_TO_EXPIRY = {{expiration}};"
+    revocation_statement = "DROP USER {{username}};"
+  }
+}
@@ -0,0 +1,36 @@
+terraform {
+  required_providers {
+    infisical = {
+      # version = <latest version>
+      source = "infisical/infisical"
+    }
+  }
+}
+
+provider "infisical" {
+  host = "https://app.infisical.com" # Only required if using self hosted instance of Infisical, default is https://app.infisical.com
+  auth = {
+    universal = {
+      client_id     = "<machine-identity-client-id>"
+      client_secret = "<machine-identity-client-secret>"
+    }
+  }
+}
+
+resource "infisical_secret_rotation_databricks_service_principal_secret" "databricks-service-principal-secret" {
+  name          = "databricks-service-principal-secret-secret-rotation-example"
+  project_id    = "<project-id>"
+  environment   = "<environment-slug>"
+  secret_path   = "<secret-path>" # Root folder is /
+  connection_id = "<app-connection-id>"
+
+  parameters = {
+    service_principal_id = "<service-principal-id>"
+    client_id            = "<service-principal-application-id>"
+  }
+
+  secrets_mapping = {
+    client_id     = "DATABRICKS_CLIENT_ID"
+    client_secret = "DATABRICKS_CLIENT_SECRET"
+  }
+}
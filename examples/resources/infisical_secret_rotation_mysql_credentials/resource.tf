@@ -34,3 +34,29 @@ resource "infisical_secret_rotation_mysql_credentials" "mysql-credentials" {
     password = "MYSQL_PASSWORD"
   }
 }
+
+# Changing rotate_trigger rotates the credentials immediately, e.g. after an incident.
+resource "infisical_secret_rotation_mysql_credentials" "mysql-credentials-rotate-now" {
+  name          = "mysql-credentials-rotate-now-example"
+  project_id    = "<project-id>"
+  environment   = "<environment-slug>"
+  secret_path   = "<secret-path>" # Root folder is /
+  connection_id = "<app-connection-id>"
+
+  parameters = {
+    username1 = "infisical_user3"
+    username2 = "infisical_user4"
+  }
+
+  secrets_mapping = {
+    username = "MYSQL_REPORTING_USERNAME"
+    password = "MYSQL_REPORTING_PASSWORD"
+  }
+
+  rotate_trigger    = "2026-10-19T08:00:00Z"
+  wait_for_rotation = true
+}
+
+output "mysql_rotation_status" {
+  value = infisical_secret_rotation_mysql_credentials.mysql-credentials.rotation_status
+}
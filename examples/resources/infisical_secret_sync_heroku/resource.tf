@@ -0,0 +1,39 @@
+terraform {
+  required_providers {
+    infisical = {
+      # version = <latest version>
+      source = "infisical/infisical"
+    }
+  }
+}
+
+provider "infisical" {
+  host = "https://app.infisical.com" # Only required if using self hosted instance of Infisical, default is https://app.infisical.com
+  auth = {
+    universal = {
+      client_id     = "<machine-identity-client-id>"
+      client_secret = "<machine-identity-client-secret>"
+    }
+  }
+}
+
+resource "infisical_secret_sync_heroku" "example" {
+  name          = "heroku-secret-sync"
+  description   = "Sync secrets to the config vars of a Heroku app"
+  project_id    = "<your-infisical-project-id>"
+  connection_id = "<app-connection-id>" # The ID of your Heroku App Connection
+  environment   = "<env-slug>"
+  secret_path   = "<infisical-secret-path>"
+
+  auto_sync_enabled = true
+
+  destination_config = {
+    app = "<heroku-app-name-or-id>"
+  }
+
+  sync_options = {
+    initial_sync_behavior   = "import-prioritize-destination"
+    disable_secret_deletion = false
+    key_schema              = "{{secretKey}}"
+  }
+}
\ No newline at end of file
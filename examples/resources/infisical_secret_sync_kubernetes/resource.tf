@@ -0,0 +1,47 @@
+terraform {
+  required_providers {
+    infisical = {
+      # version = <latest version>
+      source = "infisical/infisical"
+    }
+  }
+}
+
+provider "infisical" {
+  host = "https://app.infisical.com" # Only required if using self hosted instance of Infisical, default is https://app.infisical.com
+  auth = {
+    universal = {
+      client_id     = "<machine-identity-client-id>"
+      client_secret = "<machine-identity-client-secret>"
+    }
+  }
+}
+
+resource "infisical_secret_sync_kubernetes" "example" {
+  name          = "kubernetes-secret-sync"
+  description   = "Sync secrets to a Secret in the cluster"
+  project_id    = "<your-infisical-project-id>"
+  connection_id = "<app-connection-id>" # The ID of your Kubernetes App Connection
+  environment   = "<env-slug>"
+  secret_path   = "<infisical-secret-path>"
+
+  auto_sync_enabled = true
+
+  destination_config = {
+    namespace   = "my-app"
+    secret_name = "my-app-secrets"
+    secret_type = "Opaque"
+  }
+
+  sync_options = {
+    initial_sync_behavior   = "import-prioritize-destination"
+    disable_secret_deletion = false
+    key_schema              = "{{secretKey}}"
+    labels = {
+      "app.kubernetes.io/managed-by" = "infisical"
+    }
+    annotations = {
+      "reloader.stakater.com/match" = "true"
+    }
+  }
+}
@@ -0,0 +1,41 @@
+terraform {
+  required_providers {
+    infisical = {
+      # version = <latest version>
+      source = "infisical/infisical"
+    }
+  }
+}
+
+provider "infisical" {
+  host = "https://app.infisical.com" # Only required if using self hosted instance of Infisical, default is https://app.infisical.com
+  auth = {
+    universal = {
+      client_id     = "<machine-identity-client-id>"
+      client_secret = "<machine-identity-client-secret>"
+    }
+  }
+}
+
+resource "infisical_secret_sync_netlify" "example" {
+  name          = "netlify-secret-sync"
+  description   = "Sync secrets to the production deploys of a Netlify site"
+  project_id    = "<your-infisical-project-id>"
+  connection_id = "<app-connection-id>" # The ID of your Netlify App Connection
+  environment   = "<env-slug>"
+  secret_path   = "<infisical-secret-path>"
+
+  auto_sync_enabled = true
+
+  destination_config = {
+    account_id = "<netlify-account-id>"
+    site_id    = "<netlify-site-id>" # Optional, sync to the account's shared variables when unset
+    context    = "production"
+  }
+
+  sync_options = {
+    initial_sync_behavior   = "overwrite-destination"
+    disable_secret_deletion = false
+    key_schema              = "{{secretKey}}"
+  }
+}
\ No newline at end of file
@@ -0,0 +1,66 @@
+terraform {
+  required_providers {
+    infisical = {
+      # version = <latest version>
+      source = "infisical/infisical"
+    }
+  }
+}
+
+provider "infisical" {
+  host = "https://app.infisical.com" # Only required if using self hosted instance of Infisical, default is https://app.infisical.com
+  auth = {
+    universal = {
+      client_id     = "<machine-identity-client-id>"
+      client_secret = "<machine-identity-client-secret>"
+    }
+  }
+}
+
+resource "infisical_secret_sync_terraform_cloud" "workspace" {
+  name          = "terraform-cloud-workspace-sync"
+  description   = "Sync secrets to the environment variables of a Terraform Cloud workspace"
+  project_id    = "<your-infisical-project-id>"
+  connection_id = "<app-connection-id>" # The ID of your Terraform Cloud App Connection
+  environment   = "<env-slug>"
+  secret_path   = "<infisical-secret-path>"
+
+  auto_sync_enabled = true
+
+  destination_config = {
+    scope             = "workspace"
+    organization      = "<terraform-cloud-organization>"
+    workspace_id      = "<workspace-id>"
+    variable_category = "env"
+  }
+
+  sync_options = {
+    initial_sync_behavior   = "overwrite-destination"
+    disable_secret_deletion = false
+    key_schema              = "{{secretKey}}"
+    sensitive               = true
+  }
+}
+
+resource "infisical_secret_sync_terraform_cloud" "variable_set" {
+  name          = "terraform-cloud-variable-set-sync"
+  description   = "Sync secrets to the Terraform variables of a Terraform Cloud variable set"
+  project_id    = "<your-infisical-project-id>"
+  connection_id = "<app-connection-id>" # The ID of your Terraform Cloud App Connection
+  environment   = "<env-slug>"
+  secret_path   = "<infisical-secret-path>"
+
+  auto_sync_enabled = true
+
+  destination_config = {
+    scope             = "variable-set"
+    organization      = "<terraform-cloud-organization>"
+    variable_set_id   = "<variable-set-id>"
+    variable_category = "terraform"
+  }
+
+  sync_options = {
+    initial_sync_behavior   = "overwrite-destination"
+    disable_secret_deletion = false
+  }
+}
@@ -0,0 +1,42 @@
+terraform {
+  required_providers {
+    infisical = {
+      # version = <latest version>
+      source = "infisical/infisical"
+    }
+  }
+}
+
+provider "infisical" {
+  host = "https://app.infisical.com" # Only required if using self hosted instance of Infisical, default is https://app.infisical.com
+  auth = {
+    universal = {
+      client_id     = "<machine-identity-client-id>"
+      client_secret = "<machine-identity-client-secret>"
+    }
+  }
+}
+
+resource "infisical_secret_sync_vercel" "example" {
+  name          = "vercel-secret-sync"
+  description   = "Sync secrets to the preview deployments of a Vercel project"
+  project_id    = "<your-infisical-project-id>"
+  connection_id = "<app-connection-id>" # The ID of your Vercel App Connection
+  environment   = "<env-slug>"
+  secret_path   = "<infisical-secret-path>"
+
+  auto_sync_enabled = true
+
+  destination_config = {
+    team_id        = "<vercel-team-id>"
+    project_id     = "<vercel-project-id>"
+    environment    = "preview"
+    preview_branch = "staging" # Optional, only for the preview environment
+  }
+
+  sync_options = {
+    initial_sync_behavior   = "overwrite-destination"
+    disable_secret_deletion = false
+    key_schema              = "{{secretKey}}"
+  }
+}
\ No newline at end of file
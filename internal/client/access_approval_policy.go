@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"terraform-provider-infisical/internal/errors"
@@ -13,11 +14,11 @@ const (
 	operationCallDeleteAccessApprovalPolicy = "CallDeleteAccessApprovalPolicy"
 )
 
-func (client Client) CreateAccessApprovalPolicy(request CreateAccessApprovalPolicyRequest) (CreateAccessApprovalPolicyResponse, error) {
+func (client Client) CreateAccessApprovalPolicy(ctx context.Context, request CreateAccessApprovalPolicyRequest) (CreateAccessApprovalPolicyResponse, error) {
 
 	var body CreateAccessApprovalPolicyResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -34,11 +35,11 @@ func (client Client) CreateAccessApprovalPolicy(request CreateAccessApprovalPoli
 	return body, nil
 }
 
-func (client Client) GetAccessApprovalPolicyByID(request GetAccessApprovalPolicyByIDRequest) (GetAccessApprovalPolicyByIDResponse, error) {
+func (client Client) GetAccessApprovalPolicyByID(ctx context.Context, request GetAccessApprovalPolicyByIDRequest) (GetAccessApprovalPolicyByIDResponse, error) {
 	var body GetAccessApprovalPolicyByIDResponse
 
 	httpRequest := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT)
 
@@ -59,10 +60,10 @@ func (client Client) GetAccessApprovalPolicyByID(request GetAccessApprovalPolicy
 	return body, nil
 }
 
-func (client Client) UpdateAccessApprovalPolicy(request UpdateAccessApprovalPolicyRequest) (UpdateAccessApprovalPolicyResponse, error) {
+func (client Client) UpdateAccessApprovalPolicy(ctx context.Context, request UpdateAccessApprovalPolicyRequest) (UpdateAccessApprovalPolicyResponse, error) {
 	var body UpdateAccessApprovalPolicyResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -79,10 +80,10 @@ func (client Client) UpdateAccessApprovalPolicy(request UpdateAccessApprovalPoli
 	return body, nil
 }
 
-func (client Client) DeleteAccessApprovalPolicy(request DeleteAccessApprovalPolicyRequest) (DeleteAccessApprovalPolicyResponse, error) {
+func (client Client) DeleteAccessApprovalPolicy(ctx context.Context, request DeleteAccessApprovalPolicyRequest) (DeleteAccessApprovalPolicyResponse, error) {
 	var responseData DeleteAccessApprovalPolicyResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&responseData).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"terraform-provider-infisical/internal/errors"
@@ -27,8 +28,8 @@ func (e *AlertAlreadyExistsError) Unwrap() error {
 	return e.apiError
 }
 
-func (client Client) existingAlertForEvent(request CreateAlertRequest) *Alert {
-	alerts, err := client.ListAlerts(ListAlertsRequest{
+func (client Client) existingAlertForEvent(ctx context.Context, request CreateAlertRequest) *Alert {
+	alerts, err := client.ListAlerts(ctx, ListAlertsRequest{
 		ResourceType: request.ResourceType,
 		ResourceID:   request.ResourceID,
 		ProjectID:    request.ProjectID,
@@ -46,10 +47,10 @@ func (client Client) existingAlertForEvent(request CreateAlertRequest) *Alert {
 	return nil
 }
 
-func (client Client) CreateAlert(request CreateAlertRequest) (CreateAlertResponse, error) {
+func (client Client) CreateAlert(ctx context.Context, request CreateAlertRequest) (CreateAlertResponse, error) {
 	var body CreateAlertResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -62,7 +63,7 @@ func (client Client) CreateAlert(request CreateAlertRequest) (CreateAlertRespons
 	if response.IsError() {
 		apiError := errors.NewAPIErrorWithResponse(operationCreateAlert, response, nil)
 		if response.StatusCode() == http.StatusBadRequest {
-			if existing := client.existingAlertForEvent(request); existing != nil {
+			if existing := client.existingAlertForEvent(ctx, request); existing != nil {
 				return CreateAlertResponse{}, &AlertAlreadyExistsError{ExistingAlertID: existing.ID, apiError: apiError}
 			}
 		}
@@ -72,10 +73,10 @@ func (client Client) CreateAlert(request CreateAlertRequest) (CreateAlertRespons
 	return body, nil
 }
 
-func (client Client) ListAlerts(request ListAlertsRequest) (ListAlertsResponse, error) {
+func (client Client) ListAlerts(ctx context.Context, request ListAlertsRequest) (ListAlertsResponse, error) {
 	var body ListAlertsResponse
 	httpRequest := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetQueryParam("resourceType", request.ResourceType)
@@ -100,10 +101,10 @@ func (client Client) ListAlerts(request ListAlertsRequest) (ListAlertsResponse,
 	return body, nil
 }
 
-func (client Client) GetAlertByID(request GetAlertByIDRequest) (GetAlertByIDResponse, error) {
+func (client Client) GetAlertByID(ctx context.Context, request GetAlertByIDRequest) (GetAlertByIDResponse, error) {
 	var body GetAlertByIDResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		Get(fmt.Sprintf("api/v1/alerts/%s", request.ID))
@@ -122,10 +123,10 @@ func (client Client) GetAlertByID(request GetAlertByIDRequest) (GetAlertByIDResp
 	return body, nil
 }
 
-func (client Client) UpdateAlert(request UpdateAlertRequest) (UpdateAlertResponse, error) {
+func (client Client) UpdateAlert(ctx context.Context, request UpdateAlertRequest) (UpdateAlertResponse, error) {
 	var body UpdateAlertResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -145,10 +146,10 @@ func (client Client) UpdateAlert(request UpdateAlertRequest) (UpdateAlertRespons
 	return body, nil
 }
 
-func (client Client) DeleteAlert(request DeleteAlertRequest) (DeleteAlertResponse, error) {
+func (client Client) DeleteAlert(ctx context.Context, request DeleteAlertRequest) (DeleteAlertResponse, error) {
 	var body DeleteAlertResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		Delete(fmt.Sprintf("api/v1/alerts/%s", request.ID))
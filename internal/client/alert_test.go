@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -71,7 +72,7 @@ func TestCreateAlertReportsADuplicate(t *testing.T) {
 	))
 	defer done()
 
-	_, err := client.CreateAlert(duplicateAlertRequest)
+	_, err := client.CreateAlert(context.Background(), duplicateAlertRequest)
 
 	var alreadyExists *AlertAlreadyExistsError
 	if !errors.As(err, &alreadyExists) {
@@ -96,7 +97,7 @@ func TestCreateAlertReportsADuplicateWhateverTheMessageSays(t *testing.T) {
 	))
 	defer done()
 
-	_, err := client.CreateAlert(duplicateAlertRequest)
+	_, err := client.CreateAlert(context.Background(), duplicateAlertRequest)
 
 	var alreadyExists *AlertAlreadyExistsError
 	if !errors.As(err, &alreadyExists) {
@@ -119,7 +120,7 @@ func TestCreateAlertLeavesOtherBadRequestsAlone(t *testing.T) {
 			))
 			defer done()
 
-			_, err := client.CreateAlert(duplicateAlertRequest)
+			_, err := client.CreateAlert(context.Background(), duplicateAlertRequest)
 			if err == nil {
 				t.Fatal("CreateAlert() error = nil, want one")
 			}
@@ -144,7 +145,7 @@ func TestCreateAlertOnAForbiddenRequest(t *testing.T) {
 	})
 	defer done()
 
-	_, err := client.CreateAlert(duplicateAlertRequest)
+	_, err := client.CreateAlert(context.Background(), duplicateAlertRequest)
 
 	var alreadyExists *AlertAlreadyExistsError
 	if errors.As(err, &alreadyExists) {
@@ -167,7 +168,7 @@ func TestCreateAlertWhenTheLookupFails(t *testing.T) {
 	})
 	defer done()
 
-	_, err := client.CreateAlert(duplicateAlertRequest)
+	_, err := client.CreateAlert(context.Background(), duplicateAlertRequest)
 
 	var alreadyExists *AlertAlreadyExistsError
 	if errors.As(err, &alreadyExists) {
@@ -192,7 +193,7 @@ func TestListAlertsScopesTheRequest(t *testing.T) {
 	defer done()
 
 	projectID := "project-1"
-	alerts, err := client.ListAlerts(ListAlertsRequest{
+	alerts, err := client.ListAlerts(context.Background(), ListAlertsRequest{
 		ResourceType: "identity.authentication",
 		ResourceID:   "identity-1",
 		ProjectID:    &projectID,
@@ -228,7 +229,7 @@ func TestListAlertsWithoutAProject(t *testing.T) {
 	})
 	defer done()
 
-	if _, err := client.ListAlerts(ListAlertsRequest{ResourceType: "identity.authentication"}); err != nil {
+	if _, err := client.ListAlerts(context.Background(), ListAlertsRequest{ResourceType: "identity.authentication"}); err != nil {
 		t.Fatalf("ListAlerts() error = %v", err)
 	}
 
@@ -253,7 +254,7 @@ func TestUpdateAlertOnADeletedAlert(t *testing.T) {
 			})
 			defer done()
 
-			_, err := client.UpdateAlert(UpdateAlertRequest{ID: "alert-1"})
+			_, err := client.UpdateAlert(context.Background(), UpdateAlertRequest{ID: "alert-1"})
 			if !errors.Is(err, ErrNotFound) {
 				t.Errorf("UpdateAlert() error = %v, want ErrNotFound", err)
 			}
@@ -267,7 +268,7 @@ func TestUpdateAlertReportsOtherFailures(t *testing.T) {
 	})
 	defer done()
 
-	_, err := client.UpdateAlert(UpdateAlertRequest{ID: "alert-1"})
+	_, err := client.UpdateAlert(context.Background(), UpdateAlertRequest{ID: "alert-1"})
 	if err == nil {
 		t.Fatal("UpdateAlert() error = nil, want one")
 	}
@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"terraform-provider-infisical/internal/errors"
@@ -39,6 +40,15 @@ const (
 	AppConnectionAppHashicorpVault        AppConnectionApp = "hashicorp-vault"
 	AppConnectionAppDatadog               AppConnectionApp = "datadog"
 	AppConnectionAppCircleCI              AppConnectionApp = "circleci"
+	AppConnectionAppVercel                AppConnectionApp = "vercel"
+	AppConnectionAppNetlify               AppConnectionApp = "netlify"
+	AppConnectionAppHeroku                AppConnectionApp = "heroku"
+	AppConnectionAppTerraformCloud        AppConnectionApp = "terraform-cloud"
+	AppConnectionAppKubernetes            AppConnectionApp = "kubernetes"
+	AppConnectionAppAuth0                 AppConnectionApp = "auth0"
+	AppConnectionAppOkta                  AppConnectionApp = "okta"
+	AppConnectionAppMongoDb               AppConnectionApp = "mongodb"
+	AppConnectionAppRedis                 AppConnectionApp = "redis"
 )
 
 const (
@@ -50,11 +60,11 @@ const (
 	operationUpdateAppConnectionWithGateway = "CallUpdateAppConnectionWithGateway"
 )
 
-func (client Client) CreateAppConnection(request CreateAppConnectionRequest) (AppConnection, error) {
+func (client Client) CreateAppConnection(ctx context.Context, request CreateAppConnectionRequest) (AppConnection, error) {
 	var body CreateAppConnectionResponse
 
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -71,11 +81,11 @@ func (client Client) CreateAppConnection(request CreateAppConnectionRequest) (Ap
 	return body.AppConnection, nil
 }
 
-func (client Client) CreateAppConnectionWithGateway(request CreateAppConnectionWithGateway) (AppConnection, error) {
+func (client Client) CreateAppConnectionWithGateway(ctx context.Context, request CreateAppConnectionWithGateway) (AppConnection, error) {
 	var body CreateAppConnectionResponse
 
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -92,10 +102,10 @@ func (client Client) CreateAppConnectionWithGateway(request CreateAppConnectionW
 	return body.AppConnection, nil
 }
 
-func (client Client) GetAppConnectionById(request GetAppConnectionByIdRequest) (AppConnection, error) {
+func (client Client) GetAppConnectionById(ctx context.Context, request GetAppConnectionByIdRequest) (AppConnection, error) {
 	var body GetAppConnectionByIdResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		Get(fmt.Sprintf("api/v1/app-connections/%s/%s", request.App, request.ID))
@@ -114,10 +124,10 @@ func (client Client) GetAppConnectionById(request GetAppConnectionByIdRequest) (
 	return body.AppConnection, nil
 }
 
-func (client Client) UpdateAppConnection(request UpdateAppConnectionRequest) (AppConnection, error) {
+func (client Client) UpdateAppConnection(ctx context.Context, request UpdateAppConnectionRequest) (AppConnection, error) {
 	var body UpdateAppConnectionResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -134,11 +144,11 @@ func (client Client) UpdateAppConnection(request UpdateAppConnectionRequest) (Ap
 	return body.AppConnection, nil
 }
 
-func (client Client) UpdateAppConnectionWithGateway(request UpdateAppConnectionWithGateway) (AppConnection, error) {
+func (client Client) UpdateAppConnectionWithGateway(ctx context.Context, request UpdateAppConnectionWithGateway) (AppConnection, error) {
 	var body UpdateAppConnectionResponse
 
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -155,10 +165,10 @@ func (client Client) UpdateAppConnectionWithGateway(request UpdateAppConnectionW
 	return body.AppConnection, nil
 }
 
-func (client Client) DeleteAppConnection(request DeleteAppConnectionRequest) (AppConnection, error) {
+func (client Client) DeleteAppConnection(ctx context.Context, request DeleteAppConnectionRequest) (AppConnection, error) {
 	var body DeleteAppConnectionResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		Delete(fmt.Sprintf("api/v1/app-connections/%s/%s", request.App, request.ID))
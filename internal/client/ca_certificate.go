@@ -1,7 +1,9 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"terraform-provider-infisical/internal/errors"
 )
 
@@ -10,10 +12,10 @@ const (
 	operationGetCACertificate      = "CallGetCACertificate"
 )
 
-func (client Client) GenerateCACertificate(request GenerateCACertificateRequest) (GenerateCACertificateResponse, error) {
+func (client Client) GenerateCACertificate(ctx context.Context, request GenerateCACertificateRequest) (GenerateCACertificateResponse, error) {
 	var certResponse GenerateCACertificateResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&certResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -30,10 +32,10 @@ func (client Client) GenerateCACertificate(request GenerateCACertificateRequest)
 	return certResponse, nil
 }
 
-func (client Client) GetCACertificate(request GetCACertificateRequest) (GetCACertificateResponse, error) {
+func (client Client) GetCACertificate(ctx context.Context, request GetCACertificateRequest) (GetCACertificateResponse, error) {
 	var certResponse GetCACertificateResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&certResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Get(fmt.Sprintf("api/v1/cert-manager/ca/internal/%s/certificate", request.CaId))
@@ -43,16 +45,19 @@ func (client Client) GetCACertificate(request GetCACertificateRequest) (GetCACer
 	}
 
 	if response.IsError() {
+		if response.StatusCode() == http.StatusNotFound {
+			return GetCACertificateResponse{}, ErrNotFound
+		}
 		return GetCACertificateResponse{}, errors.NewAPIErrorWithResponse(operationGetCACertificate, response, nil)
 	}
 
 	return certResponse, nil
 }
 
-func (client Client) GetSpecificCACertificate(request GetSpecificCACertificateRequest) (GetSpecificCACertificateResponse, error) {
+func (client Client) GetSpecificCACertificate(ctx context.Context, request GetSpecificCACertificateRequest) (GetSpecificCACertificateResponse, error) {
 	var certResponse GetSpecificCACertificateResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&certResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Get(fmt.Sprintf("api/v1/cert-manager/ca/internal/%s/certificate/%s", request.CaId, request.CertId))
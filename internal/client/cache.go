@@ -0,0 +1,193 @@
+package infisicalclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"golang.org/x/sync/singleflight"
+)
+
+// readCache keeps the successful responses of lookups that many resources repeat within a run,
+// such as resolving a project, an environment or a folder, and collapses concurrent identical
+// lookups into one request. Entries live until a mutation touches their API family.
+type readCache struct {
+	mu         sync.Mutex
+	entries    map[string]readCacheEntry
+	generation uint64
+	group      singleflight.Group
+}
+
+type readCacheEntry struct {
+	family   string
+	response *resty.Response
+}
+
+// readCacheInvalidates lists the families a mutation makes stale beyond its own, for mutations
+// whose effects cascade: deleting a project or an environment also deletes its folders.
+var readCacheInvalidates = map[string][]string{
+	"projects": {"folders"},
+}
+
+func newReadCache() *readCache {
+	return &readCache{entries: map[string]readCacheEntry{}}
+}
+
+// install invalidates the cache around every mutation sent through the client. Invalidating
+// before the request is sent and again once it completes keeps a lookup that raced the mutation
+// from caching the state it replaced.
+func (c *readCache) install(httpClient *resty.Client) {
+	httpClient.OnBeforeRequest(func(_ *resty.Client, r *resty.Request) error {
+		c.invalidateFor(r.Method, r.URL)
+		return nil
+	})
+	httpClient.OnAfterResponse(func(_ *resty.Client, r *resty.Response) error {
+		c.invalidateFor(r.Request.Method, r.Request.URL)
+		return nil
+	})
+	httpClient.OnError(func(r *resty.Request, _ error) {
+		c.invalidateFor(r.Method, r.URL)
+	})
+}
+
+func (c *readCache) invalidateFor(method, rawURL string) {
+	if method == http.MethodGet || method == http.MethodHead {
+		return
+	}
+
+	family := readCacheFamily(rawURL)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	if family == "" {
+		c.entries = map[string]readCacheEntry{}
+		return
+	}
+
+	stale := append([]string{family}, readCacheInvalidates[family]...)
+	for key, entry := range c.entries {
+		for _, f := range stale {
+			if entry.family == f {
+				delete(c.entries, key)
+				break
+			}
+		}
+	}
+}
+
+// readCacheFetchTimeout bounds a shared lookup, which no longer follows the deadline of the
+// caller that started it.
+const readCacheFetchTimeout = 5 * time.Minute
+
+// get returns the cached response for key, or fetches it. Concurrent callers share a single
+// fetch, which runs on a context detached from theirs so that one caller giving up does not fail
+// the others; each caller still returns as soon as its own context is done.
+func (c *readCache) get(ctx context.Context, key string, fetch func(context.Context) (*resty.Response, error)) (*resty.Response, error) {
+	c.mu.Lock()
+	if entry, ok := c.entries[key]; ok {
+		c.mu.Unlock()
+		return entry.response, nil
+	}
+	generation := c.generation
+	c.mu.Unlock()
+
+	// Lookups only share a flight within one generation, so none joins a request that was sent
+	// before a mutation.
+	flight := c.group.DoChan(fmt.Sprintf("%d %s", generation, key), func() (interface{}, error) {
+		fetchCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), readCacheFetchTimeout)
+		defer cancel()
+
+		response, err := fetch(fetchCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		if response.IsSuccess() {
+			c.mu.Lock()
+			if c.generation == generation {
+				c.entries[key] = readCacheEntry{family: readCacheFamily(key), response: response}
+			}
+			c.mu.Unlock()
+		}
+		return response, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case result := <-flight:
+		if result.Err != nil {
+			return nil, result.Err
+		}
+		response, _ := result.Val.(*resty.Response)
+		return response, nil
+	}
+}
+
+// readCacheFamily returns the API family of a request URL, which is the path segment following
+// the API version ("projects" in api/v1/projects/<id>/environments). The legacy "workspace" routes
+// address projects. An empty string is returned for URLs outside the API.
+func readCacheFamily(rawURL string) string {
+	path := rawURL
+	if parsed, err := url.Parse(rawURL); err == nil {
+		path = parsed.Path
+	}
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i := 0; i+2 < len(segments); i++ {
+		if segments[i] != "api" {
+			continue
+		}
+		if family := segments[i+2]; family != "workspace" {
+			return family
+		}
+		return "projects"
+	}
+	return ""
+}
+
+// cachedGet sends a GET request and decodes a successful response into result. When the client
+// was created with EnableReadCache, the response is served from the read cache where possible.
+func (client Client) cachedGet(ctx context.Context, path string, query map[string]string, result interface{}) (*resty.Response, error) {
+	newRequest := func(ctx context.Context) *resty.Request {
+		return client.Config.HttpClient.
+			R().SetContext(ctx).
+			SetHeader("User-Agent", USER_AGENT).
+			SetQueryParams(query)
+	}
+
+	if client.Config.readCache == nil {
+		return newRequest(ctx).SetResult(result).Get(path)
+	}
+
+	key := path
+	if len(query) > 0 {
+		values := url.Values{}
+		for name, value := range query {
+			values.Set(name, value)
+		}
+		key += "?" + values.Encode()
+	}
+
+	response, err := client.Config.readCache.get(ctx, key, func(ctx context.Context) (*resty.Response, error) {
+		return newRequest(ctx).Get(path)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if response.IsSuccess() {
+		if err := json.Unmarshal(response.Body(), result); err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
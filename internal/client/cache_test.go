@@ -0,0 +1,232 @@
+package infisicalclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// newCachedClient returns a client with the read cache enabled, pointed at server.
+func newCachedClient(server *httptest.Server) Client {
+	cnf := Config{HttpClient: resty.New().SetBaseURL(server.URL), readCache: newReadCache()}
+	cnf.readCache.install(cnf.HttpClient)
+	return Client{cnf}
+}
+
+// countingServer serves fixed responses per path and counts the GETs received for each.
+func countingServer(t *testing.T, delay time.Duration) (*httptest.Server, func(path string) int32) {
+	t.Helper()
+
+	var mu sync.Mutex
+	counts := map[string]*int32{}
+	count := func(path string) *int32 {
+		mu.Lock()
+		defer mu.Unlock()
+		if counts[path] == nil {
+			counts[path] = new(int32)
+		}
+		return counts[path]
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			jsonResponse(http.StatusOK, `{}`)(w, r)
+			return
+		}
+		atomic.AddInt32(count(r.URL.Path), 1)
+		time.Sleep(delay)
+
+		switch r.URL.Path {
+		case "/api/v1/workspace/project-id":
+			jsonResponse(http.StatusOK, `{"workspace":{"id":"project-id","slug":"my-project"}}`)(w, r)
+		case "/api/v1/folders/project-id/dev/%2Fapp", "/api/v1/folders/project-id/dev//app":
+			jsonResponse(http.StatusOK, `{"folder":{"id":"folder-id","name":"app"}}`)(w, r)
+		default:
+			jsonResponse(http.StatusNotFound, `{"message":"not found"}`)(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server, func(path string) int32 { return atomic.LoadInt32(count(path)) }
+}
+
+func TestReadCache_ServesRepeatedLookups(t *testing.T) {
+	server, gets := countingServer(t, 0)
+	client := newCachedClient(server)
+
+	for i := 0; i < 3; i++ {
+		project, err := client.GetProjectById(context.Background(), GetProjectByIdRequest{ID: "project-id"})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if project.Slug != "my-project" {
+			t.Fatalf("got project slug %q, want %q", project.Slug, "my-project")
+		}
+	}
+
+	if got := gets("/api/v1/workspace/project-id"); got != 1 {
+		t.Fatalf("project GETs = %d, want 1", got)
+	}
+}
+
+func TestReadCache_CollapsesConcurrentLookups(t *testing.T) {
+	server, gets := countingServer(t, 50*time.Millisecond)
+	client := newCachedClient(server)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.GetProjectById(context.Background(), GetProjectByIdRequest{ID: "project-id"}); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := gets("/api/v1/workspace/project-id"); got != 1 {
+		t.Fatalf("project GETs = %d, want 1", got)
+	}
+}
+
+func TestReadCache_CancellingOneLookupDoesNotFailTheOthers(t *testing.T) {
+	server, gets := countingServer(t, 100*time.Millisecond)
+	client := newCachedClient(server)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	first := make(chan error, 1)
+	go func() {
+		_, err := client.GetProjectById(ctx, GetProjectByIdRequest{ID: "project-id"})
+		first <- err
+	}()
+
+	// the second lookup joins the flight the first one started, which is then cancelled
+	time.Sleep(20 * time.Millisecond)
+	second := make(chan error, 1)
+	go func() {
+		_, err := client.GetProjectById(context.Background(), GetProjectByIdRequest{ID: "project-id"})
+		second <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+
+	if err := <-first; err == nil {
+		t.Error("expected the cancelled lookup to fail")
+	}
+	if err := <-second; err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := gets("/api/v1/workspace/project-id"); got != 1 {
+		t.Fatalf("project GETs = %d, want 1", got)
+	}
+}
+
+func TestReadCache_DoesNotCacheErrors(t *testing.T) {
+	server, gets := countingServer(t, 0)
+	client := newCachedClient(server)
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetProjectById(context.Background(), GetProjectByIdRequest{ID: "missing"}); !errors.Is(err, ErrNotFound) {
+			t.Fatalf("got error %v, want ErrNotFound", err)
+		}
+	}
+
+	if got := gets("/api/v1/workspace/missing"); got != 2 {
+		t.Fatalf("project GETs = %d, want 2", got)
+	}
+}
+
+func TestReadCache_MutationsInvalidateTheirFamily(t *testing.T) {
+	server, gets := countingServer(t, 0)
+	client := newCachedClient(server)
+	ctx := context.Background()
+
+	lookup := func() {
+		t.Helper()
+		if _, err := client.GetProjectById(ctx, GetProjectByIdRequest{ID: "project-id"}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if _, err := client.GetFolderByPath(ctx, GetSecretFolderByPathRequest{ProjectID: "project-id", Environment: "dev", SecretPath: "/app"}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	folderGets := func() int32 {
+		return gets("/api/v1/folders/project-id/dev/%2Fapp") + gets("/api/v1/folders/project-id/dev//app")
+	}
+
+	lookup()
+
+	// A folder mutation leaves the project cached.
+	if _, err := client.Config.HttpClient.R().Post("api/v1/folders"); err != nil {
+		t.Fatal(err)
+	}
+	lookup()
+	if got := gets("/api/v1/workspace/project-id"); got != 1 {
+		t.Fatalf("project GETs = %d, want 1", got)
+	}
+	if got := folderGets(); got != 2 {
+		t.Fatalf("folder GETs = %d, want 2", got)
+	}
+
+	// A secret mutation touches neither.
+	if _, err := client.Config.HttpClient.R().Post("api/v3/secrets/raw/KEY"); err != nil {
+		t.Fatal(err)
+	}
+	lookup()
+	if got := gets("/api/v1/workspace/project-id"); got != 1 {
+		t.Fatalf("project GETs = %d, want 1", got)
+	}
+	if got := folderGets(); got != 2 {
+		t.Fatalf("folder GETs = %d, want 2", got)
+	}
+
+	// An environment mutation invalidates the project and, as it can delete folders, the folders.
+	if _, err := client.Config.HttpClient.R().Delete("api/v1/workspace/project-id/environments/env-id"); err != nil {
+		t.Fatal(err)
+	}
+	lookup()
+	if got := gets("/api/v1/workspace/project-id"); got != 2 {
+		t.Fatalf("project GETs = %d, want 2", got)
+	}
+	if got := folderGets(); got != 3 {
+		t.Fatalf("folder GETs = %d, want 3", got)
+	}
+}
+
+func TestReadCache_DisabledByDefault(t *testing.T) {
+	server, gets := countingServer(t, 0)
+	client := Client{Config{HttpClient: resty.New().SetBaseURL(server.URL)}}
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetProjectById(context.Background(), GetProjectByIdRequest{ID: "project-id"}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if got := gets("/api/v1/workspace/project-id"); got != 2 {
+		t.Fatalf("project GETs = %d, want 2", got)
+	}
+}
+
+func TestReadCacheFamily(t *testing.T) {
+	cases := map[string]string{
+		"api/v1/workspace/project-id":                       "projects",
+		"api/v1/projects/project-id/environments/slug/dev":  "projects",
+		"https://infisical.example.com/api/v1/folders":      "folders",
+		"https://example.com/infisical/api/v2/gateways?x=1": "gateways",
+		"healthz": "",
+	}
+	for in, want := range cases {
+		if got := readCacheFamily(in); got != want {
+			t.Errorf("readCacheFamily(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"terraform-provider-infisical/internal/errors"
 )
@@ -12,10 +13,10 @@ const (
 	operationRemoveCertManagerGroup = "CallRemoveCertManagerGroup"
 )
 
-func (client Client) GetCertManagerGroup(request GetCertManagerGroupRequest) (GetCertManagerGroupResponse, error) {
+func (client Client) GetCertManagerGroup(ctx context.Context, request GetCertManagerGroupRequest) (GetCertManagerGroupResponse, error) {
 	var groupResponse GetCertManagerGroupResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&groupResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Get(fmt.Sprintf("api/v1/cert-manager/access/groups/%s", request.GroupId))
@@ -34,10 +35,10 @@ func (client Client) GetCertManagerGroup(request GetCertManagerGroupRequest) (Ge
 	return groupResponse, nil
 }
 
-func (client Client) AddCertManagerGroup(request AddCertManagerGroupRequest) (AddCertManagerGroupResponse, error) {
+func (client Client) AddCertManagerGroup(ctx context.Context, request AddCertManagerGroupRequest) (AddCertManagerGroupResponse, error) {
 	var groupResponse AddCertManagerGroupResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&groupResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -54,10 +55,10 @@ func (client Client) AddCertManagerGroup(request AddCertManagerGroupRequest) (Ad
 	return groupResponse, nil
 }
 
-func (client Client) UpdateCertManagerGroup(request UpdateCertManagerGroupRequest) (UpdateCertManagerGroupResponse, error) {
+func (client Client) UpdateCertManagerGroup(ctx context.Context, request UpdateCertManagerGroupRequest) (UpdateCertManagerGroupResponse, error) {
 	var groupResponse UpdateCertManagerGroupResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&groupResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -74,10 +75,10 @@ func (client Client) UpdateCertManagerGroup(request UpdateCertManagerGroupReques
 	return groupResponse, nil
 }
 
-func (client Client) RemoveCertManagerGroup(request RemoveCertManagerGroupRequest) (RemoveCertManagerGroupResponse, error) {
+func (client Client) RemoveCertManagerGroup(ctx context.Context, request RemoveCertManagerGroupRequest) (RemoveCertManagerGroupResponse, error) {
 	var groupResponse RemoveCertManagerGroupResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&groupResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Delete(fmt.Sprintf("api/v1/cert-manager/access/groups/%s", request.GroupId))
@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"terraform-provider-infisical/internal/errors"
 )
@@ -12,10 +13,10 @@ const (
 	operationRemoveCertManagerIdentity = "CallRemoveCertManagerIdentity"
 )
 
-func (client Client) GetCertManagerIdentity(request GetCertManagerIdentityRequest) (GetCertManagerIdentityResponse, error) {
+func (client Client) GetCertManagerIdentity(ctx context.Context, request GetCertManagerIdentityRequest) (GetCertManagerIdentityResponse, error) {
 	var identityResponse GetCertManagerIdentityResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&identityResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Get(fmt.Sprintf("api/v1/cert-manager/access/identities/%s", request.IdentityId))
@@ -34,10 +35,10 @@ func (client Client) GetCertManagerIdentity(request GetCertManagerIdentityReques
 	return identityResponse, nil
 }
 
-func (client Client) AddCertManagerIdentity(request AddCertManagerIdentityRequest) (AddCertManagerIdentityResponse, error) {
+func (client Client) AddCertManagerIdentity(ctx context.Context, request AddCertManagerIdentityRequest) (AddCertManagerIdentityResponse, error) {
 	var identityResponse AddCertManagerIdentityResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&identityResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -54,10 +55,10 @@ func (client Client) AddCertManagerIdentity(request AddCertManagerIdentityReques
 	return identityResponse, nil
 }
 
-func (client Client) UpdateCertManagerIdentity(request UpdateCertManagerIdentityRequest) (UpdateCertManagerIdentityResponse, error) {
+func (client Client) UpdateCertManagerIdentity(ctx context.Context, request UpdateCertManagerIdentityRequest) (UpdateCertManagerIdentityResponse, error) {
 	var identityResponse UpdateCertManagerIdentityResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&identityResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -74,10 +75,10 @@ func (client Client) UpdateCertManagerIdentity(request UpdateCertManagerIdentity
 	return identityResponse, nil
 }
 
-func (client Client) RemoveCertManagerIdentity(request RemoveCertManagerIdentityRequest) (RemoveCertManagerIdentityResponse, error) {
+func (client Client) RemoveCertManagerIdentity(ctx context.Context, request RemoveCertManagerIdentityRequest) (RemoveCertManagerIdentityResponse, error) {
 	var identityResponse RemoveCertManagerIdentityResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&identityResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Delete(fmt.Sprintf("api/v1/cert-manager/access/identities/%s", request.IdentityId))
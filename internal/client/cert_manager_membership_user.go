@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"terraform-provider-infisical/internal/errors"
 )
@@ -13,10 +14,10 @@ const (
 	operationRemoveCertManagerUser  = "CallRemoveCertManagerUser"
 )
 
-func (client Client) ListCertManagerUsers() (ListCertManagerUsersResponse, error) {
+func (client Client) ListCertManagerUsers(ctx context.Context) (ListCertManagerUsersResponse, error) {
 	var usersResponse ListCertManagerUsersResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&usersResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Get("api/v1/cert-manager/access/users")
@@ -35,10 +36,10 @@ func (client Client) ListCertManagerUsers() (ListCertManagerUsersResponse, error
 	return usersResponse, nil
 }
 
-func (client Client) GetCertManagerUser(request GetCertManagerUserRequest) (GetCertManagerUserResponse, error) {
+func (client Client) GetCertManagerUser(ctx context.Context, request GetCertManagerUserRequest) (GetCertManagerUserResponse, error) {
 	var userResponse GetCertManagerUserResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&userResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Get(fmt.Sprintf("api/v1/cert-manager/access/users/%s", request.UserId))
@@ -57,10 +58,10 @@ func (client Client) GetCertManagerUser(request GetCertManagerUserRequest) (GetC
 	return userResponse, nil
 }
 
-func (client Client) InviteCertManagerUsers(request InviteCertManagerUsersRequest) (InviteCertManagerUsersResponse, error) {
+func (client Client) InviteCertManagerUsers(ctx context.Context, request InviteCertManagerUsersRequest) (InviteCertManagerUsersResponse, error) {
 	var usersResponse InviteCertManagerUsersResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&usersResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -77,10 +78,10 @@ func (client Client) InviteCertManagerUsers(request InviteCertManagerUsersReques
 	return usersResponse, nil
 }
 
-func (client Client) UpdateCertManagerUser(request UpdateCertManagerUserRequest) (UpdateCertManagerUserResponse, error) {
+func (client Client) UpdateCertManagerUser(ctx context.Context, request UpdateCertManagerUserRequest) (UpdateCertManagerUserResponse, error) {
 	var userResponse UpdateCertManagerUserResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&userResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -97,10 +98,10 @@ func (client Client) UpdateCertManagerUser(request UpdateCertManagerUserRequest)
 	return userResponse, nil
 }
 
-func (client Client) RemoveCertManagerUser(request RemoveCertManagerUserRequest) (RemoveCertManagerUserResponse, error) {
+func (client Client) RemoveCertManagerUser(ctx context.Context, request RemoveCertManagerUserRequest) (RemoveCertManagerUserResponse, error) {
 	var userResponse RemoveCertManagerUserResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&userResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Delete(fmt.Sprintf("api/v1/cert-manager/access/users/%s", request.UserId))
@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"terraform-provider-infisical/internal/errors"
 )
@@ -11,10 +12,10 @@ const (
 	operationGetCertificateRequestStatus = "CallGetCertificateRequestStatus"
 )
 
-func (client Client) RequestCertificate(request RequestCertificateRequest) (RequestCertificateResponse, error) {
+func (client Client) RequestCertificate(ctx context.Context, request RequestCertificateRequest) (RequestCertificateResponse, error) {
 	var certResponse RequestCertificateResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&certResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -31,10 +32,10 @@ func (client Client) RequestCertificate(request RequestCertificateRequest) (Requ
 	return certResponse, nil
 }
 
-func (client Client) GetCertificate(request GetCertificateRequest) (GetCertificateResponse, error) {
+func (client Client) GetCertificate(ctx context.Context, request GetCertificateRequest) (GetCertificateResponse, error) {
 	var certResponse GetCertificateResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&certResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Get(fmt.Sprintf("api/v1/cert-manager/certificates/%s", request.CertificateId))
@@ -53,10 +54,10 @@ func (client Client) GetCertificate(request GetCertificateRequest) (GetCertifica
 	return certResponse, nil
 }
 
-func (client Client) GetCertificateRequestStatus(request GetCertificateRequestStatusRequest) (GetCertificateRequestStatusResponse, error) {
+func (client Client) GetCertificateRequestStatus(ctx context.Context, request GetCertificateRequestStatusRequest) (GetCertificateRequestStatusResponse, error) {
 	var statusResponse GetCertificateRequestStatusResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&statusResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Get(fmt.Sprintf("api/v1/cert-manager/certificates/certificate-requests/%s", request.RequestId))
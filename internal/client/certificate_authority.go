@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"terraform-provider-infisical/internal/errors"
 )
@@ -18,10 +19,10 @@ const (
 	operationUpdateADCSCA     = "CallUpdateADCSCA"
 )
 
-func (client Client) CreateInternalCA(request CreateInternalCARequest) (CreateInternalCAResponse, error) {
+func (client Client) CreateInternalCA(ctx context.Context, request CreateInternalCARequest) (CreateInternalCAResponse, error) {
 	var caResponse CreateInternalCAResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&caResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -38,10 +39,10 @@ func (client Client) CreateInternalCA(request CreateInternalCARequest) (CreateIn
 	return caResponse, nil
 }
 
-func (client Client) GetInternalCA(request GetCARequest) (GetCAResponse, error) {
+func (client Client) GetInternalCA(ctx context.Context, request GetCARequest) (GetCAResponse, error) {
 	var caResponse GetCAResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&caResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Get(fmt.Sprintf("api/v1/cert-manager/ca/internal/%s", request.CAId))
@@ -60,10 +61,10 @@ func (client Client) GetInternalCA(request GetCARequest) (GetCAResponse, error)
 	return caResponse, nil
 }
 
-func (client Client) GetACMECA(request GetCARequest) (GetCAResponse, error) {
+func (client Client) GetACMECA(ctx context.Context, request GetCARequest) (GetCAResponse, error) {
 	var caResponse GetCAResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&caResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Get(fmt.Sprintf("api/v1/cert-manager/ca/acme/%s", request.CAId))
@@ -82,10 +83,10 @@ func (client Client) GetACMECA(request GetCARequest) (GetCAResponse, error) {
 	return caResponse, nil
 }
 
-func (client Client) GetADCSCA(request GetCARequest) (GetCAResponse, error) {
+func (client Client) GetADCSCA(ctx context.Context, request GetCARequest) (GetCAResponse, error) {
 	var caResponse GetCAResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&caResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Get(fmt.Sprintf("api/v1/cert-manager/ca/azure-ad-cs/%s", request.CAId))
@@ -104,10 +105,10 @@ func (client Client) GetADCSCA(request GetCARequest) (GetCAResponse, error) {
 	return caResponse, nil
 }
 
-func (client Client) UpdateInternalCA(request UpdateInternalCARequest) (UpdateInternalCAResponse, error) {
+func (client Client) UpdateInternalCA(ctx context.Context, request UpdateInternalCARequest) (UpdateInternalCAResponse, error) {
 	var caResponse UpdateInternalCAResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&caResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -124,10 +125,10 @@ func (client Client) UpdateInternalCA(request UpdateInternalCARequest) (UpdateIn
 	return caResponse, nil
 }
 
-func (client Client) DeleteInternalCA(request DeleteCARequest) (DeleteCAResponse, error) {
+func (client Client) DeleteInternalCA(ctx context.Context, request DeleteCARequest) (DeleteCAResponse, error) {
 	var caResponse DeleteCAResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&caResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Delete(fmt.Sprintf("api/v1/cert-manager/ca/internal/%s", request.CAId))
@@ -143,10 +144,10 @@ func (client Client) DeleteInternalCA(request DeleteCARequest) (DeleteCAResponse
 	return caResponse, nil
 }
 
-func (client Client) DeleteACMECA(request DeleteCARequest) (DeleteCAResponse, error) {
+func (client Client) DeleteACMECA(ctx context.Context, request DeleteCARequest) (DeleteCAResponse, error) {
 	var caResponse DeleteCAResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&caResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Delete(fmt.Sprintf("api/v1/cert-manager/ca/acme/%s", request.CAId))
@@ -162,10 +163,10 @@ func (client Client) DeleteACMECA(request DeleteCARequest) (DeleteCAResponse, er
 	return caResponse, nil
 }
 
-func (client Client) DeleteADCSCA(request DeleteCARequest) (DeleteCAResponse, error) {
+func (client Client) DeleteADCSCA(ctx context.Context, request DeleteCARequest) (DeleteCAResponse, error) {
 	var caResponse DeleteCAResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&caResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Delete(fmt.Sprintf("api/v1/cert-manager/ca/azure-ad-cs/%s", request.CAId))
@@ -181,10 +182,10 @@ func (client Client) DeleteADCSCA(request DeleteCARequest) (DeleteCAResponse, er
 	return caResponse, nil
 }
 
-func (client Client) CreateACMECA(request CreateACMECARequest) (CreateACMECAResponse, error) {
+func (client Client) CreateACMECA(ctx context.Context, request CreateACMECARequest) (CreateACMECAResponse, error) {
 	var caResponse CreateACMECAResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&caResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -201,10 +202,10 @@ func (client Client) CreateACMECA(request CreateACMECARequest) (CreateACMECAResp
 	return caResponse, nil
 }
 
-func (client Client) UpdateACMECA(request UpdateACMECARequest) (UpdateACMECAResponse, error) {
+func (client Client) UpdateACMECA(ctx context.Context, request UpdateACMECARequest) (UpdateACMECAResponse, error) {
 	var caResponse UpdateACMECAResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&caResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -221,10 +222,10 @@ func (client Client) UpdateACMECA(request UpdateACMECARequest) (UpdateACMECAResp
 	return caResponse, nil
 }
 
-func (client Client) CreateADCSCA(request CreateADCSCARequest) (CreateADCSCAResponse, error) {
+func (client Client) CreateADCSCA(ctx context.Context, request CreateADCSCARequest) (CreateADCSCAResponse, error) {
 	var caResponse CreateADCSCAResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&caResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -241,10 +242,10 @@ func (client Client) CreateADCSCA(request CreateADCSCARequest) (CreateADCSCAResp
 	return caResponse, nil
 }
 
-func (client Client) UpdateADCSCA(request UpdateADCSCARequest) (UpdateADCSCAResponse, error) {
+func (client Client) UpdateADCSCA(ctx context.Context, request UpdateADCSCARequest) (UpdateADCSCAResponse, error) {
 	var caResponse UpdateADCSCAResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&caResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
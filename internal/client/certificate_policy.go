@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"terraform-provider-infisical/internal/errors"
 )
@@ -12,10 +13,10 @@ const (
 	operationDeleteCertificatePolicy = "CallDeleteCertificatePolicy"
 )
 
-func (client Client) CreateCertificatePolicy(request CreateCertificatePolicyRequest) (CreateCertificatePolicyResponse, error) {
+func (client Client) CreateCertificatePolicy(ctx context.Context, request CreateCertificatePolicyRequest) (CreateCertificatePolicyResponse, error) {
 	var policyResponse CreateCertificatePolicyResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&policyResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -32,10 +33,10 @@ func (client Client) CreateCertificatePolicy(request CreateCertificatePolicyRequ
 	return policyResponse, nil
 }
 
-func (client Client) GetCertificatePolicy(request GetCertificatePolicyRequest) (GetCertificatePolicyResponse, error) {
+func (client Client) GetCertificatePolicy(ctx context.Context, request GetCertificatePolicyRequest) (GetCertificatePolicyResponse, error) {
 	var policyResponse GetCertificatePolicyResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&policyResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Get(fmt.Sprintf("api/v1/cert-manager/certificate-policies/%s", request.PolicyId))
@@ -54,10 +55,10 @@ func (client Client) GetCertificatePolicy(request GetCertificatePolicyRequest) (
 	return policyResponse, nil
 }
 
-func (client Client) UpdateCertificatePolicy(request UpdateCertificatePolicyRequest) (UpdateCertificatePolicyResponse, error) {
+func (client Client) UpdateCertificatePolicy(ctx context.Context, request UpdateCertificatePolicyRequest) (UpdateCertificatePolicyResponse, error) {
 	var policyResponse UpdateCertificatePolicyResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&policyResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -74,10 +75,10 @@ func (client Client) UpdateCertificatePolicy(request UpdateCertificatePolicyRequ
 	return policyResponse, nil
 }
 
-func (client Client) DeleteCertificatePolicy(request DeleteCertificatePolicyRequest) (DeleteCertificatePolicyResponse, error) {
+func (client Client) DeleteCertificatePolicy(ctx context.Context, request DeleteCertificatePolicyRequest) (DeleteCertificatePolicyResponse, error) {
 	var policyResponse DeleteCertificatePolicyResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&policyResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Delete(fmt.Sprintf("api/v1/cert-manager/certificate-policies/%s", request.PolicyId))
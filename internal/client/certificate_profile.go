@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"terraform-provider-infisical/internal/errors"
 )
@@ -12,10 +13,10 @@ const (
 	operationDeleteCertificateProfile = "CallDeleteCertificateProfile"
 )
 
-func (client Client) CreateCertificateProfile(request CreateCertificateProfileRequest) (CreateCertificateProfileResponse, error) {
+func (client Client) CreateCertificateProfile(ctx context.Context, request CreateCertificateProfileRequest) (CreateCertificateProfileResponse, error) {
 	var profileResponse CreateCertificateProfileResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&profileResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -32,10 +33,10 @@ func (client Client) CreateCertificateProfile(request CreateCertificateProfileRe
 	return profileResponse, nil
 }
 
-func (client Client) GetCertificateProfile(request GetCertificateProfileRequest) (GetCertificateProfileResponse, error) {
+func (client Client) GetCertificateProfile(ctx context.Context, request GetCertificateProfileRequest) (GetCertificateProfileResponse, error) {
 	var profileResponse GetCertificateProfileResponse
 	req := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&profileResponse).
 		SetHeader("User-Agent", USER_AGENT)
 
@@ -59,10 +60,10 @@ func (client Client) GetCertificateProfile(request GetCertificateProfileRequest)
 	return profileResponse, nil
 }
 
-func (client Client) UpdateCertificateProfile(request UpdateCertificateProfileRequest) (UpdateCertificateProfileResponse, error) {
+func (client Client) UpdateCertificateProfile(ctx context.Context, request UpdateCertificateProfileRequest) (UpdateCertificateProfileResponse, error) {
 	var profileResponse UpdateCertificateProfileResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&profileResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -79,10 +80,10 @@ func (client Client) UpdateCertificateProfile(request UpdateCertificateProfileRe
 	return profileResponse, nil
 }
 
-func (client Client) DeleteCertificateProfile(request DeleteCertificateProfileRequest) (DeleteCertificateProfileResponse, error) {
+func (client Client) DeleteCertificateProfile(ctx context.Context, request DeleteCertificateProfileRequest) (DeleteCertificateProfileResponse, error) {
 	var profileResponse DeleteCertificateProfileResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&profileResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Delete(fmt.Sprintf("api/v1/cert-manager/certificate-profiles/%s", request.ProfileId))
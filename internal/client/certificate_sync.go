@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -28,10 +29,10 @@ func certificateSyncBaseURL(app CertificateSyncApp) string {
 	return fmt.Sprintf("api/v1/cert-manager/syncs/%s", string(app))
 }
 
-func (client Client) CreateCertificateSync(request CreateCertificateSyncRequest) (CertificateSync, error) {
+func (client Client) CreateCertificateSync(ctx context.Context, request CreateCertificateSyncRequest) (CertificateSync, error) {
 	var body CertificateSync
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -48,10 +49,10 @@ func (client Client) CreateCertificateSync(request CreateCertificateSyncRequest)
 	return body, nil
 }
 
-func (client Client) UpdateCertificateSync(request UpdateCertificateSyncRequest) (CertificateSync, error) {
+func (client Client) UpdateCertificateSync(ctx context.Context, request UpdateCertificateSyncRequest) (CertificateSync, error) {
 	var body CertificateSync
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -68,10 +69,10 @@ func (client Client) UpdateCertificateSync(request UpdateCertificateSyncRequest)
 	return body, nil
 }
 
-func (client Client) GetCertificateSyncById(request GetCertificateSyncByIdRequest) (CertificateSync, error) {
+func (client Client) GetCertificateSyncById(ctx context.Context, request GetCertificateSyncByIdRequest) (CertificateSync, error) {
 	var body CertificateSync
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		Get(fmt.Sprintf("api/v1/cert-manager/syncs/%s", url.PathEscape(request.ID)))
@@ -91,10 +92,10 @@ func (client Client) GetCertificateSyncById(request GetCertificateSyncByIdReques
 	return body, nil
 }
 
-func (client Client) DeleteCertificateSync(request DeleteCertificateSyncRequest) (CertificateSync, error) {
+func (client Client) DeleteCertificateSync(ctx context.Context, request DeleteCertificateSyncRequest) (CertificateSync, error) {
 	var body CertificateSync
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		Delete(fmt.Sprintf("%s/%s", certificateSyncBaseURL(request.App), url.PathEscape(request.ID)))
@@ -120,10 +121,10 @@ func certificateSyncCertificatesURL(certificateSyncID string) string {
 	return fmt.Sprintf("api/v1/cert-manager/syncs/%s/certificates", url.PathEscape(certificateSyncID))
 }
 
-func (client Client) AddCertificateSyncCertificates(request AddCertificateSyncCertificatesRequest) ([]CertificateSyncCertificate, error) {
+func (client Client) AddCertificateSyncCertificates(ctx context.Context, request AddCertificateSyncCertificatesRequest) ([]CertificateSyncCertificate, error) {
 	var body AddCertificateSyncCertificatesResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -140,36 +141,37 @@ func (client Client) AddCertificateSyncCertificates(request AddCertificateSyncCe
 	return body.AddedCertificates, nil
 }
 
-func (client Client) ListCertificateSyncCertificates(request ListCertificateSyncCertificatesRequest) (ListCertificateSyncCertificatesResponse, error) {
-	var body ListCertificateSyncCertificatesResponse
-	response, err := client.Config.HttpClient.
-		R().
-		SetResult(&body).
-		SetHeader("User-Agent", USER_AGENT).
-		SetQueryParams(map[string]string{
-			"offset": fmt.Sprintf("%d", request.Offset),
-			"limit":  fmt.Sprintf("%d", request.Limit),
-		}).
-		Get(certificateSyncCertificatesURL(request.CertificateSyncID))
-
-	if err != nil {
-		return ListCertificateSyncCertificatesResponse{}, errors.NewGenericRequestError(operationListCertificateSyncCertificates, err)
-	}
-
-	if response.StatusCode() == http.StatusNotFound {
-		return ListCertificateSyncCertificatesResponse{}, ErrNotFound
-	}
-
-	if response.IsError() {
-		return ListCertificateSyncCertificatesResponse{}, errors.NewAPIErrorWithResponse(operationListCertificateSyncCertificates, response, nil)
-	}
-
-	return body, nil
+// ListCertificateSyncCertificates returns the certificates associated with a certificate sync,
+// up to opts.MaxResults. ErrNotFound is returned when the sync does not exist.
+func (client Client) ListCertificateSyncCertificates(ctx context.Context, request ListCertificateSyncCertificatesRequest, opts ListOptions) (ListResult[CertificateSyncCertificate], error) {
+	return paginate(defaultPageSize, opts, func(offset, limit int) ([]CertificateSyncCertificate, int, error) {
+		var body ListCertificateSyncCertificatesResponse
+		response, err := client.Config.HttpClient.
+			R().SetContext(ctx).
+			SetResult(&body).
+			SetHeader("User-Agent", USER_AGENT).
+			SetQueryParams(pageQuery(offset, limit)).
+			Get(certificateSyncCertificatesURL(request.CertificateSyncID))
+
+		if err != nil {
+			return nil, 0, errors.NewGenericRequestError(operationListCertificateSyncCertificates, err)
+		}
+
+		if response.StatusCode() == http.StatusNotFound {
+			return nil, 0, ErrNotFound
+		}
+
+		if response.IsError() {
+			return nil, 0, errors.NewAPIErrorWithResponse(operationListCertificateSyncCertificates, response, nil)
+		}
+
+		return body.Certificates, body.TotalCount, nil
+	})
 }
 
-func (client Client) RemoveCertificateSyncCertificates(request RemoveCertificateSyncCertificatesRequest) error {
+func (client Client) RemoveCertificateSyncCertificates(ctx context.Context, request RemoveCertificateSyncCertificatesRequest) error {
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
 		Delete(certificateSyncCertificatesURL(request.CertificateSyncID))
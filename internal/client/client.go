@@ -1,7 +1,9 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-resty/resty/v2"
 )
@@ -13,19 +15,31 @@ type Client struct {
 type AuthStrategyType string
 
 var AuthStrategy = struct {
-	SERVICE_TOKEN               AuthStrategyType
-	UNIVERSAL_MACHINE_IDENTITY  AuthStrategyType
-	OIDC_MACHINE_IDENTITY       AuthStrategyType
-	TOKEN_MACHINE_IDENTITY      AuthStrategyType
-	KUBERNETES_MACHINE_IDENTITY AuthStrategyType
-	AWS_IAM_MACHINE_IDENTITY    AuthStrategyType
+	SERVICE_TOKEN                 AuthStrategyType
+	UNIVERSAL_MACHINE_IDENTITY    AuthStrategyType
+	OIDC_MACHINE_IDENTITY         AuthStrategyType
+	TOKEN_MACHINE_IDENTITY        AuthStrategyType
+	KUBERNETES_MACHINE_IDENTITY   AuthStrategyType
+	AWS_IAM_MACHINE_IDENTITY      AuthStrategyType
+	GCP_ID_TOKEN_MACHINE_IDENTITY AuthStrategyType
+	GCP_IAM_MACHINE_IDENTITY      AuthStrategyType
+	AZURE_MACHINE_IDENTITY        AuthStrategyType
+	JWT_MACHINE_IDENTITY          AuthStrategyType
+	TLS_CERT_MACHINE_IDENTITY     AuthStrategyType
+	LDAP_MACHINE_IDENTITY         AuthStrategyType
 }{
-	SERVICE_TOKEN:               "SERVICE_TOKEN",
-	UNIVERSAL_MACHINE_IDENTITY:  "UNIVERSAL_MACHINE_IDENTITY",
-	OIDC_MACHINE_IDENTITY:       "OIDC_MACHINE_IDENTITY",
-	TOKEN_MACHINE_IDENTITY:      "TOKEN_MACHINE_IDENTITY",
-	KUBERNETES_MACHINE_IDENTITY: "KUBERNETES_MACHINE_IDENTITY",
-	AWS_IAM_MACHINE_IDENTITY:    "AWS_IAM_MACHINE_IDENTITY",
+	SERVICE_TOKEN:                 "SERVICE_TOKEN",
+	UNIVERSAL_MACHINE_IDENTITY:    "UNIVERSAL_MACHINE_IDENTITY",
+	OIDC_MACHINE_IDENTITY:         "OIDC_MACHINE_IDENTITY",
+	TOKEN_MACHINE_IDENTITY:        "TOKEN_MACHINE_IDENTITY",
+	KUBERNETES_MACHINE_IDENTITY:   "KUBERNETES_MACHINE_IDENTITY",
+	AWS_IAM_MACHINE_IDENTITY:      "AWS_IAM_MACHINE_IDENTITY",
+	GCP_ID_TOKEN_MACHINE_IDENTITY: "GCP_ID_TOKEN_MACHINE_IDENTITY",
+	GCP_IAM_MACHINE_IDENTITY:      "GCP_IAM_MACHINE_IDENTITY",
+	AZURE_MACHINE_IDENTITY:        "AZURE_MACHINE_IDENTITY",
+	JWT_MACHINE_IDENTITY:          "JWT_MACHINE_IDENTITY",
+	TLS_CERT_MACHINE_IDENTITY:     "TLS_CERT_MACHINE_IDENTITY",
+	LDAP_MACHINE_IDENTITY:         "LDAP_MACHINE_IDENTITY",
 }
 
 type Config struct {
@@ -53,18 +67,88 @@ type Config struct {
 	ServiceAccountToken     string
 	ServiceAccountTokenPath string
 
+	// GCP Machine Identity Auth. The metadata endpoints default to the platform ones and are
+	// only overridden to point the login at a local stub.
+	GcpServiceAccountKeyFilePath string
+	GcpMetadataEndpoint          string
+	GcpIamCredentialsEndpoint    string
+
+	// Azure Machine Identity Auth
+	AzureResource         string
+	AzureMetadataEndpoint string
+
+	// JWT Machine Identity Auth
+	JwtAuthToken     string
+	JwtAuthTokenPath string
+
+	// TLS Certificate Machine Identity Auth. PEM values take precedence over the file paths.
+	TlsCertAuthCertificate     string
+	TlsCertAuthPrivateKey      string
+	TlsCertAuthCertificatePath string
+	TlsCertAuthPrivateKeyPath  string
+
+	// LDAP Machine Identity Auth
+	LdapUsername string
+	LdapPassword string
+
 	EnvSlug     string
 	SecretsPath string
 	HttpClient  *resty.Client // By default a client will be created
+
+	// TLS, proxy, timeout and header settings of the connection to the Infisical API. PEM values
+	// take precedence over the file paths.
+	CACertificate         string
+	CACertificatePath     string
+	ClientCertificate     string
+	ClientPrivateKey      string
+	ClientCertificatePath string
+	ClientPrivateKeyPath  string
+	InsecureSkipVerify    bool
+	ProxyURL              string
+	RequestTimeout        time.Duration
+	Headers               map[string]string
+
+	// Retries of transient failures. When nil, DefaultRetryPolicy applies.
+	RetryPolicy *RetryPolicy
+	// Client-side throttling shared by every request. When nil, requests are not throttled.
+	RateLimitPolicy *RateLimitPolicy
+
+	// Cache repeated lookups, such as resolving projects, environments and folders, for the
+	// lifetime of the client. Mutations invalidate the entries they can make stale.
+	EnableReadCache bool
+	readCache       *readCache
+
+	// Provider-level defaults, used by resources and data sources that leave these unset
+	DefaultProjectId   string
+	DefaultEnvironment string
+	DefaultFolderPath  string
 }
 
-func NewClient(cnf Config) (*Client, error) {
+func NewClient(ctx context.Context, cnf Config) (*Client, error) {
 	if cnf.HttpClient == nil {
 		cnf.HttpClient = resty.New()
 		cnf.HttpClient.SetBaseURL(cnf.HostURL)
 	}
 
-	configureRetries(cnf.HttpClient)
+	if err := configureConnection(cnf.HttpClient, cnf); err != nil {
+		return nil, err
+	}
+
+	configureLogging(cnf.HttpClient, headerNames(cnf.Headers))
+	configureTracing(cnf.HttpClient)
+
+	retryPolicy := DefaultRetryPolicy
+	if cnf.RetryPolicy != nil {
+		retryPolicy = *cnf.RetryPolicy
+	}
+	configureRetries(cnf.HttpClient, retryPolicy)
+	if cnf.RateLimitPolicy != nil {
+		configureRateLimit(cnf.HttpClient, *cnf.RateLimitPolicy)
+	}
+	if cnf.EnableReadCache {
+		cnf.readCache = newReadCache()
+		cnf.readCache.install(cnf.HttpClient)
+	}
 
 	var usingServiceToken = cnf.ServiceToken != ""
 
@@ -84,15 +168,21 @@ func NewClient(cnf Config) (*Client, error) {
 		cnf.HttpClient.SetAuthToken(cnf.ServiceToken)
 		cnf.AuthStrategy = AuthStrategy.SERVICE_TOKEN
 	} else {
-		authStrategies := map[AuthStrategyType]func() (string, error){
-			AuthStrategy.UNIVERSAL_MACHINE_IDENTITY:  Client{cnf}.UniversalMachineIdentityAuth,
-			AuthStrategy.OIDC_MACHINE_IDENTITY:       Client{cnf}.OidcMachineIdentityAuth,
-			AuthStrategy.TOKEN_MACHINE_IDENTITY:      Client{cnf}.TokenMachineIdentityAuth,
-			AuthStrategy.KUBERNETES_MACHINE_IDENTITY: Client{cnf}.KubernetesMachineIdentityAuth,
-			AuthStrategy.AWS_IAM_MACHINE_IDENTITY:    Client{cnf}.AwsIamMachineIdentityAuth,
+		authStrategies := map[AuthStrategyType]func(context.Context) (string, error){
+			AuthStrategy.UNIVERSAL_MACHINE_IDENTITY:    Client{cnf}.UniversalMachineIdentityAuth,
+			AuthStrategy.OIDC_MACHINE_IDENTITY:         Client{cnf}.OidcMachineIdentityAuth,
+			AuthStrategy.TOKEN_MACHINE_IDENTITY:        Client{cnf}.TokenMachineIdentityAuth,
+			AuthStrategy.KUBERNETES_MACHINE_IDENTITY:   Client{cnf}.KubernetesMachineIdentityAuth,
+			AuthStrategy.AWS_IAM_MACHINE_IDENTITY:      Client{cnf}.AwsIamMachineIdentityAuth,
+			AuthStrategy.GCP_ID_TOKEN_MACHINE_IDENTITY: Client{cnf}.GcpIdTokenMachineIdentityAuth,
+			AuthStrategy.GCP_IAM_MACHINE_IDENTITY:      Client{cnf}.GcpIamMachineIdentityAuth,
+			AuthStrategy.AZURE_MACHINE_IDENTITY:        Client{cnf}.AzureMachineIdentityAuth,
+			AuthStrategy.JWT_MACHINE_IDENTITY:          Client{cnf}.JwtMachineIdentityAuth,
+			AuthStrategy.TLS_CERT_MACHINE_IDENTITY:     Client{cnf}.TlsCertMachineIdentityAuth,
+			AuthStrategy.LDAP_MACHINE_IDENTITY:         Client{cnf}.LdapMachineIdentityAuth,
 		}
 
-		token, err := authStrategies[selectedAuthStrategy]()
+		token, err := authStrategies[selectedAuthStrategy](ctx)
 		if err != nil {
 			return nil, fmt.Errorf("unable to authenticate with machine identity [err=%s]", err)
 		}
@@ -116,3 +206,12 @@ func NewClient(cnf Config) (*Client, error) {
 
 	return &Client{cnf}, nil
 }
+
+// headerNames returns the names of the custom headers, which are redacted from the request logs.
+func headerNames(headers map[string]string) []string {
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	return names
+}
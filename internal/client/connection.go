@@ -0,0 +1,82 @@
+package infisicalclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/url"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// configureConnection applies the TLS, proxy, timeout and header settings of the connection to
+// the Infisical API. Settings that are unset leave the resty defaults in place, so the system CA
+// pool is trusted and the proxy is taken from HTTP_PROXY/HTTPS_PROXY/NO_PROXY.
+func configureConnection(c *resty.Client, cnf Config) error {
+	if cnf.CACertificate != "" || cnf.CACertificatePath != "" || cnf.InsecureSkipVerify {
+		tlsConfig := &tls.Config{
+			MinVersion:         tls.VersionTLS12,
+			InsecureSkipVerify: cnf.InsecureSkipVerify,
+		}
+
+		if cnf.CACertificate != "" || cnf.CACertificatePath != "" {
+			bundle, err := pemValueOrFile(cnf.CACertificate, cnf.CACertificatePath)
+			if err != nil {
+				return fmt.Errorf("unable to read the CA certificate bundle [err=%s]", err)
+			}
+
+			// the bundle extends the system roots, so public endpoints keep working alongside an internal PKI
+			roots, err := x509.SystemCertPool()
+			if err != nil || roots == nil {
+				roots = x509.NewCertPool()
+			}
+			if !roots.AppendCertsFromPEM(bundle) {
+				return fmt.Errorf("the CA certificate bundle does not contain any PEM encoded certificate")
+			}
+			tlsConfig.RootCAs = roots
+		}
+
+		c.SetTLSClientConfig(tlsConfig)
+	}
+
+	hasClientCertificate := cnf.ClientCertificate != "" || cnf.ClientCertificatePath != ""
+	hasClientPrivateKey := cnf.ClientPrivateKey != "" || cnf.ClientPrivateKeyPath != ""
+	if hasClientCertificate || hasClientPrivateKey {
+		certificate, err := pemValueOrFile(cnf.ClientCertificate, cnf.ClientCertificatePath)
+		if err != nil {
+			return fmt.Errorf("unable to read the client certificate [err=%s]", err)
+		}
+		privateKey, err := pemValueOrFile(cnf.ClientPrivateKey, cnf.ClientPrivateKeyPath)
+		if err != nil {
+			return fmt.Errorf("unable to read the client private key [err=%s]", err)
+		}
+		keyPair, err := tls.X509KeyPair(certificate, privateKey)
+		if err != nil {
+			return fmt.Errorf("invalid client certificate or private key [err=%s]", err)
+		}
+		c.SetCertificates(keyPair)
+	}
+
+	if cnf.ProxyURL != "" {
+		proxyURL, err := url.Parse(cnf.ProxyURL)
+		if err != nil || proxyURL.Host == "" {
+			return fmt.Errorf("invalid proxy URL %q", cnf.ProxyURL)
+		}
+		switch proxyURL.Scheme {
+		case "http", "https", "socks5":
+		default:
+			return fmt.Errorf("invalid proxy URL %q: the scheme must be http, https or socks5", cnf.ProxyURL)
+		}
+		c.SetProxy(proxyURL.String())
+	}
+
+	if cnf.RequestTimeout > 0 {
+		c.SetTimeout(cnf.RequestTimeout)
+	}
+
+	if len(cnf.Headers) > 0 {
+		c.SetHeaders(cnf.Headers)
+	}
+
+	return nil
+}
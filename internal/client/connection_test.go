@@ -0,0 +1,154 @@
+package infisicalclient
+
+import (
+	"crypto/tls"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+func serverCertificatePEM(server *httptest.Server) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+}
+
+func TestConfigureConnection_TrustsTheCABundle(t *testing.T) {
+	server := httptest.NewTLSServer(jsonResponse(http.StatusOK, `{}`))
+	defer server.Close()
+
+	bundlePath := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(bundlePath, []byte(serverCertificatePEM(server)), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]Config{
+		"untrusted":            {},
+		"bundle value":         {CACertificate: serverCertificatePEM(server)},
+		"bundle file":          {CACertificatePath: bundlePath},
+		"insecure skip verify": {InsecureSkipVerify: true},
+	}
+
+	for name, cnf := range cases {
+		t.Run(name, func(t *testing.T) {
+			rc := resty.New().SetBaseURL(server.URL)
+			if err := configureConnection(rc, cnf); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			_, err := rc.R().Get("/")
+			if name == "untrusted" {
+				if err == nil {
+					t.Fatal("expected the server certificate to be rejected")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("request error: %s", err)
+			}
+		})
+	}
+}
+
+func TestConfigureConnection_PresentsTheClientCertificate(t *testing.T) {
+	certificatePEM, privateKeyPEM := generateClientCertificate(t, "api-client")
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 || r.TLS.PeerCertificates[0].Subject.CommonName != "api-client" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	rc := resty.New().SetBaseURL(server.URL)
+	err := configureConnection(rc, Config{
+		CACertificate:     serverCertificatePEM(server),
+		ClientCertificate: string(certificatePEM),
+		ClientPrivateKey:  string(privateKeyPEM),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	res, err := rc.R().Get("/")
+	if err != nil {
+		t.Fatalf("request error: %s", err)
+	}
+	if res.StatusCode() != http.StatusOK {
+		t.Fatalf("status = %d, want 200", res.StatusCode())
+	}
+}
+
+func TestConfigureConnection_SendsThroughTheProxyWithHeaders(t *testing.T) {
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// a forward proxy receives the absolute URL of the target
+		if r.URL.Host != "infisical.internal" || r.Header.Get("X-Gateway-Token") != "secret" {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer proxy.Close()
+
+	rc := resty.New().SetBaseURL("http://infisical.internal")
+	err := configureConnection(rc, Config{
+		ProxyURL: proxy.URL,
+		Headers:  map[string]string{"X-Gateway-Token": "secret"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	res, err := rc.R().Get("/api/status")
+	if err != nil {
+		t.Fatalf("request error: %s", err)
+	}
+	if res.StatusCode() != http.StatusOK {
+		t.Fatalf("status = %d, want 200", res.StatusCode())
+	}
+}
+
+func TestConfigureConnection_BoundsRequestsByTheTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	rc := resty.New().SetBaseURL(server.URL)
+	if err := configureConnection(rc, Config{RequestTimeout: 20 * time.Millisecond}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := rc.R().Get("/"); err == nil {
+		t.Fatal("expected the request to time out")
+	}
+}
+
+func TestConfigureConnection_RejectsInvalidSettings(t *testing.T) {
+	cases := map[string]struct {
+		config Config
+		want   string
+	}{
+		"ca bundle without certificates": {Config{CACertificate: "not a certificate"}, "does not contain any PEM encoded certificate"},
+		"missing ca bundle file":         {Config{CACertificatePath: filepath.Join(t.TempDir(), "missing.pem")}, "unable to read the CA certificate bundle"},
+		"certificate without key":        {Config{ClientCertificate: "certificate"}, "unable to read the client private key"},
+		"proxy without host":             {Config{ProxyURL: "proxy.internal:3128"}, "invalid proxy URL"},
+		"proxy with unsupported scheme":  {Config{ProxyURL: "ftp://proxy.internal"}, "the scheme must be http, https or socks5"},
+	}
+
+	for name, c := range cases {
+		err := configureConnection(resty.New(), c.config)
+		if err == nil || !strings.Contains(err.Error(), c.want) {
+			t.Errorf("%s: got error %v, want one containing %q", name, err, c.want)
+		}
+	}
+}
@@ -1,6 +1,6 @@
 package infisicalclient
 
-import "errors"
+import infisicalerrors "terraform-provider-infisical/internal/errors"
 
 const (
 	USER_AGENT                                              = "terraform"
@@ -17,11 +17,41 @@ const (
 	INFISICAL_KUBERNETES_SERVICE_ACCOUNT_TOKEN_PATH_NAME    = "INFISICAL_KUBERNETES_SERVICE_ACCOUNT_TOKEN_PATH"
 	INFISICAL_KUBERNETES_SERVICE_ACCOUNT_DEFAULT_TOKEN_PATH = "/var/run/secrets/kubernetes.io/serviceaccount/token"
 	INFISICAL_AUTH_METHOD_NAME                              = "INFISICAL_AUTH_METHOD"
+	INFISICAL_GCP_IAM_SERVICE_ACCOUNT_KEY_FILE_PATH_NAME    = "INFISICAL_GCP_IAM_SERVICE_ACCOUNT_KEY_FILE_PATH"
+	INFISICAL_GCP_METADATA_ENDPOINT_NAME                    = "INFISICAL_GCP_METADATA_ENDPOINT"
+	INFISICAL_AZURE_AUTH_RESOURCE_NAME                      = "INFISICAL_AZURE_AUTH_RESOURCE"
+	INFISICAL_AZURE_METADATA_ENDPOINT_NAME                  = "INFISICAL_AZURE_METADATA_ENDPOINT"
+	INFISICAL_JWT_AUTH_TOKEN_NAME                           = "INFISICAL_JWT_AUTH_TOKEN"
+	INFISICAL_JWT_AUTH_TOKEN_PATH_NAME                      = "INFISICAL_JWT_AUTH_TOKEN_PATH"
+	INFISICAL_TLS_CERT_AUTH_CERTIFICATE_NAME                = "INFISICAL_TLS_CERT_AUTH_CERTIFICATE"
+	INFISICAL_TLS_CERT_AUTH_PRIVATE_KEY_NAME                = "INFISICAL_TLS_CERT_AUTH_PRIVATE_KEY"
+	INFISICAL_TLS_CERT_AUTH_CERTIFICATE_PATH_NAME           = "INFISICAL_TLS_CERT_AUTH_CERTIFICATE_PATH"
+	INFISICAL_TLS_CERT_AUTH_PRIVATE_KEY_PATH_NAME           = "INFISICAL_TLS_CERT_AUTH_PRIVATE_KEY_PATH"
+	INFISICAL_LDAP_AUTH_USERNAME_NAME                       = "INFISICAL_LDAP_AUTH_USERNAME"
+	INFISICAL_LDAP_AUTH_PASSWORD_NAME                       = "INFISICAL_LDAP_AUTH_PASSWORD"
+	INFISICAL_CA_CERTIFICATE_NAME                           = "INFISICAL_CA_CERTIFICATE"
+	INFISICAL_CA_CERTIFICATE_PATH_NAME                      = "INFISICAL_CA_CERTIFICATE_PATH"
+	INFISICAL_CLIENT_CERTIFICATE_NAME                       = "INFISICAL_CLIENT_CERTIFICATE"
+	INFISICAL_CLIENT_PRIVATE_KEY_NAME                       = "INFISICAL_CLIENT_PRIVATE_KEY"
+	INFISICAL_CLIENT_CERTIFICATE_PATH_NAME                  = "INFISICAL_CLIENT_CERTIFICATE_PATH"
+	INFISICAL_CLIENT_PRIVATE_KEY_PATH_NAME                  = "INFISICAL_CLIENT_PRIVATE_KEY_PATH"
+	INFISICAL_INSECURE_SKIP_VERIFY_NAME                     = "INFISICAL_INSECURE_SKIP_VERIFY"
+	INFISICAL_PROXY_URL_NAME                                = "INFISICAL_PROXY_URL"
+	INFISICAL_REQUEST_TIMEOUT_NAME                          = "INFISICAL_REQUEST_TIMEOUT"
+	INFISICAL_HEADERS_NAME                                  = "INFISICAL_HEADERS"
+)
+
+const (
+	GCP_METADATA_DEFAULT_ENDPOINT        = "http://metadata.google.internal"
+	GCP_IAM_CREDENTIALS_DEFAULT_ENDPOINT = "https://iamcredentials.googleapis.com"
+	AZURE_METADATA_DEFAULT_ENDPOINT      = "http://169.254.169.254"
+	AZURE_DEFAULT_RESOURCE               = "https://management.azure.com/"
 )
 
 const AWS_MAPPING_BEHAVIOR_MANY_TO_ONE = "many-to-one"
 const AWS_MAPPING_BEHAVIOR_ONE_TO_ONE = "one-to-one"
 
 var (
-	ErrNotFound = errors.New("resource not found")
+	// ErrNotFound is returned by the client when the requested resource does not exist.
+	ErrNotFound = infisicalerrors.ErrNotFound
 )
@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"terraform-provider-infisical/internal/errors"
@@ -9,11 +10,21 @@ import (
 type DynamicSecretProvider string
 
 const (
-	DynamicSecretProviderSQLDatabase DynamicSecretProvider = "sql-database"
-	DynamicSecretProviderAWSIAM      DynamicSecretProvider = "aws-iam"
-	DynamicSecretProviderKubernetes  DynamicSecretProvider = "kubernetes"
-	DynamicSecretProviderMongoAtlas  DynamicSecretProvider = "mongo-db-atlas"
-	DynamicSecretProviderMongoDb     DynamicSecretProvider = "mongo-db"
+	DynamicSecretProviderSQLDatabase   DynamicSecretProvider = "sql-database"
+	DynamicSecretProviderAWSIAM        DynamicSecretProvider = "aws-iam"
+	DynamicSecretProviderKubernetes    DynamicSecretProvider = "kubernetes"
+	DynamicSecretProviderMongoAtlas    DynamicSecretProvider = "mongo-db-atlas"
+	DynamicSecretProviderMongoDb       DynamicSecretProvider = "mongo-db"
+	DynamicSecretProviderRedis         DynamicSecretProvider = "redis"
+	DynamicSecretProviderCassandra     DynamicSecretProvider = "cassandra"
+	DynamicSecretProviderElasticSearch DynamicSecretProvider = "elastic-search"
+	DynamicSecretProviderRabbitMq      DynamicSecretProvider = "rabbit-mq"
+	DynamicSecretProviderSnowflake     DynamicSecretProvider = "snowflake"
+	DynamicSecretProviderSapHana       DynamicSecretProvider = "sap-hana"
+	DynamicSecretProviderAzureEntraId  DynamicSecretProvider = "azure-entra-id"
+	DynamicSecretProviderGcpIam        DynamicSecretProvider = "gcp-iam"
+	DynamicSecretProviderLdap          DynamicSecretProvider = "ldap"
+	DynamicSecretProviderTotp          DynamicSecretProvider = "totp"
 )
 
 const (
@@ -23,10 +34,10 @@ const (
 	operationDeleteDynamicSecret    = "CallDeleteDynamicSecret"
 )
 
-func (client Client) CreateDynamicSecret(request CreateDynamicSecretRequest) (DynamicSecret, error) {
+func (client Client) CreateDynamicSecret(ctx context.Context, request CreateDynamicSecretRequest) (DynamicSecret, error) {
 	var body CreateDynamicSecretResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -43,10 +54,10 @@ func (client Client) CreateDynamicSecret(request CreateDynamicSecretRequest) (Dy
 	return body.DynamicSecret, nil
 }
 
-func (client Client) GetDynamicSecretByName(request GetDynamicSecretByNameRequest) (DynamicSecret, error) {
+func (client Client) GetDynamicSecretByName(ctx context.Context, request GetDynamicSecretByNameRequest) (DynamicSecret, error) {
 	var body GetDynamicSecretByNameResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetQueryParam("projectSlug", request.ProjectSlug).
@@ -69,10 +80,10 @@ func (client Client) GetDynamicSecretByName(request GetDynamicSecretByNameReques
 	return body.DynamicSecret, nil
 }
 
-func (client Client) UpdateDynamicSecret(request UpdateDynamicSecretRequest) (DynamicSecret, error) {
+func (client Client) UpdateDynamicSecret(ctx context.Context, request UpdateDynamicSecretRequest) (DynamicSecret, error) {
 	var body UpdateDynamicSecretResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -89,10 +100,10 @@ func (client Client) UpdateDynamicSecret(request UpdateDynamicSecretRequest) (Dy
 	return body.DynamicSecret, nil
 }
 
-func (client Client) DeleteDynamicSecret(request DeleteDynamicSecretRequest) (DynamicSecret, error) {
+func (client Client) DeleteDynamicSecret(ctx context.Context, request DeleteDynamicSecretRequest) (DynamicSecret, error) {
 	var body DeleteDynamicSecretResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetBody(request).
 		SetHeader("User-Agent", USER_AGENT).
@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"terraform-provider-infisical/internal/errors"
@@ -19,10 +20,10 @@ const (
 	operationDeleteExternalKms  = "CallDeleteExternalKms"
 )
 
-func (client Client) CreateExternalKms(request CreateExternalKmsRequest) (ExternalKmsWithKey, error) {
+func (client Client) CreateExternalKms(ctx context.Context, request CreateExternalKmsRequest) (ExternalKmsWithKey, error) {
 	var body ExternalKmsWithKey
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -39,10 +40,10 @@ func (client Client) CreateExternalKms(request CreateExternalKmsRequest) (Extern
 	return body, nil
 }
 
-func (client Client) GetExternalKmsById(request GetExternalKmsByIdRequest) (ExternalKmsWithKey, error) {
+func (client Client) GetExternalKmsById(ctx context.Context, request GetExternalKmsByIdRequest) (ExternalKmsWithKey, error) {
 	var body ExternalKmsWithKey
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		Get(fmt.Sprintf("api/v1/external-kms/%s/%s", request.Provider, request.ID))
@@ -61,10 +62,10 @@ func (client Client) GetExternalKmsById(request GetExternalKmsByIdRequest) (Exte
 	return body, nil
 }
 
-func (client Client) UpdateExternalKms(request UpdateExternalKmsRequest) (ExternalKmsWithKey, error) {
+func (client Client) UpdateExternalKms(ctx context.Context, request UpdateExternalKmsRequest) (ExternalKmsWithKey, error) {
 	var body ExternalKmsWithKey
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -81,10 +82,10 @@ func (client Client) UpdateExternalKms(request UpdateExternalKmsRequest) (Extern
 	return body, nil
 }
 
-func (client Client) DeleteExternalKms(request DeleteExternalKmsRequest) (ExternalKmsWithKey, error) {
+func (client Client) DeleteExternalKms(ctx context.Context, request DeleteExternalKmsRequest) (ExternalKmsWithKey, error) {
 	var body ExternalKmsWithKey
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		Delete(fmt.Sprintf("api/v1/external-kms/%s/%s", request.Provider, request.ID))
@@ -1,36 +1,40 @@
 package infisicalclient
 
 import (
+	"context"
 	"terraform-provider-infisical/internal/errors"
 )
 
 const operationListGateways = "CallListGateways"
 
 // ListGateways returns the gateways in the machine identity's organization.
-func (client Client) ListGateways() ([]Gateway, error) {
-	var gateways []Gateway
-	response, err := client.Config.HttpClient.
-		R().
-		SetResult(&gateways).
-		SetHeader("User-Agent", USER_AGENT).
-		Get("api/v2/gateways")
+func (client Client) ListGateways(ctx context.Context) ([]Gateway, error) {
+	result, err := paginate(defaultPageSize, ListOptions{}, func(offset, limit int) ([]Gateway, int, error) {
+		var gateways []Gateway
+		response, err := client.cachedGet(ctx, "api/v2/gateways", pageQuery(offset, limit), &gateways)
 
-	if err != nil {
-		return nil, errors.NewGenericRequestError(operationListGateways, err)
-	}
+		if err != nil {
+			return nil, 0, errors.NewGenericRequestError(operationListGateways, err)
+		}
 
-	if response.IsError() {
-		return nil, errors.NewAPIErrorWithResponse(operationListGateways, response, nil)
+		if response.IsError() {
+			return nil, 0, errors.NewAPIErrorWithResponse(operationListGateways, response, nil)
+		}
+
+		return gateways, unknownTotalCount, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return gateways, nil
+	return result.Items, nil
 }
 
 // GetGatewayByName resolves a gateway by name. Names are unique per organization, so a name
 // identifies at most one gateway. ErrNotFound is returned only when the list was retrieved and holds
 // no match, since a list that never arrived cannot prove the gateway's absence.
-func (client Client) GetGatewayByName(name string) (Gateway, error) {
-	gateways, err := client.ListGateways()
+func (client Client) GetGatewayByName(ctx context.Context, name string) (Gateway, error) {
+	gateways, err := client.ListGateways(ctx)
 	if err != nil {
 		return Gateway{}, err
 	}
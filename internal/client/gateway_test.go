@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"net/http/httptest"
@@ -33,7 +34,7 @@ func TestGetGatewayByNameResolves(t *testing.T) {
 		`[{"id":"11111111-1111-1111-1111-111111111111","name":"staging-gateway"},`+
 			`{"id":"22222222-2222-2222-2222-222222222222","name":"prod-gateway"}]`))
 
-	gateway, err := client.GetGatewayByName("prod-gateway")
+	gateway, err := client.GetGatewayByName(context.Background(), "prod-gateway")
 	if err != nil {
 		t.Fatalf("expected the gateway to resolve, got: %v", err)
 	}
@@ -51,7 +52,7 @@ func TestGetGatewayByNameMatchesExactly(t *testing.T) {
 	client := gatewayLookupServer(t, jsonResponse(http.StatusOK,
 		`[{"id":"33333333-3333-3333-3333-333333333333","name":"Prod-Gateway"}]`))
 
-	if _, err := client.GetGatewayByName("prod-gateway"); !errors.Is(err, ErrNotFound) {
+	if _, err := client.GetGatewayByName(context.Background(), "prod-gateway"); !errors.Is(err, ErrNotFound) {
 		t.Fatalf("expected ErrNotFound for a case-mismatched name, got: %v", err)
 	}
 }
@@ -67,7 +68,7 @@ func TestGetGatewayByNameNotFound(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			client := gatewayLookupServer(t, jsonResponse(http.StatusOK, body))
 
-			if _, err := client.GetGatewayByName("prod-gateway"); !errors.Is(err, ErrNotFound) {
+			if _, err := client.GetGatewayByName(context.Background(), "prod-gateway"); !errors.Is(err, ErrNotFound) {
 				t.Fatalf("expected ErrNotFound when the list arrived without a match, got: %v", err)
 			}
 		})
@@ -95,7 +96,7 @@ func TestGetGatewayByNameListFailureIsNotAbsence(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			client := gatewayLookupServer(t, jsonResponse(tc.status, tc.body))
 
-			_, err := client.GetGatewayByName("prod-gateway")
+			_, err := client.GetGatewayByName(context.Background(), "prod-gateway")
 			if err == nil {
 				t.Fatal("expected an error when the gateway list could not be retrieved")
 			}
@@ -107,7 +108,7 @@ func TestGetGatewayByNameListFailureIsNotAbsence(t *testing.T) {
 			if len(apiErrors) == 0 {
 				t.Fatalf("the underlying API failure must stay classifiable, got: %v", err)
 			}
-			if apiErrors[0].StatusCode != tc.status {
+			if int(apiErrors[0].StatusCode) != tc.status {
 				t.Errorf("expected status %d to be discoverable, got %d", tc.status, apiErrors[0].StatusCode)
 			}
 		})
@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"terraform-provider-infisical/internal/errors"
@@ -14,10 +15,10 @@ const (
 	operationGetGroups    = "CallGetGroups"
 )
 
-func (client Client) CreateGroup(request CreateGroupRequest) (Group, error) {
+func (client Client) CreateGroup(ctx context.Context, request CreateGroupRequest) (Group, error) {
 	var groupResponse Group
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&groupResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -34,10 +35,10 @@ func (client Client) CreateGroup(request CreateGroupRequest) (Group, error) {
 	return groupResponse, nil
 }
 
-func (client Client) UpdateGroup(request UpdateGroupRequest) (Group, error) {
+func (client Client) UpdateGroup(ctx context.Context, request UpdateGroupRequest) (Group, error) {
 	var groupResponse Group
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&groupResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -54,10 +55,10 @@ func (client Client) UpdateGroup(request UpdateGroupRequest) (Group, error) {
 	return groupResponse, nil
 }
 
-func (client Client) DeleteGroup(request DeleteGroupRequest) (Group, error) {
+func (client Client) DeleteGroup(ctx context.Context, request DeleteGroupRequest) (Group, error) {
 	var groupResponse Group
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&groupResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Delete(fmt.Sprintf("api/v1/groups/%s", request.ID))
@@ -73,14 +74,14 @@ func (client Client) DeleteGroup(request DeleteGroupRequest) (Group, error) {
 	return groupResponse, nil
 }
 
-func (client Client) GetGroupById(request GetGroupByIdRequest) (Group, error) {
+func (client Client) GetGroupById(ctx context.Context, request GetGroupByIdRequest) (Group, error) {
 	if request.ID == "" {
 		return Group{}, ErrNotFound
 	}
 
 	var groupResponse Group
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&groupResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Get(fmt.Sprintf("api/v1/groups/%s", request.ID))
@@ -100,23 +101,25 @@ func (client Client) GetGroupById(request GetGroupByIdRequest) (Group, error) {
 	return groupResponse, nil
 }
 
-func (client Client) GetGroups() (GetGroupsResponse, error) {
-	var body GetGroupsResponse
-
-	httpRequest := client.Config.HttpClient.
-		R().
-		SetResult(&body).
-		SetHeader("User-Agent", USER_AGENT)
-
-	response, err := httpRequest.Get("api/v1/groups")
-
-	if err != nil {
-		return GetGroupsResponse{}, errors.NewGenericRequestError(operationGetGroups, err)
-	}
-
-	if response.IsError() {
-		return GetGroupsResponse{}, errors.NewAPIErrorWithResponse(operationGetGroups, response, nil)
-	}
-
-	return body, nil
+// GetGroups returns the groups of the machine identity's organization, up to opts.MaxResults.
+func (client Client) GetGroups(ctx context.Context, opts ListOptions) (ListResult[Group], error) {
+	return paginate(defaultPageSize, opts, func(offset, limit int) ([]Group, int, error) {
+		var body GetGroupsResponse
+		response, err := client.Config.HttpClient.
+			R().SetContext(ctx).
+			SetResult(&body).
+			SetHeader("User-Agent", USER_AGENT).
+			SetQueryParams(pageQuery(offset, limit)).
+			Get("api/v1/groups")
+
+		if err != nil {
+			return nil, 0, errors.NewGenericRequestError(operationGetGroups, err)
+		}
+
+		if response.IsError() {
+			return nil, 0, errors.NewAPIErrorWithResponse(operationGetGroups, response, nil)
+		}
+
+		return body, unknownTotalCount, nil
+	})
 }
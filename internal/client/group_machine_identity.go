@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"terraform-provider-infisical/internal/errors"
@@ -12,10 +13,10 @@ const (
 	operationRemoveGroupMachineIdentity = "CallRemoveGroupMachineIdentity"
 )
 
-func (client Client) AddGroupMachineIdentity(request AddGroupMachineIdentityRequest) (AddGroupMachineIdentityResponse, error) {
+func (client Client) AddGroupMachineIdentity(ctx context.Context, request AddGroupMachineIdentityRequest) (AddGroupMachineIdentityResponse, error) {
 	var responseData AddGroupMachineIdentityResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&responseData).
 		SetHeader("User-Agent", USER_AGENT).
 		Post(fmt.Sprintf("api/v1/groups/%s/machine-identities/%s", request.GroupID, request.IdentityID))
@@ -31,10 +32,10 @@ func (client Client) AddGroupMachineIdentity(request AddGroupMachineIdentityRequ
 	return responseData, nil
 }
 
-func (client Client) ListGroupMachineIdentities(request ListGroupMachineIdentitiesRequest) (ListGroupMachineIdentitiesResponse, error) {
+func (client Client) ListGroupMachineIdentities(ctx context.Context, request ListGroupMachineIdentitiesRequest) (ListGroupMachineIdentitiesResponse, error) {
 	var responseData ListGroupMachineIdentitiesResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&responseData).
 		SetHeader("User-Agent", USER_AGENT).
 		Get(fmt.Sprintf("api/v1/groups/%s/machine-identities", request.GroupID))
@@ -54,10 +55,10 @@ func (client Client) ListGroupMachineIdentities(request ListGroupMachineIdentiti
 	return responseData, nil
 }
 
-func (client Client) RemoveGroupMachineIdentity(request RemoveGroupMachineIdentityRequest) (RemoveGroupMachineIdentityResponse, error) {
+func (client Client) RemoveGroupMachineIdentity(ctx context.Context, request RemoveGroupMachineIdentityRequest) (RemoveGroupMachineIdentityResponse, error) {
 	var responseData RemoveGroupMachineIdentityResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&responseData).
 		SetHeader("User-Agent", USER_AGENT).
 		Delete(fmt.Sprintf("api/v1/groups/%s/machine-identities/%s", request.GroupID, request.IdentityID))
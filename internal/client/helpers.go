@@ -62,6 +62,11 @@ func (e *NotFoundError) Error() string {
 	return fmt.Sprintf("%s with ID %s not found", e.Resource, e.ID)
 }
 
+// Is matches a NotFoundError against ErrNotFound, so it is handled like any missing resource.
+func (e *NotFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
 // A helper function to create a new NotFoundError.
 func NewNotFoundError(resource, id string) error {
 	return &NotFoundError{
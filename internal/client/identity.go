@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"net/http"
 	"terraform-provider-infisical/internal/errors"
 )
@@ -12,11 +13,11 @@ const (
 	operationDeleteIdentity = "CallDeleteIdentity"
 )
 
-func (client Client) GetIdentity(request GetIdentityRequest) (OrgIdentity, error) {
+func (client Client) GetIdentity(ctx context.Context, request GetIdentityRequest) (OrgIdentity, error) {
 	var body GetIdentityResponse
 
 	httpRequest := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT)
 
@@ -37,10 +38,10 @@ func (client Client) GetIdentity(request GetIdentityRequest) (OrgIdentity, error
 	return body.Identity, nil
 }
 
-func (client Client) CreateIdentity(request CreateIdentityRequest) (CreateIdentityResponse, error) {
+func (client Client) CreateIdentity(ctx context.Context, request CreateIdentityRequest) (CreateIdentityResponse, error) {
 	var body CreateIdentityResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -57,10 +58,10 @@ func (client Client) CreateIdentity(request CreateIdentityRequest) (CreateIdenti
 	return body, nil
 }
 
-func (client Client) UpdateIdentity(request UpdateIdentityRequest) (UpdateIdentityResponse, error) {
+func (client Client) UpdateIdentity(ctx context.Context, request UpdateIdentityRequest) (UpdateIdentityResponse, error) {
 	var body UpdateIdentityResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -77,10 +78,10 @@ func (client Client) UpdateIdentity(request UpdateIdentityRequest) (UpdateIdenti
 	return body, nil
 }
 
-func (client Client) DeleteIdentity(request DeleteIdentityRequest) (DeleteIdentityResponse, error) {
+func (client Client) DeleteIdentity(ctx context.Context, request DeleteIdentityRequest) (DeleteIdentityResponse, error) {
 	var body DeleteIdentityResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
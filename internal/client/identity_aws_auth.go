@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"net/http"
 	"terraform-provider-infisical/internal/errors"
 )
@@ -12,11 +13,11 @@ const (
 	operationRevokeIdentityAwsAuth = "CallRevokeIdentityAwsAuth"
 )
 
-func (client Client) GetIdentityAwsAuth(request GetIdentityAwsAuthRequest) (IdentityAwsAuth, error) {
+func (client Client) GetIdentityAwsAuth(ctx context.Context, request GetIdentityAwsAuthRequest) (IdentityAwsAuth, error) {
 	var body GetIdentityAwsAuthResponse
 
 	httpRequest := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT)
 
@@ -37,10 +38,10 @@ func (client Client) GetIdentityAwsAuth(request GetIdentityAwsAuthRequest) (Iden
 	return body.IdentityAwsAuth, nil
 }
 
-func (client Client) CreateIdentityAwsAuth(request CreateIdentityAwsAuthRequest) (IdentityAwsAuth, error) {
+func (client Client) CreateIdentityAwsAuth(ctx context.Context, request CreateIdentityAwsAuthRequest) (IdentityAwsAuth, error) {
 	var body CreateIdentityAwsAuthResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -57,10 +58,10 @@ func (client Client) CreateIdentityAwsAuth(request CreateIdentityAwsAuthRequest)
 	return body.IdentityAwsAuth, nil
 }
 
-func (client Client) UpdateIdentityAwsAuth(request UpdateIdentityAwsAuthRequest) (IdentityAwsAuth, error) {
+func (client Client) UpdateIdentityAwsAuth(ctx context.Context, request UpdateIdentityAwsAuthRequest) (IdentityAwsAuth, error) {
 	var body UpdateIdentityAwsAuthResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -77,10 +78,10 @@ func (client Client) UpdateIdentityAwsAuth(request UpdateIdentityAwsAuthRequest)
 	return body.IdentityAwsAuth, nil
 }
 
-func (client Client) RevokeIdentityAwsAuth(request RevokeIdentityAwsAuthRequest) (IdentityAwsAuth, error) {
+func (client Client) RevokeIdentityAwsAuth(ctx context.Context, request RevokeIdentityAwsAuthRequest) (IdentityAwsAuth, error) {
 	var body RevokeIdentityAwsAuthResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
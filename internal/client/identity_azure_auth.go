@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"net/http"
 	"terraform-provider-infisical/internal/errors"
 )
@@ -12,11 +13,11 @@ const (
 	operationRevokeIdentityAzureAuth = "CallRevokeIdentityAzureAuth"
 )
 
-func (client Client) GetIdentityAzureAuth(request GetIdentityAzureAuthRequest) (IdentityAzureAuth, error) {
+func (client Client) GetIdentityAzureAuth(ctx context.Context, request GetIdentityAzureAuthRequest) (IdentityAzureAuth, error) {
 	var body GetIdentityAzureAuthResponse
 
 	httpRequest := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT)
 
@@ -37,10 +38,10 @@ func (client Client) GetIdentityAzureAuth(request GetIdentityAzureAuthRequest) (
 	return body.IdentityAzureAuth, nil
 }
 
-func (client Client) CreateIdentityAzureAuth(request CreateIdentityAzureAuthRequest) (IdentityAzureAuth, error) {
+func (client Client) CreateIdentityAzureAuth(ctx context.Context, request CreateIdentityAzureAuthRequest) (IdentityAzureAuth, error) {
 	var body CreateIdentityAzureAuthResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -57,10 +58,10 @@ func (client Client) CreateIdentityAzureAuth(request CreateIdentityAzureAuthRequ
 	return body.IdentityAzureAuth, nil
 }
 
-func (client Client) UpdateIdentityAzureAuth(request UpdateIdentityAzureAuthRequest) (IdentityAzureAuth, error) {
+func (client Client) UpdateIdentityAzureAuth(ctx context.Context, request UpdateIdentityAzureAuthRequest) (IdentityAzureAuth, error) {
 	var body UpdateIdentityAzureAuthResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -77,10 +78,10 @@ func (client Client) UpdateIdentityAzureAuth(request UpdateIdentityAzureAuthRequ
 	return body.IdentityAzureAuth, nil
 }
 
-func (client Client) RevokeIdentityAzureAuth(request RevokeIdentityAzureAuthRequest) (IdentityAzureAuth, error) {
+func (client Client) RevokeIdentityAzureAuth(ctx context.Context, request RevokeIdentityAzureAuthRequest) (IdentityAzureAuth, error) {
 	var body RevokeIdentityAzureAuthResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
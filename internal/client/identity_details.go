@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"terraform-provider-infisical/internal/errors"
 )
 
@@ -8,15 +9,9 @@ const (
 	operationGetIdentityDetails = "CallGetIdentityDetails"
 )
 
-func (client Client) GetIdentityDetails() (GetIdentityDetailsResponse, error) {
+func (client Client) GetIdentityDetails(ctx context.Context) (GetIdentityDetailsResponse, error) {
 	var body GetIdentityDetailsResponse
-
-	httpRequest := client.Config.HttpClient.
-		R().
-		SetResult(&body).
-		SetHeader("User-Agent", USER_AGENT)
-
-	response, err := httpRequest.Get("api/v1/identities/details")
+	response, err := client.cachedGet(ctx, "api/v1/identities/details", nil, &body)
 
 	if err != nil {
 		return GetIdentityDetailsResponse{}, errors.NewGenericRequestError(operationGetIdentityDetails, err)
@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"net/http"
 	"terraform-provider-infisical/internal/errors"
 )
@@ -12,11 +13,11 @@ const (
 	operationRevokeIdentityGcpAuth = "CallRevokeIdentityGcpAuth"
 )
 
-func (client Client) GetIdentityGcpAuth(request GetIdentityGcpAuthRequest) (IdentityGcpAuth, error) {
+func (client Client) GetIdentityGcpAuth(ctx context.Context, request GetIdentityGcpAuthRequest) (IdentityGcpAuth, error) {
 	var body GetIdentityGcpAuthResponse
 
 	httpRequest := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT)
 
@@ -37,10 +38,10 @@ func (client Client) GetIdentityGcpAuth(request GetIdentityGcpAuthRequest) (Iden
 	return body.IdentityGcpAuth, nil
 }
 
-func (client Client) CreateIdentityGcpAuth(request CreateIdentityGcpAuthRequest) (IdentityGcpAuth, error) {
+func (client Client) CreateIdentityGcpAuth(ctx context.Context, request CreateIdentityGcpAuthRequest) (IdentityGcpAuth, error) {
 	var body CreateIdentityGcpAuthResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -57,10 +58,10 @@ func (client Client) CreateIdentityGcpAuth(request CreateIdentityGcpAuthRequest)
 	return body.IdentityGcpAuth, nil
 }
 
-func (client Client) UpdateIdentityGcpAuth(request UpdateIdentityGcpAuthRequest) (IdentityGcpAuth, error) {
+func (client Client) UpdateIdentityGcpAuth(ctx context.Context, request UpdateIdentityGcpAuthRequest) (IdentityGcpAuth, error) {
 	var body UpdateIdentityGcpAuthResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -77,10 +78,10 @@ func (client Client) UpdateIdentityGcpAuth(request UpdateIdentityGcpAuthRequest)
 	return body.IdentityGcpAuth, nil
 }
 
-func (client Client) RevokeIdentityGcpAuth(request RevokeIdentityGcpAuthRequest) (IdentityGcpAuth, error) {
+func (client Client) RevokeIdentityGcpAuth(ctx context.Context, request RevokeIdentityGcpAuthRequest) (IdentityGcpAuth, error) {
 	var body RevokeIdentityGcpAuthResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
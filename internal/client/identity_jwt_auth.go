@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"net/http"
 	"terraform-provider-infisical/internal/errors"
 )
@@ -12,10 +13,10 @@ const (
 	operationRevokeIdentityJwtAuth = "CallRevokeIdentityJwtAuth"
 )
 
-func (client Client) CreateIdentityJwtAuth(request IdentityJwtAuthRequest) (IdentityJwtAuth, error) {
+func (client Client) CreateIdentityJwtAuth(ctx context.Context, request IdentityJwtAuthRequest) (IdentityJwtAuth, error) {
 	var body IdentityJwtAuthResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -32,11 +33,11 @@ func (client Client) CreateIdentityJwtAuth(request IdentityJwtAuthRequest) (Iden
 	return body.IdentityJwtAuth, nil
 }
 
-func (client Client) GetIdentityJwtAuth(request GetIdentityJwtAuthRequest) (IdentityJwtAuth, error) {
+func (client Client) GetIdentityJwtAuth(ctx context.Context, request GetIdentityJwtAuthRequest) (IdentityJwtAuth, error) {
 	var body IdentityJwtAuthResponse
 
 	httpRequest := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT)
 
@@ -57,10 +58,10 @@ func (client Client) GetIdentityJwtAuth(request GetIdentityJwtAuthRequest) (Iden
 	return body.IdentityJwtAuth, nil
 }
 
-func (client Client) UpdateIdentityJwtAuth(request IdentityJwtAuthRequest) (IdentityJwtAuth, error) {
+func (client Client) UpdateIdentityJwtAuth(ctx context.Context, request IdentityJwtAuthRequest) (IdentityJwtAuth, error) {
 	var body IdentityJwtAuthResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -77,10 +78,10 @@ func (client Client) UpdateIdentityJwtAuth(request IdentityJwtAuthRequest) (Iden
 	return body.IdentityJwtAuth, nil
 }
 
-func (client Client) RevokeIdentityJwtAuth(request RevokeIdentityJwtAuthRequest) (IdentityJwtAuth, error) {
+func (client Client) RevokeIdentityJwtAuth(ctx context.Context, request RevokeIdentityJwtAuthRequest) (IdentityJwtAuth, error) {
 	var body IdentityJwtAuthResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
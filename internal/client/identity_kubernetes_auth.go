@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"net/http"
 	"terraform-provider-infisical/internal/errors"
 )
@@ -12,11 +13,11 @@ const (
 	operationRevokeIdentityKubernetesAuth = "CallRevokeIdentityKubernetesAuth"
 )
 
-func (client Client) GetIdentityKubernetesAuth(request GetIdentityKubernetesAuthRequest) (IdentityKubernetesAuth, error) {
+func (client Client) GetIdentityKubernetesAuth(ctx context.Context, request GetIdentityKubernetesAuthRequest) (IdentityKubernetesAuth, error) {
 	var body GetIdentityKubernetesAuthResponse
 
 	httpRequest := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT)
 
@@ -37,10 +38,10 @@ func (client Client) GetIdentityKubernetesAuth(request GetIdentityKubernetesAuth
 	return body.IdentityKubernetesAuth, nil
 }
 
-func (client Client) CreateIdentityKubernetesAuth(request CreateIdentityKubernetesAuthRequest) (IdentityKubernetesAuth, error) {
+func (client Client) CreateIdentityKubernetesAuth(ctx context.Context, request CreateIdentityKubernetesAuthRequest) (IdentityKubernetesAuth, error) {
 	var body CreateIdentityKubernetesAuthResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -57,10 +58,10 @@ func (client Client) CreateIdentityKubernetesAuth(request CreateIdentityKubernet
 	return body.IdentityKubernetesAuth, nil
 }
 
-func (client Client) UpdateIdentityKubernetesAuth(request UpdateIdentityKubernetesAuthRequest) (IdentityKubernetesAuth, error) {
+func (client Client) UpdateIdentityKubernetesAuth(ctx context.Context, request UpdateIdentityKubernetesAuthRequest) (IdentityKubernetesAuth, error) {
 	var body UpdateIdentityKubernetesAuthResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -77,10 +78,10 @@ func (client Client) UpdateIdentityKubernetesAuth(request UpdateIdentityKubernet
 	return body.IdentityKubernetesAuth, nil
 }
 
-func (client Client) RevokeIdentityKubernetesAuth(request RevokeIdentityKubernetesAuthRequest) (IdentityKubernetesAuth, error) {
+func (client Client) RevokeIdentityKubernetesAuth(ctx context.Context, request RevokeIdentityKubernetesAuthRequest) (IdentityKubernetesAuth, error) {
 	var body RevokeIdentityKubernetesAuthResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"net/http"
 	"terraform-provider-infisical/internal/errors"
 )
@@ -12,10 +13,10 @@ const (
 	operationRevokeIdentityOidcAuth = "CallRevokeIdentityOidcAuth"
 )
 
-func (client Client) CreateIdentityOidcAuth(request CreateIdentityOidcAuthRequest) (IdentityOidcAuth, error) {
+func (client Client) CreateIdentityOidcAuth(ctx context.Context, request CreateIdentityOidcAuthRequest) (IdentityOidcAuth, error) {
 	var body CreateIdentityOidcAuthResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -32,11 +33,11 @@ func (client Client) CreateIdentityOidcAuth(request CreateIdentityOidcAuthReques
 	return body.IdentityOidcAuth, nil
 }
 
-func (client Client) GetIdentityOidcAuth(request GetIdentityOidcAuthRequest) (IdentityOidcAuth, error) {
+func (client Client) GetIdentityOidcAuth(ctx context.Context, request GetIdentityOidcAuthRequest) (IdentityOidcAuth, error) {
 	var body GetIdentityOidcAuthResponse
 
 	httpRequest := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT)
 
@@ -57,10 +58,10 @@ func (client Client) GetIdentityOidcAuth(request GetIdentityOidcAuthRequest) (Id
 	return body.IdentityOidcAuth, nil
 }
 
-func (client Client) UpdateIdentityOidcAuth(request UpdateIdentityOidcAuthRequest) (IdentityOidcAuth, error) {
+func (client Client) UpdateIdentityOidcAuth(ctx context.Context, request UpdateIdentityOidcAuthRequest) (IdentityOidcAuth, error) {
 	var body UpdateIdentityOidcAuthResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -77,10 +78,10 @@ func (client Client) UpdateIdentityOidcAuth(request UpdateIdentityOidcAuthReques
 	return body.IdentityOidcAuth, nil
 }
 
-func (client Client) RevokeIdentityOidcAuth(request RevokeIdentityOidcAuthRequest) (IdentityOidcAuth, error) {
+func (client Client) RevokeIdentityOidcAuth(ctx context.Context, request RevokeIdentityOidcAuthRequest) (IdentityOidcAuth, error) {
 	var body RevokeIdentityOidcAuthResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"net/http"
 	"terraform-provider-infisical/internal/errors"
 )
@@ -12,11 +13,11 @@ const (
 	operationRevokeIdentityTlsCertAuth = "CallRevokeIdentityTlsCertAuth"
 )
 
-func (client Client) GetIdentityTlsCertAuth(request GetIdentityTlsCertAuthRequest) (IdentityTlsCertAuth, error) {
+func (client Client) GetIdentityTlsCertAuth(ctx context.Context, request GetIdentityTlsCertAuthRequest) (IdentityTlsCertAuth, error) {
 	var body GetIdentityTlsCertAuthResponse
 
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		Get("api/v1/auth/tls-cert-auth/identities/" + request.IdentityID)
@@ -36,10 +37,10 @@ func (client Client) GetIdentityTlsCertAuth(request GetIdentityTlsCertAuthReques
 	return body.IdentityTlsCertAuth, nil
 }
 
-func (client Client) CreateIdentityTlsCertAuth(request CreateIdentityTlsCertAuthRequest) (IdentityTlsCertAuth, error) {
+func (client Client) CreateIdentityTlsCertAuth(ctx context.Context, request CreateIdentityTlsCertAuthRequest) (IdentityTlsCertAuth, error) {
 	var body CreateIdentityTlsCertAuthResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -56,10 +57,10 @@ func (client Client) CreateIdentityTlsCertAuth(request CreateIdentityTlsCertAuth
 	return body.IdentityTlsCertAuth, nil
 }
 
-func (client Client) UpdateIdentityTlsCertAuth(request UpdateIdentityTlsCertAuthRequest) (IdentityTlsCertAuth, error) {
+func (client Client) UpdateIdentityTlsCertAuth(ctx context.Context, request UpdateIdentityTlsCertAuthRequest) (IdentityTlsCertAuth, error) {
 	var body UpdateIdentityTlsCertAuthResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -76,10 +77,10 @@ func (client Client) UpdateIdentityTlsCertAuth(request UpdateIdentityTlsCertAuth
 	return body.IdentityTlsCertAuth, nil
 }
 
-func (client Client) RevokeIdentityTlsCertAuth(request RevokeIdentityTlsCertAuthRequest) (IdentityTlsCertAuth, error) {
+func (client Client) RevokeIdentityTlsCertAuth(ctx context.Context, request RevokeIdentityTlsCertAuthRequest) (IdentityTlsCertAuth, error) {
 	var body RevokeIdentityTlsCertAuthResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
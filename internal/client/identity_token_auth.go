@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"net/http"
 	"terraform-provider-infisical/internal/errors"
 )
@@ -12,11 +13,11 @@ const (
 	operationRevokeIdentityTokenAuth = "CallRevokeIdentityTokenAuth"
 )
 
-func (client Client) GetIdentityTokenAuth(request GetIdentityTokenAuthRequest) (IdentityTokenAuth, error) {
+func (client Client) GetIdentityTokenAuth(ctx context.Context, request GetIdentityTokenAuthRequest) (IdentityTokenAuth, error) {
 	var body GetIdentityTokenAuthResponse
 
 	httpRequest := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT)
 
@@ -37,10 +38,10 @@ func (client Client) GetIdentityTokenAuth(request GetIdentityTokenAuthRequest) (
 	return body.IdentityTokenAuth, nil
 }
 
-func (client Client) CreateIdentityTokenAuth(request CreateIdentityTokenAuthRequest) (IdentityTokenAuth, error) {
+func (client Client) CreateIdentityTokenAuth(ctx context.Context, request CreateIdentityTokenAuthRequest) (IdentityTokenAuth, error) {
 	var body CreateIdentityTokenAuthResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -57,10 +58,10 @@ func (client Client) CreateIdentityTokenAuth(request CreateIdentityTokenAuthRequ
 	return body.IdentityTokenAuth, nil
 }
 
-func (client Client) UpdateIdentityTokenAuth(request UpdateIdentityTokenAuthRequest) (IdentityTokenAuth, error) {
+func (client Client) UpdateIdentityTokenAuth(ctx context.Context, request UpdateIdentityTokenAuthRequest) (IdentityTokenAuth, error) {
 	var body UpdateIdentityTokenAuthResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -77,10 +78,10 @@ func (client Client) UpdateIdentityTokenAuth(request UpdateIdentityTokenAuthRequ
 	return body.IdentityTokenAuth, nil
 }
 
-func (client Client) RevokeIdentityTokenAuth(request RevokeIdentityTokenAuthRequest) (IdentityTokenAuth, error) {
+func (client Client) RevokeIdentityTokenAuth(ctx context.Context, request RevokeIdentityTokenAuthRequest) (IdentityTokenAuth, error) {
 	var body RevokeIdentityTokenAuthResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
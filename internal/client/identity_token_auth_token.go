@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"terraform-provider-infisical/internal/errors"
@@ -13,11 +14,11 @@ const (
 	operationRevokeIdentityTokenAuthToken = "CallRevokeIdentityTokenAuthToken"
 )
 
-func (client Client) GetIdentityTokenAuthToken(request GetIdentityTokenAuthTokenRequest) (IdentityTokenAuthToken, error) {
+func (client Client) GetIdentityTokenAuthToken(ctx context.Context, request GetIdentityTokenAuthTokenRequest) (IdentityTokenAuthToken, error) {
 	var body GetIdentityTokenAuthTokenResponse
 
 	httpRequest := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT)
 
@@ -38,10 +39,10 @@ func (client Client) GetIdentityTokenAuthToken(request GetIdentityTokenAuthToken
 	return body.Token, nil
 }
 
-func (client Client) CreateIdentityTokenAuthToken(request CreateIdentityTokenAuthTokenRequest) (CreateIdentityTokenAuthTokenResponse, error) {
+func (client Client) CreateIdentityTokenAuthToken(ctx context.Context, request CreateIdentityTokenAuthTokenRequest) (CreateIdentityTokenAuthTokenResponse, error) {
 	var body CreateIdentityTokenAuthTokenResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -58,10 +59,10 @@ func (client Client) CreateIdentityTokenAuthToken(request CreateIdentityTokenAut
 	return body, nil
 }
 
-func (client Client) UpdateIdentityTokenAuthToken(request UpdateIdentityTokenAuthTokenRequest) (IdentityTokenAuthToken, error) {
+func (client Client) UpdateIdentityTokenAuthToken(ctx context.Context, request UpdateIdentityTokenAuthTokenRequest) (IdentityTokenAuthToken, error) {
 	var body UpdateIdentityTokenAuthTokenResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -78,10 +79,10 @@ func (client Client) UpdateIdentityTokenAuthToken(request UpdateIdentityTokenAut
 	return body.Token, nil
 }
 
-func (client Client) RevokeIdentityTokenAuthToken(request RevokeIdentityTokenAuthTokenRequest) (IdentityTokenAuthToken, error) {
+func (client Client) RevokeIdentityTokenAuthToken(ctx context.Context, request RevokeIdentityTokenAuthTokenRequest) (IdentityTokenAuthToken, error) {
 	var body RevokeIdentityTokenAuthTokenResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
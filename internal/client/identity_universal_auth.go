@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"net/http"
 	"terraform-provider-infisical/internal/errors"
 )
@@ -12,11 +13,11 @@ const (
 	operationRevokeIdentityUniversalAuth = "CallRevokeIdentityUniversalAuth"
 )
 
-func (client Client) GetIdentityUniversalAuth(request GetIdentityUniversalAuthRequest) (IdentityUniversalAuth, error) {
+func (client Client) GetIdentityUniversalAuth(ctx context.Context, request GetIdentityUniversalAuthRequest) (IdentityUniversalAuth, error) {
 	var body GetIdentityUniversalAuthResponse
 
 	httpRequest := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT)
 
@@ -37,10 +38,10 @@ func (client Client) GetIdentityUniversalAuth(request GetIdentityUniversalAuthRe
 	return body.UniversalAuth, nil
 }
 
-func (client Client) CreateIdentityUniversalAuth(request CreateIdentityUniversalAuthRequest) (IdentityUniversalAuth, error) {
+func (client Client) CreateIdentityUniversalAuth(ctx context.Context, request CreateIdentityUniversalAuthRequest) (IdentityUniversalAuth, error) {
 	var body CreateIdentityUniversalAuthResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -57,10 +58,10 @@ func (client Client) CreateIdentityUniversalAuth(request CreateIdentityUniversal
 	return body.UniversalAuth, nil
 }
 
-func (client Client) UpdateIdentityUniversalAuth(request UpdateIdentityUniversalAuthRequest) (IdentityUniversalAuth, error) {
+func (client Client) UpdateIdentityUniversalAuth(ctx context.Context, request UpdateIdentityUniversalAuthRequest) (IdentityUniversalAuth, error) {
 	var body UpdateIdentityUniversalAuthResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -77,10 +78,10 @@ func (client Client) UpdateIdentityUniversalAuth(request UpdateIdentityUniversal
 	return body.UniversalAuth, nil
 }
 
-func (client Client) RevokeIdentityUniversalAuth(request RevokeIdentityUniversalAuthRequest) (IdentityUniversalAuth, error) {
+func (client Client) RevokeIdentityUniversalAuth(ctx context.Context, request RevokeIdentityUniversalAuthRequest) (IdentityUniversalAuth, error) {
 	var body RevokeIdentityUniversalAuthResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"net/http"
 	"terraform-provider-infisical/internal/errors"
 )
@@ -11,11 +12,11 @@ const (
 	operationRevokeIdentityUniversalAuthClientSecret = "CallRevokeIdentityUniversalAuthClientSecret"
 )
 
-func (client Client) GetIdentityUniversalAuthClientSecret(request GetIdentityUniversalAuthClientSecretRequest) (IdentityUniversalAuthClientSecret, error) {
+func (client Client) GetIdentityUniversalAuthClientSecret(ctx context.Context, request GetIdentityUniversalAuthClientSecretRequest) (IdentityUniversalAuthClientSecret, error) {
 	var body GetIdentityUniversalAuthClientSecretResponse
 
 	httpRequest := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT)
 
@@ -36,10 +37,10 @@ func (client Client) GetIdentityUniversalAuthClientSecret(request GetIdentityUni
 	return body.ClientSecretData, nil
 }
 
-func (client Client) CreateIdentityUniversalAuthClientSecret(request CreateIdentityUniversalAuthClientSecretRequest) (CreateIdentityUniversalAuthClientSecretResponse, error) {
+func (client Client) CreateIdentityUniversalAuthClientSecret(ctx context.Context, request CreateIdentityUniversalAuthClientSecretRequest) (CreateIdentityUniversalAuthClientSecretResponse, error) {
 	var body CreateIdentityUniversalAuthClientSecretResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -56,10 +57,10 @@ func (client Client) CreateIdentityUniversalAuthClientSecret(request CreateIdent
 	return body, nil
 }
 
-func (client Client) RevokeIdentityUniversalAuthClientSecret(request RevokeIdentityUniversalAuthClientSecretRequest) (IdentityUniversalAuthClientSecret, error) {
+func (client Client) RevokeIdentityUniversalAuthClientSecret(ctx context.Context, request RevokeIdentityUniversalAuthClientSecretRequest) (IdentityUniversalAuthClientSecret, error) {
 	var body RevokeIdentityUniversalAuthClientSecretResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"terraform-provider-infisical/internal/errors"
@@ -12,10 +13,10 @@ const (
 	operationUpdateIntegration = "CallUpdateIntegration"
 )
 
-func (client Client) CreateIntegration(request CreateIntegrationRequest) (CreateIntegrationResponse, error) {
+func (client Client) CreateIntegration(ctx context.Context, request CreateIntegrationRequest) (CreateIntegrationResponse, error) {
 	var body CreateIntegrationResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -32,10 +33,10 @@ func (client Client) CreateIntegration(request CreateIntegrationRequest) (Create
 	return body, nil
 }
 
-func (client Client) GetIntegration(request GetIntegrationRequest) (GetIntegrationResponse, error) {
+func (client Client) GetIntegration(ctx context.Context, request GetIntegrationRequest) (GetIntegrationResponse, error) {
 	var body GetIntegrationResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		Get(fmt.Sprintf("api/v1/integration/%s", request.ID))
@@ -54,10 +55,10 @@ func (client Client) GetIntegration(request GetIntegrationRequest) (GetIntegrati
 	return body, nil
 }
 
-func (client Client) UpdateIntegration(request UpdateIntegrationRequest) (UpdateIntegrationResponse, error) {
+func (client Client) UpdateIntegration(ctx context.Context, request UpdateIntegrationRequest) (UpdateIntegrationResponse, error) {
 	var body UpdateIntegrationResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
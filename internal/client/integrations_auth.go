@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"terraform-provider-infisical/internal/errors"
 )
@@ -22,10 +23,10 @@ const (
 	operationDeleteIntegrationAuth = "CallDeleteIntegrationAuth"
 )
 
-func (client Client) CreateIntegrationAuth(request CreateIntegrationAuthRequest) (CreateIntegrationAuthResponse, error) {
+func (client Client) CreateIntegrationAuth(ctx context.Context, request CreateIntegrationAuthRequest) (CreateIntegrationAuthResponse, error) {
 	var body CreateIntegrationAuthResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -42,10 +43,10 @@ func (client Client) CreateIntegrationAuth(request CreateIntegrationAuthRequest)
 	return body, nil
 }
 
-func (client Client) UpdateIntegrationAuth(request UpdateIntegrationAuthRequest) (UpdateIntegrationAuthResponse, error) {
+func (client Client) UpdateIntegrationAuth(ctx context.Context, request UpdateIntegrationAuthRequest) (UpdateIntegrationAuthResponse, error) {
 	var body UpdateIntegrationAuthResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -63,10 +64,10 @@ func (client Client) UpdateIntegrationAuth(request UpdateIntegrationAuthRequest)
 }
 
 // Deleting integration auth triggers a cascade effect, that will also delete the associated integration.
-func (client Client) DeleteIntegrationAuth(request DeleteIntegrationAuthRequest) (DeleteIntegrationAuthResponse, error) {
+func (client Client) DeleteIntegrationAuth(ctx context.Context, request DeleteIntegrationAuthRequest) (DeleteIntegrationAuthResponse, error) {
 	var body DeleteIntegrationAuthResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		Delete(fmt.Sprintf("api/v1/integration-auth/%s", request.ID))
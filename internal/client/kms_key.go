@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"terraform-provider-infisical/internal/errors"
@@ -17,10 +18,10 @@ const (
 	operationGetKMSKeySigningAlgorithms = "CallGetKMSKeySigningAlgorithms"
 )
 
-func (client Client) CreateKMSKey(request CreateKMSKeyRequest) (CreateKMSKeyResponse, error) {
+func (client Client) CreateKMSKey(ctx context.Context, request CreateKMSKeyRequest) (CreateKMSKeyResponse, error) {
 	var kmsKeyResponse CreateKMSKeyResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&kmsKeyResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -37,10 +38,10 @@ func (client Client) CreateKMSKey(request CreateKMSKeyRequest) (CreateKMSKeyResp
 	return kmsKeyResponse, nil
 }
 
-func (client Client) GetKMSKey(request GetKMSKeyRequest) (GetKMSKeyResponse, error) {
+func (client Client) GetKMSKey(ctx context.Context, request GetKMSKeyRequest) (GetKMSKeyResponse, error) {
 	var kmsKeyResponse GetKMSKeyResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&kmsKeyResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Get(fmt.Sprintf("api/v1/kms/keys/%s", request.KeyId))
@@ -60,10 +61,10 @@ func (client Client) GetKMSKey(request GetKMSKeyRequest) (GetKMSKeyResponse, err
 	return kmsKeyResponse, nil
 }
 
-func (client Client) GetKMSKeyByName(request GetKMSKeyByNameRequest) (GetKMSKeyByNameResponse, error) {
+func (client Client) GetKMSKeyByName(ctx context.Context, request GetKMSKeyByNameRequest) (GetKMSKeyByNameResponse, error) {
 	var kmsKeyResponse GetKMSKeyByNameResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&kmsKeyResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetQueryParam("projectId", request.ProjectId).
@@ -84,47 +85,45 @@ func (client Client) GetKMSKeyByName(request GetKMSKeyByNameRequest) (GetKMSKeyB
 	return kmsKeyResponse, nil
 }
 
-func (client Client) ListKMSKeys(request ListKMSKeysRequest) (ListKMSKeysResponse, error) {
-	var kmsKeysResponse ListKMSKeysResponse
-	req := client.Config.HttpClient.
-		R().
-		SetResult(&kmsKeysResponse).
-		SetHeader("User-Agent", USER_AGENT).
-		SetQueryParam("projectId", request.ProjectId)
-
-	if request.Offset != nil {
-		req.SetQueryParam("offset", fmt.Sprintf("%d", *request.Offset))
-	}
-	if request.Limit != nil {
-		req.SetQueryParam("limit", fmt.Sprintf("%d", *request.Limit))
-	}
-	if request.OrderBy != nil {
-		req.SetQueryParam("orderBy", *request.OrderBy)
-	}
-	if request.OrderDirection != nil {
-		req.SetQueryParam("orderDirection", *request.OrderDirection)
-	}
-	if request.Search != nil {
-		req.SetQueryParam("search", *request.Search)
-	}
-
-	response, err := req.Get("api/v1/kms/keys")
-
-	if err != nil {
-		return ListKMSKeysResponse{}, errors.NewGenericRequestError(operationListKMSKeys, err)
-	}
-
-	if response.IsError() {
-		return ListKMSKeysResponse{}, errors.NewAPIErrorWithResponse(operationListKMSKeys, response, nil)
-	}
-
-	return kmsKeysResponse, nil
+// ListKMSKeys returns the KMS keys of a project matching the request, up to opts.MaxResults.
+func (client Client) ListKMSKeys(ctx context.Context, request ListKMSKeysRequest, opts ListOptions) (ListResult[KMSKey], error) {
+	return paginate(defaultPageSize, opts, func(offset, limit int) ([]KMSKey, int, error) {
+		var kmsKeysResponse ListKMSKeysResponse
+		req := client.Config.HttpClient.
+			R().SetContext(ctx).
+			SetResult(&kmsKeysResponse).
+			SetHeader("User-Agent", USER_AGENT).
+			SetQueryParam("projectId", request.ProjectId).
+			SetQueryParams(pageQuery(offset, limit))
+
+		if request.OrderBy != nil {
+			req.SetQueryParam("orderBy", *request.OrderBy)
+		}
+		if request.OrderDirection != nil {
+			req.SetQueryParam("orderDirection", *request.OrderDirection)
+		}
+		if request.Search != nil {
+			req.SetQueryParam("search", *request.Search)
+		}
+
+		response, err := req.Get("api/v1/kms/keys")
+
+		if err != nil {
+			return nil, 0, errors.NewGenericRequestError(operationListKMSKeys, err)
+		}
+
+		if response.IsError() {
+			return nil, 0, errors.NewAPIErrorWithResponse(operationListKMSKeys, response, nil)
+		}
+
+		return kmsKeysResponse.Keys, kmsKeysResponse.TotalCount, nil
+	})
 }
 
-func (client Client) UpdateKMSKey(request UpdateKMSKeyRequest) (UpdateKMSKeyResponse, error) {
+func (client Client) UpdateKMSKey(ctx context.Context, request UpdateKMSKeyRequest) (UpdateKMSKeyResponse, error) {
 	var kmsKeyResponse UpdateKMSKeyResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&kmsKeyResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -141,10 +140,10 @@ func (client Client) UpdateKMSKey(request UpdateKMSKeyRequest) (UpdateKMSKeyResp
 	return kmsKeyResponse, nil
 }
 
-func (client Client) DeleteKMSKey(request DeleteKMSKeyRequest) (DeleteKMSKeyResponse, error) {
+func (client Client) DeleteKMSKey(ctx context.Context, request DeleteKMSKeyRequest) (DeleteKMSKeyResponse, error) {
 	var kmsKeyResponse DeleteKMSKeyResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&kmsKeyResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Delete(fmt.Sprintf("api/v1/kms/keys/%s", request.KeyId))
@@ -160,10 +159,10 @@ func (client Client) DeleteKMSKey(request DeleteKMSKeyRequest) (DeleteKMSKeyResp
 	return kmsKeyResponse, nil
 }
 
-func (client Client) GetKMSKeyPublicKey(request GetKMSKeyPublicKeyRequest) (GetKMSKeyPublicKeyResponse, error) {
+func (client Client) GetKMSKeyPublicKey(ctx context.Context, request GetKMSKeyPublicKeyRequest) (GetKMSKeyPublicKeyResponse, error) {
 	var publicKeyResponse GetKMSKeyPublicKeyResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&publicKeyResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Get(fmt.Sprintf("api/v1/kms/keys/%s/public-key", request.KeyId))
@@ -183,10 +182,10 @@ func (client Client) GetKMSKeyPublicKey(request GetKMSKeyPublicKeyRequest) (GetK
 	return publicKeyResponse, nil
 }
 
-func (client Client) GetKMSKeySigningAlgorithms(request GetKMSKeySigningAlgorithmsRequest) (GetKMSKeySigningAlgorithmsResponse, error) {
+func (client Client) GetKMSKeySigningAlgorithms(ctx context.Context, request GetKMSKeySigningAlgorithmsRequest) (GetKMSKeySigningAlgorithmsResponse, error) {
 	var signingAlgorithmsResponse GetKMSKeySigningAlgorithmsResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&signingAlgorithmsResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Get(fmt.Sprintf("api/v1/kms/keys/%s/signing-algorithms", request.KeyId))
@@ -0,0 +1,224 @@
+package infisicalclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	infisicalerrors "terraform-provider-infisical/internal/errors"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// LogSubsystem is the tflog subsystem of the API client. Its level can be set apart from the
+// provider's with TF_LOG_PROVIDER_INFISICAL_CLIENT, for example to trace the API calls alone.
+const LogSubsystem = "infisical_client"
+
+const redactedValue = "[REDACTED]"
+
+// sensitiveBodyFields are the JSON fields whose values are never logged. A field is sensitive
+// when its name, lowercased and without underscores and dashes, contains one of these, so that
+// keys such as "DB_PASSWORD" or "SECRET_ACCESS_KEY" in dynamic secret leases are covered too.
+// Strings, objects and arrays under sensitive fields are redacted as a whole; numbers and
+// booleans, such as a token's TTL, cannot hold a credential and are kept.
+var sensitiveBodyFields = []string{
+	"secretvalue",
+	"clientsecret",
+	"secretaccesskey",
+	"secretkey",
+	"webhooksecret",
+	"token",
+	"jwt",
+	"password",
+	"passphrase",
+	"privatekey",
+	"apikey",
+	"credential",
+	"kubeconfig",
+	"iamrequestheaders",
+}
+
+// sensitiveExactBodyFields are sensitive only when the normalized name matches as a whole, since
+// they are part of many harmless names. A URL can embed credentials, and the otpauth URL of a TOTP
+// dynamic secret carries its key.
+var sensitiveExactBodyFields = []string{"url"}
+
+// secretNameField names a secret next to its value in the secrets API, and is kept there so the
+// logs tell the secrets apart. Elsewhere, such as in the inputs of a TOTP dynamic secret, a field
+// of that name holds a key and is redacted.
+const secretNameField = "secretKey"
+
+// sensitiveHeaders are the request headers whose values are never logged.
+var sensitiveHeaders = []string{"Authorization", "Cookie", "X-Api-Key", IdempotencyKeyHeader}
+
+// withLogSubsystem returns ctx with the client's log subsystem, so it can be logged to.
+func withLogSubsystem(ctx context.Context) context.Context {
+	return tflog.NewSubsystem(ctx, LogSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_INFISICAL_CLIENT"))
+}
+
+// configureLogging logs every API call to the client's log subsystem: a summary of each attempt
+// at debug level, and the redacted headers and bodies at trace level. The values of the headers
+// in redactHeaders, such as the static headers configured for an authenticating gateway, are
+// redacted along with the well-known credential headers.
+func configureLogging(c *resty.Client, redactHeaders []string) {
+	redacted := map[string]bool{}
+	for _, name := range sensitiveHeaders {
+		redacted[http.CanonicalHeaderKey(name)] = true
+	}
+	for _, name := range redactHeaders {
+		redacted[http.CanonicalHeaderKey(name)] = true
+	}
+
+	c.OnAfterResponse(func(_ *resty.Client, r *resty.Response) error {
+		ctx := withLogSubsystem(r.Request.Context())
+
+		fields := requestLogFields(r.Request)
+		fields["status_code"] = r.StatusCode()
+		fields["duration_ms"] = r.Time().Milliseconds()
+		if reqId := infisicalerrors.TryExtractReqId(r); reqId != "" {
+			fields["request_id"] = reqId
+		}
+		tflog.SubsystemDebug(ctx, LogSubsystem, "Infisical API call", fields)
+
+		// the raw request also carries the headers resty adds when sending, such as Authorization
+		requestHeaders := r.Request.Header
+		if r.Request.RawRequest != nil {
+			requestHeaders = r.Request.RawRequest.Header
+		}
+
+		tflog.SubsystemTrace(ctx, LogSubsystem, "Infisical API call details", map[string]interface{}{
+			"method":           r.Request.Method,
+			"path":             requestPath(r.Request.URL),
+			"request_headers":  redactHeaderValues(requestHeaders, redacted),
+			"request_body":     redactRequestBody(r.Request.Body),
+			"response_headers": redactHeaderValues(r.Header(), redacted),
+			"response_body":    redactJSONBody(r.Body()),
+		})
+		return nil
+	})
+
+	c.OnError(func(r *resty.Request, err error) {
+		ctx := withLogSubsystem(r.Context())
+
+		fields := requestLogFields(r)
+		fields["error"] = err.Error()
+		tflog.SubsystemDebug(ctx, LogSubsystem, "Infisical API call failed", fields)
+	})
+}
+
+func requestLogFields(r *resty.Request) map[string]interface{} {
+	return map[string]interface{}{
+		"method":      r.Method,
+		"path":        requestPath(r.URL),
+		"retry_count": max(r.Attempt-1, 0),
+	}
+}
+
+// requestPath returns the path of a request URL. The query is left out, as it can hold the names
+// of secrets and other identifiers that do not help tell calls apart.
+func requestPath(rawURL string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	return parsed.Path
+}
+
+func redactHeaderValues(header http.Header, redacted map[string]bool) map[string]string {
+	values := make(map[string]string, len(header))
+	for name := range header {
+		if redacted[http.CanonicalHeaderKey(name)] {
+			values[name] = redactedValue
+			continue
+		}
+		values[name] = header.Get(name)
+	}
+	return values
+}
+
+// redactRequestBody returns the redacted JSON form of a request body as set on the request,
+// which is either already encoded or a value resty encodes as JSON.
+func redactRequestBody(body interface{}) string {
+	switch b := body.(type) {
+	case nil:
+		return ""
+	case []byte:
+		return redactJSONBody(b)
+	case string:
+		return redactJSONBody([]byte(b))
+	}
+
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		return "<unloggable body>"
+	}
+	return redactJSONBody(encoded)
+}
+
+// redactJSONBody returns body with the values of sensitive fields replaced. Bodies that are not
+// JSON are left out, since they cannot be redacted reliably.
+func redactJSONBody(body []byte) string {
+	if len(strings.TrimSpace(string(body))) == 0 {
+		return ""
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		return "<non-JSON body>"
+	}
+
+	encoded, err := json.Marshal(redactJSONValue(decoded))
+	if err != nil {
+		return "<unloggable body>"
+	}
+	return string(encoded)
+}
+
+func isSensitiveField(name string, object map[string]interface{}) bool {
+	if _, isSecret := object["secretValue"]; isSecret && name == secretNameField {
+		return false
+	}
+
+	normalized := strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(name))
+	for _, field := range sensitiveExactBodyFields {
+		if normalized == field {
+			return true
+		}
+	}
+	for _, field := range sensitiveBodyFields {
+		if strings.Contains(normalized, field) {
+			return true
+		}
+	}
+	return false
+}
+
+func isScalarJSONValue(value interface{}) bool {
+	switch value.(type) {
+	case nil, bool, float64:
+		return true
+	}
+	return false
+}
+
+func redactJSONValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			if isSensitiveField(key, v) && !isScalarJSONValue(child) {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = redactJSONValue(child)
+		}
+		return v
+	case []interface{}:
+		for i, child := range v {
+			v[i] = redactJSONValue(child)
+		}
+		return v
+	}
+	return value
+}
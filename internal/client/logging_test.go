@@ -0,0 +1,92 @@
+package infisicalclient
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestRedactJSONBody(t *testing.T) {
+	cases := map[string]string{
+		`{"secret":{"secretKey":"DB_URL","secretValue":"postgres://u:p@db"}}`:         `{"secret":{"secretKey":"DB_URL","secretValue":"[REDACTED]"}}`,
+		`{"accessToken":"eyJ","expiresIn":7200,"accessTokenMaxTTL":0}`:                `{"accessToken":"[REDACTED]","accessTokenMaxTTL":0,"expiresIn":7200}`,
+		`{"lease":{"id":"l"},"data":{"DB_USERNAME":"u","DB_PASSWORD":"p"}}`:           `{"data":{"DB_PASSWORD":"[REDACTED]","DB_USERNAME":"u"},"lease":{"id":"l"}}`,
+		`{"connection":{"credentials":{"accessKeyId":"AKIA","secretAccessKey":"s"}}}`: `{"connection":{"credentials":"[REDACTED]"}}`,
+		`[{"clientSecret":"s","description":"ci"},{"privateKey":"-----BEGIN"}]`:       `[{"clientSecret":"[REDACTED]","description":"ci"},{"privateKey":"[REDACTED]"}]`,
+		`{"inputs":{"accessKey":"AKIA","secretAccessKey":"s","region":"us-east-1"}}`:  `{"inputs":{"accessKey":"AKIA","region":"us-east-1","secretAccessKey":"[REDACTED]"}}`,
+		`{"data":{"ACCESS_KEY":"AKIA","SECRET_ACCESS_KEY":"s","SESSION_TOKEN":"t"}}`:  `{"data":{"ACCESS_KEY":"AKIA","SECRET_ACCESS_KEY":"[REDACTED]","SESSION_TOKEN":"[REDACTED]"}}`,
+		`{"inputs":{"configType":"manual","secretKey":"JBSWY3DP","period":30}}`:       `{"inputs":{"configType":"manual","period":30,"secretKey":"[REDACTED]"}}`,
+		`{"inputs":{"configType":"url","url":"otpauth://totp/ci?secret=JBSWY3DP"}}`:   `{"inputs":{"configType":"url","url":"[REDACTED]"}}`,
+		`{"credentials":{"kubeconfig":"apiVersion: v1"}}`:                             `{"credentials":"[REDACTED]"}`,
+		`{"kubeconfig":"apiVersion: v1","instanceUrl":"https://k8s"}`:                 `{"instanceUrl":"https://k8s","kubeconfig":"[REDACTED]"}`,
+		`{"webhookUrl":"https://hooks","webhookSecretKey":"s"}`:                       `{"webhookSecretKey":"[REDACTED]","webhookUrl":"https://hooks"}`,
+		`<html>502 Bad Gateway</html>`:                                                `<non-JSON body>`,
+		``:                                                                            ``,
+	}
+	for in, want := range cases {
+		if got := redactJSONBody([]byte(in)); got != want {
+			t.Errorf("redactJSONBody(%s) = %s, want %s", in, got, want)
+		}
+	}
+}
+
+func TestConfigureLogging_RedactsTheLoggedCall(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		jsonResponse(http.StatusBadRequest, `{"message":"bad request","reqId":"req-123","secret":{"secretValue":"from-response"}}`)(w, r)
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	rc := resty.New().SetBaseURL(server.URL).SetAuthToken("machine-identity-token")
+	configureLogging(rc, []string{"X-Gateway-Token"})
+
+	_, err := rc.R().SetContext(ctx).
+		SetHeader("X-Gateway-Token", "gateway-secret").
+		SetBody(map[string]string{"secretKey": "DB_URL", "secretValue": "from-request"}).
+		Post("api/v3/secrets/raw/DB_URL")
+	if err != nil {
+		t.Fatalf("request error: %s", err)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("unable to decode the logs: %s", err)
+	}
+
+	var summary map[string]interface{}
+	for _, entry := range entries {
+		if entry["@message"] == "Infisical API call" {
+			summary = entry
+		}
+	}
+	if summary == nil {
+		t.Fatalf("no API call summary among the logs: %v", entries)
+	}
+	for field, want := range map[string]interface{}{
+		"@module":     "provider." + LogSubsystem,
+		"method":      "POST",
+		"path":        "/api/v3/secrets/raw/DB_URL",
+		"status_code": float64(http.StatusBadRequest),
+		"request_id":  "req-123",
+		"retry_count": float64(0),
+	} {
+		if summary[field] != want {
+			t.Errorf("summary %s = %v, want %v", field, summary[field], want)
+		}
+	}
+
+	logs := output.String()
+	for _, leaked := range []string{"machine-identity-token", "gateway-secret", "from-request", "from-response"} {
+		if strings.Contains(logs, leaked) {
+			t.Errorf("the logs contain %q", leaked)
+		}
+	}
+}
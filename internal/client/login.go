@@ -2,11 +2,22 @@ package infisicalclient
 
 import (
 	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
 	"os"
+	"strings"
 	"terraform-provider-infisical/internal/errors"
+	"time"
 
-	infisicalSdk "github.com/infisical/go-sdk"
+	awsSigner "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/go-resty/resty/v2"
+	infisicalSdkUtil "github.com/infisical/go-sdk/packages/util"
 )
 
 const (
@@ -15,9 +26,20 @@ const (
 	operationOidcMachineIdentityAuth       = "CallOidcMachineIdentityAuth"
 	operationKubernetesMachineIdentityAuth = "CallKubernetesMachineIdentityAuth"
 	operationTokenMachineIdentityAuth      = "CallTokenMachineIdentityAuth"
+	operationAwsIamMachineIdentityAuth     = "CallAwsIamMachineIdentityAuth"
+	operationGcpMachineIdentityAuth        = "CallGcpMachineIdentityAuth"
+	operationAzureMachineIdentityAuth      = "CallAzureMachineIdentityAuth"
+	operationJwtMachineIdentityAuth        = "CallJwtMachineIdentityAuth"
+	operationTlsCertMachineIdentityAuth    = "CallTlsCertMachineIdentityAuth"
+	operationLdapMachineIdentityAuth       = "CallLdapMachineIdentityAuth"
 )
 
-func (client Client) UniversalMachineIdentityAuth() (string, error) {
+const (
+	metadataRequestTimeout = 10 * time.Second
+	gcpIamJwtLifetime      = 15 * time.Minute
+)
+
+func (client Client) UniversalMachineIdentityAuth(ctx context.Context) (string, error) {
 	if client.Config.ClientId == "" || client.Config.ClientSecret == "" {
 		return "", fmt.Errorf("you must set the client secret and client ID for the client before making calls")
 	}
@@ -31,7 +53,7 @@ func (client Client) UniversalMachineIdentityAuth() (string, error) {
 	if client.Config.OrganizationSlug != "" {
 		reqBody["organizationSlug"] = client.Config.OrganizationSlug
 	}
-	res, err := client.Config.HttpClient.R().SetResult(&loginResponse).SetHeader("User-Agent", USER_AGENT).SetBody(reqBody).Post("api/v1/auth/universal-auth/login")
+	res, err := client.Config.HttpClient.R().SetContext(withSafeRetry(ctx)).SetResult(&loginResponse).SetHeader("User-Agent", USER_AGENT).SetBody(reqBody).Post("api/v1/auth/universal-auth/login")
 
 	if err != nil {
 		return "", errors.NewGenericRequestError(operationUniversalMachineIdentityAuth, err)
@@ -44,10 +66,10 @@ func (client Client) UniversalMachineIdentityAuth() (string, error) {
 	return loginResponse.AccessToken, nil
 }
 
-func (client Client) GetServiceTokenDetailsV2() (GetServiceTokenDetailsResponse, error) {
+func (client Client) GetServiceTokenDetailsV2(ctx context.Context) (GetServiceTokenDetailsResponse, error) {
 	var tokenDetailsResponse GetServiceTokenDetailsResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&tokenDetailsResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Get("api/v2/service-token")
@@ -63,7 +85,7 @@ func (client Client) GetServiceTokenDetailsV2() (GetServiceTokenDetailsResponse,
 	return tokenDetailsResponse, nil
 }
 
-func (client Client) OidcMachineIdentityAuth() (string, error) {
+func (client Client) OidcMachineIdentityAuth(ctx context.Context) (string, error) {
 	tokenEnvironmentName := client.Config.OidcTokenEnvName
 	if tokenEnvironmentName == "" {
 		tokenEnvironmentName = INFISICAL_AUTH_JWT_NAME
@@ -90,7 +112,7 @@ func (client Client) OidcMachineIdentityAuth() (string, error) {
 		reqBody["organizationSlug"] = client.Config.OrganizationSlug
 	}
 
-	res, err := client.Config.HttpClient.R().SetResult(&loginResponse).SetHeader("User-Agent", USER_AGENT).SetBody(reqBody).Post("api/v1/auth/oidc-auth/login")
+	res, err := client.Config.HttpClient.R().SetContext(withSafeRetry(ctx)).SetResult(&loginResponse).SetHeader("User-Agent", USER_AGENT).SetBody(reqBody).Post("api/v1/auth/oidc-auth/login")
 
 	if err != nil {
 		return "", errors.NewGenericRequestError(operationOidcMachineIdentityAuth, err)
@@ -103,7 +125,7 @@ func (client Client) OidcMachineIdentityAuth() (string, error) {
 	return loginResponse.AccessToken, nil
 }
 
-func (client Client) KubernetesMachineIdentityAuth() (string, error) {
+func (client Client) KubernetesMachineIdentityAuth(ctx context.Context) (string, error) {
 
 	token := client.Config.ServiceAccountToken
 	tokenPath := client.Config.ServiceAccountTokenPath
@@ -134,7 +156,7 @@ func (client Client) KubernetesMachineIdentityAuth() (string, error) {
 	if client.Config.OrganizationSlug != "" {
 		reqBody["organizationSlug"] = client.Config.OrganizationSlug
 	}
-	res, err := client.Config.HttpClient.R().SetResult(&loginResponse).SetHeader("User-Agent", USER_AGENT).SetBody(reqBody).Post("api/v1/auth/kubernetes-auth/login")
+	res, err := client.Config.HttpClient.R().SetContext(withSafeRetry(ctx)).SetResult(&loginResponse).SetHeader("User-Agent", USER_AGENT).SetBody(reqBody).Post("api/v1/auth/kubernetes-auth/login")
 
 	if err != nil {
 		return "", errors.NewGenericRequestError(operationKubernetesMachineIdentityAuth, err)
@@ -147,7 +169,7 @@ func (client Client) KubernetesMachineIdentityAuth() (string, error) {
 	return loginResponse.AccessToken, nil
 }
 
-func (client Client) TokenMachineIdentityAuth() (string, error) {
+func (client Client) TokenMachineIdentityAuth(ctx context.Context) (string, error) {
 	if client.Config.Token == "" {
 		return "", fmt.Errorf("you must set the token for the client before making calls")
 	}
@@ -155,24 +177,424 @@ func (client Client) TokenMachineIdentityAuth() (string, error) {
 	return client.Config.Token, nil
 }
 
-func (client Client) AwsIamMachineIdentityAuth() (string, error) {
+func (client Client) AwsIamMachineIdentityAuth(ctx context.Context) (string, error) {
+	if client.Config.IdentityId == "" {
+		return "", fmt.Errorf("you must set the identity ID for the client before making calls")
+	}
+
+	awsCredentials, awsRegion, err := infisicalSdkUtil.RetrieveAwsCredentials()
+	if err != nil {
+		return "", fmt.Errorf("AwsIamMachineIdentityAuth: Unable to retrieve AWS credentials [err=%s]", err)
+	}
+
+	// The GetCallerIdentity request is signed here and sent by Infisical, which learns the
+	// caller's identity from the response. Signing locally rather than through the SDK keeps the
+	// login on the configured HTTP client, so its TLS, proxy and header settings apply.
+	iamRequestHost := fmt.Sprintf("sts.%s.amazonaws.com", awsRegion)
+	iamRequestBody := "Action=GetCallerIdentity&Version=2011-06-15"
+
+	iamRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://"+iamRequestHost+"/", strings.NewReader(iamRequestBody))
+	if err != nil {
+		return "", fmt.Errorf("AwsIamMachineIdentityAuth: Unable to build the STS request [err=%s]", err)
+	}
+
+	now := time.Now().UTC()
+	iamRequest.Header.Set("X-Amz-Date", now.Format("20060102T150405Z"))
+
+	payloadHash := sha256.Sum256([]byte(iamRequestBody))
+	if err := awsSigner.NewSigner().SignHTTP(ctx, awsCredentials, iamRequest, hex.EncodeToString(payloadHash[:]), "sts", awsRegion, now); err != nil {
+		return "", fmt.Errorf("AwsIamMachineIdentityAuth: Unable to sign the STS request [err=%s]", err)
+	}
+
+	iamRequestHeaders := map[string]string{}
+	for name, values := range iamRequest.Header {
+		if strings.EqualFold(name, "Content-Length") {
+			continue
+		}
+		iamRequestHeaders[name] = values[0]
+	}
+	iamRequestHeaders["Host"] = iamRequestHost
+	iamRequestHeaders["Content-Type"] = "application/x-www-form-urlencoded; charset=utf-8"
+	iamRequestHeaders["Content-Length"] = fmt.Sprintf("%d", len(iamRequestBody))
+
+	encodedHeaders, err := json.Marshal(iamRequestHeaders)
+	if err != nil {
+		return "", fmt.Errorf("AwsIamMachineIdentityAuth: Unable to encode the STS request headers [err=%s]", err)
+	}
+
+	reqBody := map[string]string{
+		"identityId":           client.Config.IdentityId,
+		"iamHttpRequestMethod": http.MethodPost,
+		"iamRequestBody":       base64.StdEncoding.EncodeToString([]byte(iamRequestBody)),
+		"iamRequestHeaders":    base64.StdEncoding.EncodeToString(encodedHeaders),
+	}
+	if client.Config.OrganizationSlug != "" {
+		reqBody["organizationSlug"] = client.Config.OrganizationSlug
+	}
+
+	var loginResponse MachineIdentityAuthResponse
+	res, err := client.Config.HttpClient.R().SetContext(withSafeRetry(ctx)).SetResult(&loginResponse).SetHeader("User-Agent", USER_AGENT).SetBody(reqBody).Post("api/v1/auth/aws-auth/login")
+
+	if err != nil {
+		return "", errors.NewGenericRequestError(operationAwsIamMachineIdentityAuth, err)
+	}
+
+	if res.IsError() {
+		return "", errors.NewAPIErrorWithResponse(operationAwsIamMachineIdentityAuth, res, nil)
+	}
+
+	return loginResponse.AccessToken, nil
+}
+
+func (client Client) GcpIdTokenMachineIdentityAuth(ctx context.Context) (string, error) {
+	if client.Config.IdentityId == "" {
+		return "", fmt.Errorf("you must set the identity ID for the client before making calls")
+	}
+
+	// the identity ID doubles as the audience the Infisical GCP auth method expects
+	res, err := metadataHttpClient().R().SetContext(ctx).
+		SetHeader("Metadata-Flavor", "Google").
+		SetQueryParams(map[string]string{
+			"audience": client.Config.IdentityId,
+			"format":   "full",
+		}).
+		Get(client.gcpMetadataURL(ctx, "instance/service-accounts/default/identity"))
+
+	if err != nil {
+		return "", errors.NewGenericRequestError(operationGcpMachineIdentityAuth, err)
+	}
+
+	if res.IsError() {
+		return "", fmt.Errorf("GcpIdTokenMachineIdentityAuth: Unable to fetch the identity token from the GCP metadata service [status-code=%d]", res.StatusCode())
+	}
+
+	return client.jwtMachineIdentityLogin(ctx, operationGcpMachineIdentityAuth, "api/v1/auth/gcp-auth/login", res.String())
+}
+
+func (client Client) GcpIamMachineIdentityAuth(ctx context.Context) (string, error) {
+	if client.Config.IdentityId == "" {
+		return "", fmt.Errorf("you must set the identity ID for the client before making calls")
+	}
+
+	var jwt string
+	var err error
+
+	if client.Config.GcpServiceAccountKeyFilePath != "" {
+		jwt, err = infisicalSdkUtil.GetGCPIamServiceAccountToken(client.Config.IdentityId, client.Config.GcpServiceAccountKeyFilePath)
+		if err != nil {
+			return "", fmt.Errorf("GcpIamMachineIdentityAuth: Unable to sign JWT with the service account key [err=%s]", err)
+		}
+	} else {
+		jwt, err = client.signGcpIamJwtWithMetadataCredentials(ctx)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return client.jwtMachineIdentityLogin(ctx, operationGcpMachineIdentityAuth, "api/v1/auth/gcp-auth/login", jwt)
+}
+
+// signGcpIamJwtWithMetadataCredentials signs the GCP IAM login JWT as the service account
+// attached to the instance, using an access token from the metadata service, so no service
+// account key has to be distributed to the machine.
+func (client Client) signGcpIamJwtWithMetadataCredentials(ctx context.Context) (string, error) {
+	httpClient := metadataHttpClient()
+
+	emailRes, err := httpClient.R().SetContext(ctx).
+		SetHeader("Metadata-Flavor", "Google").
+		Get(client.gcpMetadataURL(ctx, "instance/service-accounts/default/email"))
+
+	if err != nil {
+		return "", errors.NewGenericRequestError(operationGcpMachineIdentityAuth, err)
+	}
+
+	if emailRes.IsError() {
+		return "", fmt.Errorf("GcpIamMachineIdentityAuth: Unable to fetch the service account email from the GCP metadata service [status-code=%d]", emailRes.StatusCode())
+	}
+
+	var tokenResponse struct {
+		AccessToken string `json:"access_token"`
+	}
+
+	tokenRes, err := httpClient.R().SetContext(ctx).
+		SetHeader("Metadata-Flavor", "Google").
+		SetResult(&tokenResponse).
+		Get(client.gcpMetadataURL(ctx, "instance/service-accounts/default/token"))
+
+	if err != nil {
+		return "", errors.NewGenericRequestError(operationGcpMachineIdentityAuth, err)
+	}
+
+	if tokenRes.IsError() {
+		return "", fmt.Errorf("GcpIamMachineIdentityAuth: Unable to fetch an access token from the GCP metadata service [status-code=%d]", tokenRes.StatusCode())
+	}
+
+	serviceAccountEmail := strings.TrimSpace(emailRes.String())
+
+	payload, err := json.Marshal(map[string]any{
+		"sub": serviceAccountEmail,
+		"aud": client.Config.IdentityId,
+		"exp": time.Now().Add(gcpIamJwtLifetime).Unix(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("GcpIamMachineIdentityAuth: Unable to marshal JWT payload [err=%s]", err)
+	}
+
+	var signJwtResponse struct {
+		SignedJwt string `json:"signedJwt"`
+	}
+
+	iamCredentialsEndpoint := client.Config.GcpIamCredentialsEndpoint
+	if iamCredentialsEndpoint == "" {
+		iamCredentialsEndpoint = GCP_IAM_CREDENTIALS_DEFAULT_ENDPOINT
+	}
+
+	signRes, err := httpClient.R().SetContext(ctx).
+		SetAuthToken(tokenResponse.AccessToken).
+		SetResult(&signJwtResponse).
+		SetBody(map[string]string{"payload": string(payload)}).
+		Post(fmt.Sprintf("%s/v1/projects/-/serviceAccounts/%s:signJwt", strings.TrimSuffix(iamCredentialsEndpoint, "/"), url.PathEscape(serviceAccountEmail)))
+
+	if err != nil {
+		return "", errors.NewGenericRequestError(operationGcpMachineIdentityAuth, err)
+	}
+
+	if signRes.IsError() {
+		return "", fmt.Errorf("GcpIamMachineIdentityAuth: Unable to sign JWT, ensure the IAM Service Account Credentials API is enabled [status-code=%d] [response=%s]", signRes.StatusCode(), signRes.String())
+	}
+
+	if signJwtResponse.SignedJwt == "" {
+		return "", fmt.Errorf("GcpIamMachineIdentityAuth: Unable to sign JWT, the signed JWT is empty")
+	}
+
+	return signJwtResponse.SignedJwt, nil
+}
+
+func (client Client) AzureMachineIdentityAuth(ctx context.Context) (string, error) {
+	if client.Config.IdentityId == "" {
+		return "", fmt.Errorf("you must set the identity ID for the client before making calls")
+	}
+
+	resource := client.Config.AzureResource
+	if resource == "" {
+		resource = AZURE_DEFAULT_RESOURCE
+	}
+
+	metadataEndpoint := client.Config.AzureMetadataEndpoint
+	if metadataEndpoint == "" {
+		metadataEndpoint = AZURE_METADATA_DEFAULT_ENDPOINT
+	}
+
+	var tokenResponse struct {
+		AccessToken string `json:"access_token"`
+	}
+
+	res, err := metadataHttpClient().R().SetContext(ctx).
+		SetHeader("Metadata", "true").
+		SetHeader("Accept", "application/json").
+		SetQueryParams(map[string]string{
+			"api-version": "2018-02-01",
+			"resource":    resource,
+		}).
+		SetResult(&tokenResponse).
+		Get(strings.TrimSuffix(metadataEndpoint, "/") + "/metadata/identity/oauth2/token")
+
+	if err != nil {
+		return "", errors.NewGenericRequestError(operationAzureMachineIdentityAuth, err)
+	}
+
+	if res.IsError() {
+		return "", fmt.Errorf("AzureMachineIdentityAuth: Unable to fetch the managed identity token from the Azure metadata service [status-code=%d]", res.StatusCode())
+	}
+
+	return client.jwtMachineIdentityLogin(ctx, operationAzureMachineIdentityAuth, "api/v1/auth/azure-auth/login", tokenResponse.AccessToken)
+}
+
+func (client Client) JwtMachineIdentityAuth(ctx context.Context) (string, error) {
+	if client.Config.IdentityId == "" {
+		return "", fmt.Errorf("you must set the identity ID for the client before making calls")
+	}
+
+	token := client.Config.JwtAuthToken
+
+	if token == "" {
+		if client.Config.JwtAuthTokenPath == "" {
+			return "", fmt.Errorf("you must set either the JWT or the path to a file containing it before making calls")
+		}
+
+		tokenBytes, err := os.ReadFile(client.Config.JwtAuthTokenPath)
+		if err != nil {
+			return "", errors.NewGenericRequestError(operationJwtMachineIdentityAuth, err)
+		}
+
+		token = strings.TrimSpace(string(tokenBytes))
+	}
+
+	return client.jwtMachineIdentityLogin(ctx, operationJwtMachineIdentityAuth, "api/v1/auth/jwt-auth/login", token)
+}
+
+func (client Client) TlsCertMachineIdentityAuth(ctx context.Context) (string, error) {
 	if client.Config.IdentityId == "" {
 		return "", fmt.Errorf("you must set the identity ID for the client before making calls")
 	}
 
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+	certificate, err := pemValueOrFile(client.Config.TlsCertAuthCertificate, client.Config.TlsCertAuthCertificatePath)
+	if err != nil {
+		return "", fmt.Errorf("TlsCertMachineIdentityAuth: Unable to read the client certificate [err=%s]", err)
+	}
+
+	privateKey, err := pemValueOrFile(client.Config.TlsCertAuthPrivateKey, client.Config.TlsCertAuthPrivateKeyPath)
+	if err != nil {
+		return "", fmt.Errorf("TlsCertMachineIdentityAuth: Unable to read the client private key [err=%s]", err)
+	}
+
+	keyPair, err := tls.X509KeyPair(certificate, privateKey)
+	if err != nil {
+		return "", fmt.Errorf("TlsCertMachineIdentityAuth: Invalid client certificate or private key [err=%s]", err)
+	}
+
+	// the certificate is presented during the TLS handshake, the body only names the identity
+	loginClient, err := client.tlsCertLoginClient(keyPair)
+	if err != nil {
+		return "", fmt.Errorf("TlsCertMachineIdentityAuth: Unable to configure the login connection [err=%s]", err)
+	}
+
+	var loginResponse MachineIdentityAuthResponse
+
+	reqBody := map[string]string{
+		"identityId": client.Config.IdentityId,
+	}
+	if client.Config.OrganizationSlug != "" {
+		reqBody["organizationSlug"] = client.Config.OrganizationSlug
+	}
+	res, err := loginClient.R().SetContext(withSafeRetry(ctx)).SetResult(&loginResponse).SetHeader("User-Agent", USER_AGENT).SetBody(reqBody).Post("api/v1/auth/tls-cert-auth/login")
+
+	if err != nil {
+		return "", errors.NewGenericRequestError(operationTlsCertMachineIdentityAuth, err)
+	}
+
+	if res.IsError() {
+		return "", errors.NewAPIErrorWithResponse(operationTlsCertMachineIdentityAuth, res, nil)
+	}
+
+	return loginResponse.AccessToken, nil
+}
+
+// tlsCertLoginClient returns a client for the TLS certificate login that presents only the
+// identity's key pair. It has its own transport, so the identity certificate is never sent with
+// the API calls that follow, nor offered alongside the client certificate of the connection, and
+// the connections it opens are not reused by the shared client.
+func (client Client) tlsCertLoginClient(keyPair tls.Certificate) (*resty.Client, error) {
+	shared := client.Config.HttpClient
 
-	infisicalClient := infisicalSdk.NewInfisicalClient(ctx, infisicalSdk.Config{
-		SiteUrl:          client.Config.HostURL,
-		AutoTokenRefresh: false,
+	transport, err := shared.Transport()
+	if err != nil {
+		return nil, err
+	}
+	loginTransport := transport.Clone()
+
+	tlsConfig := &tls.Config{}
+	if loginTransport.TLSClientConfig != nil {
+		tlsConfig = loginTransport.TLSClientConfig.Clone()
+	}
+	tlsConfig.Certificates = []tls.Certificate{keyPair}
+	tlsConfig.GetClientCertificate = nil
+	loginTransport.TLSClientConfig = tlsConfig
+
+	loginClient := resty.NewWithClient(&http.Client{
+		Transport: loginTransport,
+		Timeout:   shared.GetClient().Timeout,
 	})
+	loginClient.SetBaseURL(shared.BaseURL)
+	loginClient.SetHeaders(client.Config.Headers)
+
+	retryPolicy := DefaultRetryPolicy
+	if client.Config.RetryPolicy != nil {
+		retryPolicy = *client.Config.RetryPolicy
+	}
+	configureRetries(loginClient, retryPolicy)
+	configureLogging(loginClient, headerNames(client.Config.Headers))
+
+	return loginClient, nil
+}
+
+func (client Client) LdapMachineIdentityAuth(ctx context.Context) (string, error) {
+	if client.Config.IdentityId == "" {
+		return "", fmt.Errorf("you must set the identity ID for the client before making calls")
+	}
+
+	if client.Config.LdapUsername == "" || client.Config.LdapPassword == "" {
+		return "", fmt.Errorf("you must set the LDAP username and password for the client before making calls")
+	}
+
+	var loginResponse MachineIdentityAuthResponse
+
+	reqBody := map[string]string{
+		"identityId": client.Config.IdentityId,
+		"username":   client.Config.LdapUsername,
+		"password":   client.Config.LdapPassword,
+	}
+	if client.Config.OrganizationSlug != "" {
+		reqBody["organizationSlug"] = client.Config.OrganizationSlug
+	}
+	res, err := client.Config.HttpClient.R().SetContext(withSafeRetry(ctx)).SetResult(&loginResponse).SetHeader("User-Agent", USER_AGENT).SetBody(reqBody).Post("api/v1/auth/ldap-auth/login")
+
+	if err != nil {
+		return "", errors.NewGenericRequestError(operationLdapMachineIdentityAuth, err)
+	}
 
-	credential, err := infisicalClient.Auth().WithOrganizationSlug(client.Config.OrganizationSlug).AwsIamAuthLogin(client.Config.IdentityId)
+	if res.IsError() {
+		return "", errors.NewAPIErrorWithResponse(operationLdapMachineIdentityAuth, res, nil)
+	}
+
+	return loginResponse.AccessToken, nil
+}
+
+// jwtMachineIdentityLogin exchanges a platform issued JWT for an Infisical access token.
+func (client Client) jwtMachineIdentityLogin(ctx context.Context, operation string, loginPath string, jwt string) (string, error) {
+	var loginResponse MachineIdentityAuthResponse
+
+	reqBody := map[string]string{
+		"identityId": client.Config.IdentityId,
+		"jwt":        jwt,
+	}
+	if client.Config.OrganizationSlug != "" {
+		reqBody["organizationSlug"] = client.Config.OrganizationSlug
+	}
+	res, err := client.Config.HttpClient.R().SetContext(withSafeRetry(ctx)).SetResult(&loginResponse).SetHeader("User-Agent", USER_AGENT).SetBody(reqBody).Post(loginPath)
 
 	if err != nil {
-		return "", fmt.Errorf("AwsIamMachineIdentityAuth: Unable to get machine identity token [err=%s]", err)
+		return "", errors.NewGenericRequestError(operation, err)
 	}
 
-	return credential.AccessToken, nil
+	if res.IsError() {
+		return "", errors.NewAPIErrorWithResponse(operation, res, nil)
+	}
+
+	return loginResponse.AccessToken, nil
+}
+
+func (client Client) gcpMetadataURL(ctx context.Context, metadataPath string) string {
+	metadataEndpoint := client.Config.GcpMetadataEndpoint
+	if metadataEndpoint == "" {
+		metadataEndpoint = GCP_METADATA_DEFAULT_ENDPOINT
+	}
+	return strings.TrimSuffix(metadataEndpoint, "/") + "/computeMetadata/v1/" + metadataPath
+}
+
+// pemValueOrFile returns value when set, and otherwise the contents of the file at path.
+func pemValueOrFile(value string, path string) ([]byte, error) {
+	if value != "" {
+		return []byte(value), nil
+	}
+	if path == "" {
+		return nil, fmt.Errorf("neither a PEM value nor a file path was set")
+	}
+	return os.ReadFile(path)
+}
+
+// metadataHttpClient returns the client used to talk to the cloud metadata services. It is
+// separate from the Infisical client so those calls don't inherit its base URL or retries.
+func metadataHttpClient() *resty.Client {
+	return resty.New().SetTimeout(metadataRequestTimeout)
 }
@@ -0,0 +1,341 @@
+package infisicalclient
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// newPlatformLoginStub serves the cloud metadata endpoints, the IAM credentials signJwt endpoint
+// and the Infisical login routes from a single server. Each login route answers with the JWT it
+// received, so a test can assert which platform token ended up being exchanged.
+func newPlatformLoginStub(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/computeMetadata/v1/instance/service-accounts/default/identity", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Metadata-Flavor") != "Google" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		fmt.Fprintf(w, "gcp-id-token-for-%s", r.URL.Query().Get("audience"))
+	})
+	mux.HandleFunc("/computeMetadata/v1/instance/service-accounts/default/email", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "runner@project.iam.gserviceaccount.com")
+	})
+	mux.HandleFunc("/computeMetadata/v1/instance/service-accounts/default/token", jsonResponse(http.StatusOK, `{"access_token":"gce-access-token"}`))
+	mux.HandleFunc("/v1/projects/-/serviceAccounts/runner@project.iam.gserviceaccount.com:signJwt", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer gce-access-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var body struct {
+			Payload string `json:"payload"`
+		}
+		var claims map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || json.Unmarshal([]byte(body.Payload), &claims) != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"signedJwt":"signed-for-%s-%s"}`, claims["sub"], claims["aud"])
+	})
+	mux.HandleFunc("/metadata/identity/oauth2/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Metadata") != "true" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"azure-token-for-%s"}`, r.URL.Query().Get("resource"))
+	})
+
+	echoJwt := func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body["identityId"] != "identity-id" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"accessToken":%q}`, body["jwt"])
+	}
+	mux.HandleFunc("/api/v1/auth/gcp-auth/login", echoJwt)
+	mux.HandleFunc("/api/v1/auth/azure-auth/login", echoJwt)
+	mux.HandleFunc("/api/v1/auth/jwt-auth/login", echoJwt)
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestPlatformMachineIdentityAuth(t *testing.T) {
+	server := newPlatformLoginStub(t)
+
+	cases := []struct {
+		name  string
+		login func(Client, context.Context) (string, error)
+		want  string
+	}{
+		{"gcp id token", Client.GcpIdTokenMachineIdentityAuth, "gcp-id-token-for-identity-id"},
+		{"gcp iam via metadata credentials", Client.GcpIamMachineIdentityAuth, "signed-for-runner@project.iam.gserviceaccount.com-identity-id"},
+		{"azure", Client.AzureMachineIdentityAuth, "azure-token-for-" + AZURE_DEFAULT_RESOURCE},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			client := Client{Config{
+				HttpClient:                resty.New().SetBaseURL(server.URL),
+				IdentityId:                "identity-id",
+				GcpMetadataEndpoint:       server.URL,
+				GcpIamCredentialsEndpoint: server.URL,
+				AzureMetadataEndpoint:     server.URL,
+			}}
+
+			got, err := c.login(client, context.Background())
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got != c.want {
+				t.Errorf("got access token %q, want %q", got, c.want)
+			}
+		})
+	}
+}
+
+func TestPlatformMachineIdentityAuthRequiresIdentityId(t *testing.T) {
+	client := Client{Config{HttpClient: resty.New()}}
+
+	for name, login := range map[string]func(Client, context.Context) (string, error){
+		"gcp id token": Client.GcpIdTokenMachineIdentityAuth,
+		"gcp iam":      Client.GcpIamMachineIdentityAuth,
+		"azure":        Client.AzureMachineIdentityAuth,
+	} {
+		if _, err := login(client, context.Background()); err == nil {
+			t.Errorf("%s: expected an error without an identity ID", name)
+		}
+	}
+}
+
+func TestPlatformMachineIdentityAuthMetadataFailure(t *testing.T) {
+	server := httptest.NewServer(jsonResponse(http.StatusNotFound, `{}`))
+	t.Cleanup(server.Close)
+
+	client := Client{Config{
+		HttpClient:            resty.New().SetBaseURL(server.URL),
+		IdentityId:            "identity-id",
+		GcpMetadataEndpoint:   server.URL,
+		AzureMetadataEndpoint: server.URL,
+	}}
+
+	if _, err := client.GcpIdTokenMachineIdentityAuth(context.Background()); err == nil {
+		t.Error("gcp id token: expected an error when the metadata service fails")
+	}
+	if _, err := client.AzureMachineIdentityAuth(context.Background()); err == nil {
+		t.Error("azure: expected an error when the metadata service fails")
+	}
+}
+
+func TestJwtMachineIdentityAuth(t *testing.T) {
+	server := newPlatformLoginStub(t)
+
+	tokenPath := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenPath, []byte("jwt-from-file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		config Config
+		want   string
+	}{
+		"value":                {Config{JwtAuthToken: "jwt-from-value"}, "jwt-from-value"},
+		"file":                 {Config{JwtAuthTokenPath: tokenPath}, "jwt-from-file"},
+		"value overrides file": {Config{JwtAuthToken: "jwt-from-value", JwtAuthTokenPath: tokenPath}, "jwt-from-value"},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			c.config.HttpClient = resty.New().SetBaseURL(server.URL)
+			c.config.IdentityId = "identity-id"
+
+			got, err := Client{c.config}.JwtMachineIdentityAuth(context.Background())
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got != c.want {
+				t.Errorf("got access token %q, want %q", got, c.want)
+			}
+		})
+	}
+
+	if _, err := (Client{Config{IdentityId: "identity-id", HttpClient: resty.New()}}).JwtMachineIdentityAuth(context.Background()); err == nil {
+		t.Error("expected an error without a JWT or JWT path")
+	}
+}
+
+func TestLdapMachineIdentityAuth(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		if r.URL.Path != "/api/v1/auth/ldap-auth/login" || json.NewDecoder(r.Body).Decode(&body) != nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if body["identityId"] != "identity-id" || body["username"] != "jdoe" || body["password"] != "hunter2" {
+			jsonResponse(http.StatusUnauthorized, `{"message":"invalid credentials"}`)(w, r)
+			return
+		}
+		jsonResponse(http.StatusOK, `{"accessToken":"ldap-access-token"}`)(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	client := Client{Config{
+		HttpClient:   resty.New().SetBaseURL(server.URL),
+		IdentityId:   "identity-id",
+		LdapUsername: "jdoe",
+		LdapPassword: "hunter2",
+	}}
+
+	got, err := client.LdapMachineIdentityAuth(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got != "ldap-access-token" {
+		t.Errorf("got access token %q, want %q", got, "ldap-access-token")
+	}
+
+	client.Config.LdapPassword = "wrong"
+	if _, err := client.LdapMachineIdentityAuth(context.Background()); err == nil {
+		t.Error("expected an error for rejected credentials")
+	}
+}
+
+func TestTlsCertMachineIdentityAuth(t *testing.T) {
+	certificatePEM, privateKeyPEM := generateClientCertificate(t, "terraform-runner")
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"accessToken":"token-for-%s"}`, r.TLS.PeerCertificates[0].Subject.CommonName)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(server.Certificate())
+
+	client := Client{Config{
+		HttpClient:             resty.New().SetBaseURL(server.URL).SetTLSClientConfig(&tls.Config{RootCAs: roots}),
+		IdentityId:             "identity-id",
+		TlsCertAuthCertificate: string(certificatePEM),
+		TlsCertAuthPrivateKey:  string(privateKeyPEM),
+	}}
+
+	got, err := client.TlsCertMachineIdentityAuth(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got != "token-for-terraform-runner" {
+		t.Errorf("got access token %q, want %q", got, "token-for-terraform-runner")
+	}
+
+	client.Config.TlsCertAuthPrivateKey = ""
+	if _, err := client.TlsCertMachineIdentityAuth(context.Background()); err == nil {
+		t.Error("expected an error without a private key")
+	}
+}
+
+func TestTlsCertMachineIdentityAuthKeepsTheConnectionClientCertificate(t *testing.T) {
+	apiCertificatePEM, apiPrivateKeyPEM := generateClientCertificate(t, "api-client")
+	identityCertificatePEM, identityPrivateKeyPEM := generateClientCertificate(t, "terraform-runner")
+
+	presented := map[string][]string{}
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, certificate := range r.TLS.PeerCertificates {
+			presented[r.URL.Path] = append(presented[r.URL.Path], certificate.Subject.CommonName)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"accessToken":"token"}`)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	cnf := Config{
+		HttpClient:             resty.New().SetBaseURL(server.URL),
+		CACertificate:          serverCertificatePEM(server),
+		ClientCertificate:      string(apiCertificatePEM),
+		ClientPrivateKey:       string(apiPrivateKeyPEM),
+		IdentityId:             "identity-id",
+		TlsCertAuthCertificate: string(identityCertificatePEM),
+		TlsCertAuthPrivateKey:  string(identityPrivateKeyPEM),
+	}
+	if err := configureConnection(cnf.HttpClient, cnf); err != nil {
+		t.Fatalf("configuring the connection: %s", err)
+	}
+	client := Client{cnf}
+
+	// logging in again must not pile the identity certificate onto the shared client
+	for i := 0; i < 2; i++ {
+		if _, err := client.TlsCertMachineIdentityAuth(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	if _, err := client.Config.HttpClient.R().Get("api/v1/workspace"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := presented["/api/v1/auth/tls-cert-auth/login"]; len(got) != 2 || got[0] != "terraform-runner" || got[1] != "terraform-runner" {
+		t.Errorf("the logins presented %v, want the identity certificate only", got)
+	}
+	if got := presented["/api/v1/workspace"]; len(got) != 1 || got[0] != "api-client" {
+		t.Errorf("the API call presented %v, want the connection client certificate only", got)
+	}
+}
+
+func generateClientCertificate(t *testing.T, commonName string) ([]byte, []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
@@ -324,7 +324,8 @@ type GetProjectByIdResponse struct {
 }
 
 type GetProjectsResponse struct {
-	Projects []ProjectWithEnvironments `json:"projects"`
+	Projects   []ProjectWithEnvironments `json:"projects"`
+	TotalCount *int                      `json:"totalCount"`
 }
 
 type ProjectMemberships struct {
@@ -2626,8 +2627,6 @@ type RemoveCertificateSyncCertificatesRequest struct {
 
 type ListCertificateSyncCertificatesRequest struct {
 	CertificateSyncID string
-	Offset            int
-	Limit             int
 }
 
 type ListCertificateSyncCertificatesResponse struct {
@@ -2798,6 +2797,12 @@ type SecretRotation struct {
 	Parameters          map[string]any `json:"parameters"`
 	SecretsMapping      map[string]any `json:"secretsMapping"`
 	TemporaryParameters map[string]any `json:"temporaryParameters"`
+
+	LastRotatedAt           *time.Time `json:"lastRotatedAt"`
+	LastRotationAttemptedAt *time.Time `json:"lastRotationAttemptedAt"`
+	NextRotationAt          *time.Time `json:"nextRotationAt"`
+	RotationStatus          string     `json:"rotationStatus"`
+	LastRotationMessage     *string    `json:"lastRotationMessage"`
 }
 
 type CreateSecretRotationRequest struct {
@@ -2864,6 +2869,15 @@ type DeleteSecretRotationResponse struct {
 	SecretRotation SecretRotation `json:"secretRotation"`
 }
 
+type RotateSecretRotationRequest struct {
+	Provider SecretRotationProvider
+	ID       string
+}
+
+type RotateSecretRotationResponse struct {
+	SecretRotation SecretRotation `json:"secretRotation"`
+}
+
 type ProjectTemplate struct {
 	ID           string                    `json:"id"`
 	Name         string                    `json:"name"`
@@ -3018,8 +3032,6 @@ type GetKMSKeyByNameResponse struct {
 
 type ListKMSKeysRequest struct {
 	ProjectId      string
-	Offset         *int
-	Limit          *int
 	OrderBy        *string
 	OrderDirection *string
 	Search         *string
@@ -0,0 +1,129 @@
+package infisicalclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	infisicalerrors "terraform-provider-infisical/internal/errors"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Every Get a resource refreshes from must report a 404 as ErrNotFound, or the resource's Read
+// fails on drift instead of removing it from state. One Get per resource family is enough to
+// catch a family whose client code took a different path.
+func TestGet_ReturnsErrNotFoundOn404(t *testing.T) {
+	server := httptest.NewServer(jsonResponse(http.StatusNotFound, `{"statusCode":404,"message":"Not found","error":"NotFound"}`))
+	defer server.Close()
+
+	client := Client{Config{HttpClient: resty.New().SetBaseURL(server.URL)}}
+	ctx := context.Background()
+
+	gets := map[string]func() error{
+		"project": func() error {
+			_, err := client.GetProjectById(ctx, GetProjectByIdRequest{})
+			return err
+		},
+		"project environment": func() error {
+			_, err := client.GetProjectEnvironmentByID(ctx, GetProjectEnvironmentByIDRequest{})
+			return err
+		},
+		"project role": func() error {
+			_, err := client.GetProjectRoleBySlug(ctx, GetProjectRoleBySlugRequest{})
+			return err
+		},
+		"project role v2": func() error {
+			_, err := client.GetProjectRoleBySlugV2(ctx, GetProjectRoleBySlugV2Request{})
+			return err
+		},
+		"identity specific privilege": func() error {
+			_, err := client.GetProjectIdentitySpecificPrivilegeV2(ctx, GetProjectIdentitySpecificPrivilegeV2Request{})
+			return err
+		},
+		"secret": func() error {
+			_, err := client.GetSingleSecretByIDV3(ctx, GetSingleSecretByIDV3Request{})
+			return err
+		},
+		"secret folder": func() error {
+			_, err := client.GetSecretFolderByID(ctx, GetSecretFolderByIDRequest{})
+			return err
+		},
+		"secret import": func() error {
+			_, err := client.GetSecretImportByID(ctx, GetSecretImportByIDRequest{})
+			return err
+		},
+		"identity": func() error {
+			_, err := client.GetIdentity(ctx, GetIdentityRequest{})
+			return err
+		},
+		"identity auth method": func() error {
+			_, err := client.GetIdentityUniversalAuth(ctx, GetIdentityUniversalAuthRequest{})
+			return err
+		},
+		"app connection": func() error {
+			_, err := client.GetAppConnectionById(ctx, GetAppConnectionByIdRequest{})
+			return err
+		},
+		"secret sync": func() error {
+			_, err := client.GetSecretSyncById(ctx, GetSecretSyncByIdRequest{})
+			return err
+		},
+		"dynamic secret": func() error {
+			_, err := client.GetDynamicSecretByName(ctx, GetDynamicSecretByNameRequest{})
+			return err
+		},
+		"secret rotation": func() error {
+			_, err := client.GetSecretRotationById(ctx, GetSecretRotationByIdRequest{})
+			return err
+		},
+		"external kms": func() error {
+			_, err := client.GetExternalKmsById(ctx, GetExternalKmsByIdRequest{})
+			return err
+		},
+		"kms key": func() error {
+			_, err := client.GetKMSKey(ctx, GetKMSKeyRequest{})
+			return err
+		},
+		"certificate": func() error {
+			_, err := client.GetCertificate(ctx, GetCertificateRequest{})
+			return err
+		},
+		"ca certificate": func() error {
+			_, err := client.GetCACertificate(ctx, GetCACertificateRequest{})
+			return err
+		},
+		"certificate sync": func() error {
+			_, err := client.GetCertificateSyncById(ctx, GetCertificateSyncByIdRequest{})
+			return err
+		},
+	}
+
+	for name, get := range gets {
+		t.Run(name, func(t *testing.T) {
+			if err := get(); !errors.Is(err, ErrNotFound) {
+				t.Errorf("got error %v, want ErrNotFound", err)
+			}
+		})
+	}
+}
+
+func TestAPIError_MatchesErrNotFoundOnlyFor404(t *testing.T) {
+	for status, want := range map[int]bool{
+		http.StatusNotFound:            true,
+		http.StatusForbidden:           false,
+		http.StatusInternalServerError: false,
+	} {
+		err := fmt.Errorf("context: %w", &infisicalerrors.APIError{Operation: "CallGetProjectById", StatusCode: infisicalerrors.Status(status)})
+		if got := errors.Is(err, ErrNotFound); got != want {
+			t.Errorf("status %d: errors.Is(err, ErrNotFound) = %v, want %v", status, got, want)
+		}
+	}
+
+	if !errors.Is(NewNotFoundError("SecretImport", "id"), ErrNotFound) {
+		t.Error("a NotFoundError does not match ErrNotFound")
+	}
+}
@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"terraform-provider-infisical/internal/errors"
@@ -14,10 +15,10 @@ const (
 	operationGetOrgRoleById   = "CallGetOrgRoleById"
 )
 
-func (client Client) CreateOrgRole(request CreateOrgRoleRequest) (CreateOrgRoleResponse, error) {
+func (client Client) CreateOrgRole(ctx context.Context, request CreateOrgRoleRequest) (CreateOrgRoleResponse, error) {
 	var responseData CreateOrgRoleResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&responseData).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -34,10 +35,10 @@ func (client Client) CreateOrgRole(request CreateOrgRoleRequest) (CreateOrgRoleR
 	return responseData, nil
 }
 
-func (client Client) UpdateOrgRole(request UpdateOrgRoleRequest) (UpdateOrgRoleResponse, error) {
+func (client Client) UpdateOrgRole(ctx context.Context, request UpdateOrgRoleRequest) (UpdateOrgRoleResponse, error) {
 	var responseData UpdateOrgRoleResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&responseData).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -54,10 +55,10 @@ func (client Client) UpdateOrgRole(request UpdateOrgRoleRequest) (UpdateOrgRoleR
 	return responseData, nil
 }
 
-func (client Client) DeleteOrgRole(request DeleteOrgRoleRequest) (DeleteOrgRoleResponse, error) {
+func (client Client) DeleteOrgRole(ctx context.Context, request DeleteOrgRoleRequest) (DeleteOrgRoleResponse, error) {
 	var responseData DeleteOrgRoleResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&responseData).
 		SetHeader("User-Agent", USER_AGENT).
 		Delete(fmt.Sprintf("api/v1/organization/roles/%s", request.RoleId))
@@ -73,10 +74,10 @@ func (client Client) DeleteOrgRole(request DeleteOrgRoleRequest) (DeleteOrgRoleR
 	return responseData, nil
 }
 
-func (client Client) GetOrgRoleBySlug(request GetOrgRoleBySlugRequest) (GetOrgRoleBySlugResponse, error) {
+func (client Client) GetOrgRoleBySlug(ctx context.Context, request GetOrgRoleBySlugRequest) (GetOrgRoleBySlugResponse, error) {
 	var responseData GetOrgRoleBySlugResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&responseData).
 		SetHeader("User-Agent", USER_AGENT).
 		Get(fmt.Sprintf("api/v1/organization/roles/slug/%s", request.RoleSlug))
@@ -95,10 +96,10 @@ func (client Client) GetOrgRoleBySlug(request GetOrgRoleBySlugRequest) (GetOrgRo
 	return responseData, nil
 }
 
-func (client Client) GetOrgRoleById(request GetOrgRoleByIdRequest) (GetOrgRoleByIdResponse, error) {
+func (client Client) GetOrgRoleById(ctx context.Context, request GetOrgRoleByIdRequest) (GetOrgRoleByIdResponse, error) {
 	var responseData GetOrgRoleByIdResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&responseData).
 		SetHeader("User-Agent", USER_AGENT).
 		Get(fmt.Sprintf("api/v1/organization/roles/%s", request.RoleId))
@@ -1,6 +1,9 @@
 package infisicalclient
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // GetOrganizationBySlug resolves an organization by slug. It checks the identity's own org, then scans sub-orgs of its
 // root. ErrNotFound is returned only when both lookups succeed and neither matches.
@@ -9,8 +12,8 @@ import "fmt"
 // to a sub-org via auth.organization_slug. A Machine Identity created inside a sub-org has no root
 // membership, so both sources fail; either failure is surfaced rather than treated as
 // ErrNotFound, since an unavailable source cannot prove absence.
-func (client Client) GetOrganizationBySlug(slug string) (Organization, error) {
-	identityDetails, detailsErr := client.GetIdentityDetails()
+func (client Client) GetOrganizationBySlug(ctx context.Context, slug string) (Organization, error) {
+	identityDetails, detailsErr := client.GetIdentityDetails(ctx)
 	if detailsErr == nil {
 		ownOrg := identityDetails.IdentityDetails.Organization
 		if ownOrg.Slug == slug {
@@ -19,7 +22,7 @@ func (client Client) GetOrganizationBySlug(slug string) (Organization, error) {
 		}
 	}
 
-	subOrgs, listErr := client.ListSubOrganizations()
+	subOrgs, listErr := client.ListSubOrganizations(ctx)
 	if listErr == nil {
 		for _, subOrg := range subOrgs {
 			if subOrg.Slug == slug {
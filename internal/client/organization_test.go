@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"net/http/httptest"
@@ -44,7 +45,7 @@ func TestGetOrganizationBySlugBothLookupsFail(t *testing.T) {
 		jsonResponse(http.StatusForbidden, `{"statusCode":403,"message":"You are not a member of this organization","reqId":"req-list"}`),
 	)
 
-	_, err := client.GetOrganizationBySlug("some-sub-org")
+	_, err := client.GetOrganizationBySlug(context.Background(), "some-sub-org")
 	if err == nil {
 		t.Fatal("expected an error when both organization lookups fail")
 	}
@@ -59,7 +60,7 @@ func TestGetOrganizationBySlugBothLookupsFail(t *testing.T) {
 	// errors.Is/errors.As can traverse are all acceptable.
 	statuses := map[int]bool{}
 	for _, apiErr := range collectAPIErrors(err) {
-		statuses[apiErr.StatusCode] = true
+		statuses[int(apiErr.StatusCode)] = true
 	}
 	for _, want := range []int{http.StatusInternalServerError, http.StatusForbidden} {
 		if !statuses[want] {
@@ -87,7 +88,7 @@ func TestGetOrganizationBySlugDetailsFailureIsNotAbsence(t *testing.T) {
 		jsonResponse(http.StatusOK, `{"organizations":[],"totalCount":0}`),
 	)
 
-	_, err := client.GetOrganizationBySlug("missing")
+	_, err := client.GetOrganizationBySlug(context.Background(), "missing")
 	if err == nil {
 		t.Fatal("expected an error when the identity organization lookup fails")
 	}
@@ -112,7 +113,7 @@ func TestGetOrganizationBySlugListFailureIsNotAbsence(t *testing.T) {
 		jsonResponse(http.StatusForbidden, `{"statusCode":403,"message":"forbidden","reqId":"req-list"}`),
 	)
 
-	_, err := client.GetOrganizationBySlug("some-sub-org")
+	_, err := client.GetOrganizationBySlug(context.Background(), "some-sub-org")
 	if err == nil {
 		t.Fatal("expected an error when the sub-organization lookup fails")
 	}
@@ -138,7 +139,7 @@ func TestGetOrganizationBySlugNotFoundOnlyWhenBothSourcesAnswer(t *testing.T) {
 		jsonResponse(http.StatusOK, `{"organizations":[{"id":"22222222-2222-2222-2222-222222222222","name":"Sub","slug":"sub-org","parentOrgId":"11111111-1111-1111-1111-111111111111"}],"totalCount":1}`),
 	)
 
-	if _, err := client.GetOrganizationBySlug("nope"); !errors.Is(err, ErrNotFound) {
+	if _, err := client.GetOrganizationBySlug(context.Background(), "nope"); !errors.Is(err, ErrNotFound) {
 		t.Errorf("expected ErrNotFound when both lookups succeed and neither matches, got %v", err)
 	}
 }
@@ -164,7 +165,7 @@ func TestGetOrganizationBySlugResolves(t *testing.T) {
 	for _, c := range cases {
 		t.Run(c.name, func(t *testing.T) {
 			client := orgLookupServer(t, details, subOrgs)
-			org, err := client.GetOrganizationBySlug(c.slug)
+			org, err := client.GetOrganizationBySlug(context.Background(), c.slug)
 			if c.wantErr {
 				if !errors.Is(err, ErrNotFound) {
 					t.Fatalf("expected ErrNotFound for %q, got %v", c.slug, err)
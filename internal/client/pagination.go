@@ -0,0 +1,87 @@
+package infisicalclient
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// defaultPageSize is the number of items requested per page, within the limit every paginated
+// endpoint of the API accepts.
+const defaultPageSize = 100
+
+// ListOptions bounds the items a list call returns.
+type ListOptions struct {
+	// MaxResults caps the number of items returned; zero returns every item.
+	MaxResults int
+}
+
+// ListResult holds the items returned by a list call.
+type ListResult[T any] struct {
+	Items []T
+	// Truncated is set when more items exist than ListOptions.MaxResults let through.
+	Truncated bool
+}
+
+// unknownTotalCount is returned by a page fetcher whose endpoint does not report the total
+// number of items.
+const unknownTotalCount = -1
+
+// pageFetcher requests the page of a list endpoint starting at offset, returning its items and
+// the total number of items, or unknownTotalCount.
+type pageFetcher[T any] func(offset, limit int) (items []T, totalCount int, err error)
+
+// paginate pages through a list endpoint with offset and limit query parameters until every item
+// is retrieved or opts.MaxResults is reached. Pagination advances by the number of items each page
+// returned, so an endpoint capping the limit below the one requested loses nothing. Endpoints that
+// ignore the pagination parameters are detected, either by returning more items than the limit or
+// by returning the same page again, so they are listed once rather than looped over.
+func paginate[T any](pageSize int, opts ListOptions, fetch pageFetcher[T]) (ListResult[T], error) {
+	items := []T{}
+	var previousPage []T
+	offset := 0
+
+	for {
+		limit := pageSize
+		if opts.MaxResults > 0 {
+			// One item past the cap is requested, so a capped list can be told from a complete one.
+			limit = min(limit, opts.MaxResults-len(items)+1)
+		}
+
+		page, totalCount, err := fetch(offset, limit)
+		if err != nil {
+			return ListResult[T]{}, err
+		}
+		if offset > 0 && reflect.DeepEqual(page, previousPage) {
+			break
+		}
+
+		items = append(items, page...)
+		offset += len(page)
+		previousPage = page
+
+		if opts.MaxResults > 0 && len(items) > opts.MaxResults {
+			return ListResult[T]{Items: items[:opts.MaxResults], Truncated: true}, nil
+		}
+
+		if len(page) == 0 || len(page) > limit {
+			break
+		}
+		if totalCount == unknownTotalCount {
+			if len(page) < limit {
+				break
+			}
+		} else if offset >= totalCount {
+			break
+		}
+	}
+
+	return ListResult[T]{Items: items}, nil
+}
+
+// pageQuery returns the query parameters requesting a page.
+func pageQuery(offset, limit int) map[string]string {
+	return map[string]string{
+		"offset": fmt.Sprintf("%d", offset),
+		"limit":  fmt.Sprintf("%d", limit),
+	}
+}
@@ -0,0 +1,141 @@
+package infisicalclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// listEndpoint serves total items of a list endpoint, honouring offset and limit up to maxLimit
+// items per page. It records the pages requested.
+type listEndpoint struct {
+	total    int
+	maxLimit int
+
+	// reportTotal includes the total count in each page.
+	reportTotal bool
+	// ignorePagination returns every item regardless of offset and limit.
+	ignorePagination bool
+
+	requests []string
+}
+
+func (e *listEndpoint) fetch(offset, limit int) ([]int, int, error) {
+	e.requests = append(e.requests, fmt.Sprintf("%d+%d", offset, limit))
+
+	end := e.total
+	if !e.ignorePagination {
+		end = min(offset+min(limit, e.maxLimit), e.total)
+	} else {
+		offset = 0
+	}
+
+	items := []int{}
+	for i := offset; i < end; i++ {
+		items = append(items, i)
+	}
+
+	if !e.reportTotal {
+		return items, unknownTotalCount, nil
+	}
+	return items, e.total, nil
+}
+
+func TestPaginate(t *testing.T) {
+	cases := map[string]struct {
+		endpoint      listEndpoint
+		maxResults    int
+		wantItems     int
+		wantTruncated bool
+		wantRequests  string
+	}{
+		"total count":                {endpoint: listEndpoint{total: 250, maxLimit: 100, reportTotal: true}, wantItems: 250, wantRequests: "0+100 100+100 200+100"},
+		"exact multiple of the page": {endpoint: listEndpoint{total: 200, maxLimit: 100, reportTotal: true}, wantItems: 200, wantRequests: "0+100 100+100"},
+		"no total count":             {endpoint: listEndpoint{total: 250, maxLimit: 100}, wantItems: 250, wantRequests: "0+100 100+100 200+100"},
+		"no total count, exact":      {endpoint: listEndpoint{total: 200, maxLimit: 100}, wantItems: 200, wantRequests: "0+100 100+100 200+100"},
+		"limit capped by the server": {endpoint: listEndpoint{total: 120, maxLimit: 50, reportTotal: true}, wantItems: 120, wantRequests: "0+100 50+100 100+100"},
+		"empty":                      {endpoint: listEndpoint{total: 0, maxLimit: 100, reportTotal: true}, wantItems: 0, wantRequests: "0+100"},
+		"pagination ignored, long":   {endpoint: listEndpoint{total: 150, ignorePagination: true}, wantItems: 150, wantRequests: "0+100"},
+		"pagination ignored, exact":  {endpoint: listEndpoint{total: 100, ignorePagination: true}, wantItems: 100, wantRequests: "0+100 100+100"},
+		"capped":                     {endpoint: listEndpoint{total: 250, maxLimit: 100, reportTotal: true}, maxResults: 150, wantItems: 150, wantTruncated: true, wantRequests: "0+100 100+51"},
+		"cap not reached":            {endpoint: listEndpoint{total: 150, maxLimit: 100, reportTotal: true}, maxResults: 150, wantItems: 150, wantRequests: "0+100 100+51"},
+		"capped, pagination ignored": {endpoint: listEndpoint{total: 150, ignorePagination: true}, maxResults: 10, wantItems: 10, wantTruncated: true, wantRequests: "0+11"},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			endpoint := c.endpoint
+			result, err := paginate(100, ListOptions{MaxResults: c.maxResults}, endpoint.fetch)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(result.Items) != c.wantItems || result.Truncated != c.wantTruncated {
+				t.Errorf("got %d items (truncated: %v), want %d (truncated: %v)", len(result.Items), result.Truncated, c.wantItems, c.wantTruncated)
+			}
+			for i, item := range result.Items {
+				if item != i {
+					t.Fatalf("item %d = %d, the items are duplicated or out of order", i, item)
+				}
+			}
+			if got := strings.Join(endpoint.requests, " "); got != c.wantRequests {
+				t.Errorf("requested pages %q, want %q", got, c.wantRequests)
+			}
+		})
+	}
+}
+
+func TestPaginate_ReturnsTheFetchError(t *testing.T) {
+	calls := 0
+	_, err := paginate(10, ListOptions{}, func(offset, limit int) ([]int, int, error) {
+		calls++
+		if calls == 2 {
+			return nil, 0, fmt.Errorf("page failed")
+		}
+		return make([]int, limit), 100, nil
+	})
+	if err == nil || err.Error() != "page failed" {
+		t.Fatalf("got error %v, want the page error", err)
+	}
+}
+
+func TestGetProjects_ListsEveryPage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		var projects []string
+		for i := offset; i < min(offset+limit, 130); i++ {
+			projects = append(projects, fmt.Sprintf(`{"id":"project-%d"}`, i))
+		}
+		jsonResponse(http.StatusOK, `{"projects":[`+strings.Join(projects, ",")+`]}`)(w, r)
+	}))
+	defer server.Close()
+
+	client := Client{Config{HttpClient: resty.New().SetBaseURL(server.URL)}}
+
+	projects, err := client.GetProjects(context.Background(), ListOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(projects.Items) != 130 || projects.Truncated {
+		t.Fatalf("got %d projects (truncated: %v), want all 130", len(projects.Items), projects.Truncated)
+	}
+	if projects.Items[129].ID != "project-129" {
+		t.Errorf("last project = %q, want project-129", projects.Items[129].ID)
+	}
+
+	capped, err := client.GetProjects(context.Background(), ListOptions{MaxResults: 20})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(capped.Items) != 20 || !capped.Truncated {
+		t.Fatalf("got %d projects (truncated: %v), want the first 20 and a truncated list", len(capped.Items), capped.Truncated)
+	}
+}
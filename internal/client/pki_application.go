@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"terraform-provider-infisical/internal/errors"
 )
@@ -12,10 +13,10 @@ const (
 	operationDeletePkiApplication = "CallDeletePkiApplication"
 )
 
-func (client Client) CreatePkiApplication(request CreatePkiApplicationRequest) (CreatePkiApplicationResponse, error) {
+func (client Client) CreatePkiApplication(ctx context.Context, request CreatePkiApplicationRequest) (CreatePkiApplicationResponse, error) {
 	var applicationResponse CreatePkiApplicationResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&applicationResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -32,10 +33,10 @@ func (client Client) CreatePkiApplication(request CreatePkiApplicationRequest) (
 	return applicationResponse, nil
 }
 
-func (client Client) GetPkiApplication(request GetPkiApplicationRequest) (GetPkiApplicationResponse, error) {
+func (client Client) GetPkiApplication(ctx context.Context, request GetPkiApplicationRequest) (GetPkiApplicationResponse, error) {
 	var applicationResponse GetPkiApplicationResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&applicationResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Get(fmt.Sprintf("api/v1/cert-manager/applications/%s", request.ApplicationId))
@@ -54,10 +55,10 @@ func (client Client) GetPkiApplication(request GetPkiApplicationRequest) (GetPki
 	return applicationResponse, nil
 }
 
-func (client Client) UpdatePkiApplication(request UpdatePkiApplicationRequest) (UpdatePkiApplicationResponse, error) {
+func (client Client) UpdatePkiApplication(ctx context.Context, request UpdatePkiApplicationRequest) (UpdatePkiApplicationResponse, error) {
 	var applicationResponse UpdatePkiApplicationResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&applicationResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -74,10 +75,10 @@ func (client Client) UpdatePkiApplication(request UpdatePkiApplicationRequest) (
 	return applicationResponse, nil
 }
 
-func (client Client) DeletePkiApplication(request DeletePkiApplicationRequest) (DeletePkiApplicationResponse, error) {
+func (client Client) DeletePkiApplication(ctx context.Context, request DeletePkiApplicationRequest) (DeletePkiApplicationResponse, error) {
 	var applicationResponse DeletePkiApplicationResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&applicationResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Delete(fmt.Sprintf("api/v1/cert-manager/applications/%s", request.ApplicationId))
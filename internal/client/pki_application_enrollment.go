@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"terraform-provider-infisical/internal/errors"
 )
@@ -18,10 +19,10 @@ const (
 	operationClearPkiApplicationScepEnrollment = "CallClearPkiApplicationScepEnrollment"
 )
 
-func (client Client) GetPkiApplicationEnrollment(request GetPkiApplicationEnrollmentRequest) (GetPkiApplicationEnrollmentResponse, error) {
+func (client Client) GetPkiApplicationEnrollment(ctx context.Context, request GetPkiApplicationEnrollmentRequest) (GetPkiApplicationEnrollmentResponse, error) {
 	var enrollmentResponse GetPkiApplicationEnrollmentResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&enrollmentResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Get(fmt.Sprintf("api/v1/cert-manager/applications/%s/profiles/%s/enrollment", request.ApplicationId, request.ProfileId))
@@ -40,10 +41,10 @@ func (client Client) GetPkiApplicationEnrollment(request GetPkiApplicationEnroll
 	return enrollmentResponse, nil
 }
 
-func (client Client) SetPkiApplicationApiEnrollment(request SetPkiApplicationApiEnrollmentRequest) (SetPkiApplicationApiEnrollmentResponse, error) {
+func (client Client) SetPkiApplicationApiEnrollment(ctx context.Context, request SetPkiApplicationApiEnrollmentRequest) (SetPkiApplicationApiEnrollmentResponse, error) {
 	var enrollmentResponse SetPkiApplicationApiEnrollmentResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&enrollmentResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -60,10 +61,10 @@ func (client Client) SetPkiApplicationApiEnrollment(request SetPkiApplicationApi
 	return enrollmentResponse, nil
 }
 
-func (client Client) ClearPkiApplicationApiEnrollment(request ClearPkiApplicationApiEnrollmentRequest) (ClearPkiApplicationApiEnrollmentResponse, error) {
+func (client Client) ClearPkiApplicationApiEnrollment(ctx context.Context, request ClearPkiApplicationApiEnrollmentRequest) (ClearPkiApplicationApiEnrollmentResponse, error) {
 	var enrollmentResponse ClearPkiApplicationApiEnrollmentResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&enrollmentResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Delete(fmt.Sprintf("api/v1/cert-manager/applications/%s/profiles/%s/enrollment/api", request.ApplicationId, request.ProfileId))
@@ -79,10 +80,10 @@ func (client Client) ClearPkiApplicationApiEnrollment(request ClearPkiApplicatio
 	return enrollmentResponse, nil
 }
 
-func (client Client) SetPkiApplicationEstEnrollment(request SetPkiApplicationEstEnrollmentRequest) (SetPkiApplicationEstEnrollmentResponse, error) {
+func (client Client) SetPkiApplicationEstEnrollment(ctx context.Context, request SetPkiApplicationEstEnrollmentRequest) (SetPkiApplicationEstEnrollmentResponse, error) {
 	var enrollmentResponse SetPkiApplicationEstEnrollmentResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&enrollmentResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -99,10 +100,10 @@ func (client Client) SetPkiApplicationEstEnrollment(request SetPkiApplicationEst
 	return enrollmentResponse, nil
 }
 
-func (client Client) ClearPkiApplicationEstEnrollment(request ClearPkiApplicationEstEnrollmentRequest) (ClearPkiApplicationEstEnrollmentResponse, error) {
+func (client Client) ClearPkiApplicationEstEnrollment(ctx context.Context, request ClearPkiApplicationEstEnrollmentRequest) (ClearPkiApplicationEstEnrollmentResponse, error) {
 	var enrollmentResponse ClearPkiApplicationEstEnrollmentResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&enrollmentResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Delete(fmt.Sprintf("api/v1/cert-manager/applications/%s/profiles/%s/enrollment/est", request.ApplicationId, request.ProfileId))
@@ -118,10 +119,10 @@ func (client Client) ClearPkiApplicationEstEnrollment(request ClearPkiApplicatio
 	return enrollmentResponse, nil
 }
 
-func (client Client) SetPkiApplicationAcmeEnrollment(request SetPkiApplicationAcmeEnrollmentRequest) (SetPkiApplicationAcmeEnrollmentResponse, error) {
+func (client Client) SetPkiApplicationAcmeEnrollment(ctx context.Context, request SetPkiApplicationAcmeEnrollmentRequest) (SetPkiApplicationAcmeEnrollmentResponse, error) {
 	var enrollmentResponse SetPkiApplicationAcmeEnrollmentResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&enrollmentResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -138,10 +139,10 @@ func (client Client) SetPkiApplicationAcmeEnrollment(request SetPkiApplicationAc
 	return enrollmentResponse, nil
 }
 
-func (client Client) ClearPkiApplicationAcmeEnrollment(request ClearPkiApplicationAcmeEnrollmentRequest) (ClearPkiApplicationAcmeEnrollmentResponse, error) {
+func (client Client) ClearPkiApplicationAcmeEnrollment(ctx context.Context, request ClearPkiApplicationAcmeEnrollmentRequest) (ClearPkiApplicationAcmeEnrollmentResponse, error) {
 	var enrollmentResponse ClearPkiApplicationAcmeEnrollmentResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&enrollmentResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Delete(fmt.Sprintf("api/v1/cert-manager/applications/%s/profiles/%s/enrollment/acme", request.ApplicationId, request.ProfileId))
@@ -157,10 +158,10 @@ func (client Client) ClearPkiApplicationAcmeEnrollment(request ClearPkiApplicati
 	return enrollmentResponse, nil
 }
 
-func (client Client) RevealPkiApplicationAcmeEabSecret(request RevealPkiApplicationAcmeEabSecretRequest) (RevealPkiApplicationAcmeEabSecretResponse, error) {
+func (client Client) RevealPkiApplicationAcmeEabSecret(ctx context.Context, request RevealPkiApplicationAcmeEabSecretRequest) (RevealPkiApplicationAcmeEabSecretResponse, error) {
 	var eabResponse RevealPkiApplicationAcmeEabSecretResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&eabResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Post(fmt.Sprintf("api/v1/cert-manager/applications/%s/profiles/%s/enrollment/acme/eab/reveal", request.ApplicationId, request.ProfileId))
@@ -176,10 +177,10 @@ func (client Client) RevealPkiApplicationAcmeEabSecret(request RevealPkiApplicat
 	return eabResponse, nil
 }
 
-func (client Client) SetPkiApplicationScepEnrollment(request SetPkiApplicationScepEnrollmentRequest) (SetPkiApplicationScepEnrollmentResponse, error) {
+func (client Client) SetPkiApplicationScepEnrollment(ctx context.Context, request SetPkiApplicationScepEnrollmentRequest) (SetPkiApplicationScepEnrollmentResponse, error) {
 	var enrollmentResponse SetPkiApplicationScepEnrollmentResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&enrollmentResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -196,10 +197,10 @@ func (client Client) SetPkiApplicationScepEnrollment(request SetPkiApplicationSc
 	return enrollmentResponse, nil
 }
 
-func (client Client) ClearPkiApplicationScepEnrollment(request ClearPkiApplicationScepEnrollmentRequest) (ClearPkiApplicationScepEnrollmentResponse, error) {
+func (client Client) ClearPkiApplicationScepEnrollment(ctx context.Context, request ClearPkiApplicationScepEnrollmentRequest) (ClearPkiApplicationScepEnrollmentResponse, error) {
 	var enrollmentResponse ClearPkiApplicationScepEnrollmentResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&enrollmentResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Delete(fmt.Sprintf("api/v1/cert-manager/applications/%s/profiles/%s/enrollment/scep", request.ApplicationId, request.ProfileId))
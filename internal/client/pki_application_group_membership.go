@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"terraform-provider-infisical/internal/errors"
 )
@@ -12,10 +13,10 @@ const (
 	operationRemovePkiApplicationGroupMember     = "CallRemovePkiApplicationGroupMember"
 )
 
-func (client Client) ListPkiApplicationGroupMembers(request ListPkiApplicationGroupMembersRequest) (ListPkiApplicationGroupMembersResponse, error) {
+func (client Client) ListPkiApplicationGroupMembers(ctx context.Context, request ListPkiApplicationGroupMembersRequest) (ListPkiApplicationGroupMembersResponse, error) {
 	var membersResponse ListPkiApplicationGroupMembersResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&membersResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Get(fmt.Sprintf("api/v1/cert-manager/applications/%s/groups", request.ApplicationId))
@@ -34,10 +35,10 @@ func (client Client) ListPkiApplicationGroupMembers(request ListPkiApplicationGr
 	return membersResponse, nil
 }
 
-func (client Client) AddPkiApplicationGroupMember(request AddPkiApplicationGroupMemberRequest) (AddPkiApplicationGroupMemberResponse, error) {
+func (client Client) AddPkiApplicationGroupMember(ctx context.Context, request AddPkiApplicationGroupMemberRequest) (AddPkiApplicationGroupMemberResponse, error) {
 	var memberResponse AddPkiApplicationGroupMemberResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&memberResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -54,10 +55,10 @@ func (client Client) AddPkiApplicationGroupMember(request AddPkiApplicationGroup
 	return memberResponse, nil
 }
 
-func (client Client) UpdatePkiApplicationGroupMemberRole(request UpdatePkiApplicationGroupMemberRoleRequest) (UpdatePkiApplicationGroupMemberRoleResponse, error) {
+func (client Client) UpdatePkiApplicationGroupMemberRole(ctx context.Context, request UpdatePkiApplicationGroupMemberRoleRequest) (UpdatePkiApplicationGroupMemberRoleResponse, error) {
 	var memberResponse UpdatePkiApplicationGroupMemberRoleResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&memberResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -74,10 +75,10 @@ func (client Client) UpdatePkiApplicationGroupMemberRole(request UpdatePkiApplic
 	return memberResponse, nil
 }
 
-func (client Client) RemovePkiApplicationGroupMember(request RemovePkiApplicationGroupMemberRequest) (RemovePkiApplicationGroupMemberResponse, error) {
+func (client Client) RemovePkiApplicationGroupMember(ctx context.Context, request RemovePkiApplicationGroupMemberRequest) (RemovePkiApplicationGroupMemberResponse, error) {
 	var memberResponse RemovePkiApplicationGroupMemberResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&memberResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Delete(fmt.Sprintf("api/v1/cert-manager/applications/%s/groups/%s", request.ApplicationId, request.GroupId))
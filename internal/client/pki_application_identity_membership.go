@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"terraform-provider-infisical/internal/errors"
 )
@@ -12,10 +13,10 @@ const (
 	operationRemovePkiApplicationIdentityMember     = "CallRemovePkiApplicationIdentityMember"
 )
 
-func (client Client) ListPkiApplicationIdentityMembers(request ListPkiApplicationIdentityMembersRequest) (ListPkiApplicationIdentityMembersResponse, error) {
+func (client Client) ListPkiApplicationIdentityMembers(ctx context.Context, request ListPkiApplicationIdentityMembersRequest) (ListPkiApplicationIdentityMembersResponse, error) {
 	var membersResponse ListPkiApplicationIdentityMembersResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&membersResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Get(fmt.Sprintf("api/v1/cert-manager/applications/%s/identities", request.ApplicationId))
@@ -34,10 +35,10 @@ func (client Client) ListPkiApplicationIdentityMembers(request ListPkiApplicatio
 	return membersResponse, nil
 }
 
-func (client Client) AddPkiApplicationIdentityMember(request AddPkiApplicationIdentityMemberRequest) (AddPkiApplicationIdentityMemberResponse, error) {
+func (client Client) AddPkiApplicationIdentityMember(ctx context.Context, request AddPkiApplicationIdentityMemberRequest) (AddPkiApplicationIdentityMemberResponse, error) {
 	var memberResponse AddPkiApplicationIdentityMemberResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&memberResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -54,10 +55,10 @@ func (client Client) AddPkiApplicationIdentityMember(request AddPkiApplicationId
 	return memberResponse, nil
 }
 
-func (client Client) UpdatePkiApplicationIdentityMemberRole(request UpdatePkiApplicationIdentityMemberRoleRequest) (UpdatePkiApplicationIdentityMemberRoleResponse, error) {
+func (client Client) UpdatePkiApplicationIdentityMemberRole(ctx context.Context, request UpdatePkiApplicationIdentityMemberRoleRequest) (UpdatePkiApplicationIdentityMemberRoleResponse, error) {
 	var memberResponse UpdatePkiApplicationIdentityMemberRoleResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&memberResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -74,10 +75,10 @@ func (client Client) UpdatePkiApplicationIdentityMemberRole(request UpdatePkiApp
 	return memberResponse, nil
 }
 
-func (client Client) RemovePkiApplicationIdentityMember(request RemovePkiApplicationIdentityMemberRequest) (RemovePkiApplicationIdentityMemberResponse, error) {
+func (client Client) RemovePkiApplicationIdentityMember(ctx context.Context, request RemovePkiApplicationIdentityMemberRequest) (RemovePkiApplicationIdentityMemberResponse, error) {
 	var memberResponse RemovePkiApplicationIdentityMemberResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&memberResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Delete(fmt.Sprintf("api/v1/cert-manager/applications/%s/identities/%s", request.ApplicationId, request.IdentityId))
@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"terraform-provider-infisical/internal/errors"
 )
@@ -11,10 +12,10 @@ const (
 	operationDetachPkiApplicationProfile = "CallDetachPkiApplicationProfile"
 )
 
-func (client Client) ListPkiApplicationProfiles(request ListPkiApplicationProfilesRequest) (ListPkiApplicationProfilesResponse, error) {
+func (client Client) ListPkiApplicationProfiles(ctx context.Context, request ListPkiApplicationProfilesRequest) (ListPkiApplicationProfilesResponse, error) {
 	var profilesResponse ListPkiApplicationProfilesResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&profilesResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Get(fmt.Sprintf("api/v1/cert-manager/applications/%s/profiles", request.ApplicationId))
@@ -33,10 +34,10 @@ func (client Client) ListPkiApplicationProfiles(request ListPkiApplicationProfil
 	return profilesResponse, nil
 }
 
-func (client Client) AttachPkiApplicationProfiles(request AttachPkiApplicationProfilesRequest) (AttachPkiApplicationProfilesResponse, error) {
+func (client Client) AttachPkiApplicationProfiles(ctx context.Context, request AttachPkiApplicationProfilesRequest) (AttachPkiApplicationProfilesResponse, error) {
 	var profilesResponse AttachPkiApplicationProfilesResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&profilesResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -53,10 +54,10 @@ func (client Client) AttachPkiApplicationProfiles(request AttachPkiApplicationPr
 	return profilesResponse, nil
 }
 
-func (client Client) DetachPkiApplicationProfile(request DetachPkiApplicationProfileRequest) (DetachPkiApplicationProfileResponse, error) {
+func (client Client) DetachPkiApplicationProfile(ctx context.Context, request DetachPkiApplicationProfileRequest) (DetachPkiApplicationProfileResponse, error) {
 	var profileResponse DetachPkiApplicationProfileResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&profileResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Delete(fmt.Sprintf("api/v1/cert-manager/applications/%s/profiles/%s", request.ApplicationId, request.ProfileId))
@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"terraform-provider-infisical/internal/errors"
 )
@@ -12,10 +13,10 @@ const (
 	operationRemovePkiApplicationUserMember     = "CallRemovePkiApplicationUserMember"
 )
 
-func (client Client) AddPkiApplicationUserMembers(request AddPkiApplicationUserMembersRequest) (AddPkiApplicationUserMembersResponse, error) {
+func (client Client) AddPkiApplicationUserMembers(ctx context.Context, request AddPkiApplicationUserMembersRequest) (AddPkiApplicationUserMembersResponse, error) {
 	var membersResponse AddPkiApplicationUserMembersResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&membersResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -32,10 +33,10 @@ func (client Client) AddPkiApplicationUserMembers(request AddPkiApplicationUserM
 	return membersResponse, nil
 }
 
-func (client Client) ListPkiApplicationUserMembers(request ListPkiApplicationUserMembersRequest) (ListPkiApplicationUserMembersResponse, error) {
+func (client Client) ListPkiApplicationUserMembers(ctx context.Context, request ListPkiApplicationUserMembersRequest) (ListPkiApplicationUserMembersResponse, error) {
 	var membersResponse ListPkiApplicationUserMembersResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&membersResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Get(fmt.Sprintf("api/v1/cert-manager/applications/%s/users", request.ApplicationId))
@@ -54,10 +55,10 @@ func (client Client) ListPkiApplicationUserMembers(request ListPkiApplicationUse
 	return membersResponse, nil
 }
 
-func (client Client) UpdatePkiApplicationUserMemberRole(request UpdatePkiApplicationUserMemberRoleRequest) (UpdatePkiApplicationUserMemberRoleResponse, error) {
+func (client Client) UpdatePkiApplicationUserMemberRole(ctx context.Context, request UpdatePkiApplicationUserMemberRoleRequest) (UpdatePkiApplicationUserMemberRoleResponse, error) {
 	var memberResponse UpdatePkiApplicationUserMemberRoleResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&memberResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -74,10 +75,10 @@ func (client Client) UpdatePkiApplicationUserMemberRole(request UpdatePkiApplica
 	return memberResponse, nil
 }
 
-func (client Client) RemovePkiApplicationUserMember(request RemovePkiApplicationUserMemberRequest) (RemovePkiApplicationUserMemberResponse, error) {
+func (client Client) RemovePkiApplicationUserMember(ctx context.Context, request RemovePkiApplicationUserMemberRequest) (RemovePkiApplicationUserMemberResponse, error) {
 	var memberResponse RemovePkiApplicationUserMemberResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&memberResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Delete(fmt.Sprintf("api/v1/cert-manager/applications/%s/users/%s", request.ApplicationId, request.UserId))
@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"terraform-provider-infisical/internal/errors"
@@ -17,7 +18,7 @@ const (
 	operationGetProjectEnvironmentBySlug    = "CallGetProjectEnvironmentBySlug"
 )
 
-func (client Client) CreateProject(request CreateProjectRequest) (CreateProjectResponse, error) {
+func (client Client) CreateProject(ctx context.Context, request CreateProjectRequest) (CreateProjectResponse, error) {
 
 	if request.Slug == "" {
 		request = CreateProjectRequest{
@@ -28,7 +29,7 @@ func (client Client) CreateProject(request CreateProjectRequest) (CreateProjectR
 
 	var projectResponse CreateProjectResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&projectResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -45,10 +46,10 @@ func (client Client) CreateProject(request CreateProjectRequest) (CreateProjectR
 	return projectResponse, nil
 }
 
-func (client Client) DeleteProject(request DeleteProjectRequest) error {
+func (client Client) DeleteProject(ctx context.Context, request DeleteProjectRequest) error {
 	var projectResponse DeleteProjectResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&projectResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Delete(fmt.Sprintf("api/v2/workspace/%s", request.Slug))
@@ -64,10 +65,10 @@ func (client Client) DeleteProject(request DeleteProjectRequest) error {
 	return nil
 }
 
-func (client Client) GetProject(request GetProjectRequest) (ProjectWithEnvironments, error) {
+func (client Client) GetProject(ctx context.Context, request GetProjectRequest) (ProjectWithEnvironments, error) {
 	var projectResponse ProjectWithEnvironments
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&projectResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Get(fmt.Sprintf("api/v2/workspace/%s", request.Slug))
@@ -87,29 +88,36 @@ func (client Client) GetProject(request GetProjectRequest) (ProjectWithEnvironme
 	return projectResponse, nil
 }
 
-func (client Client) GetProjects() (GetProjectsResponse, error) {
-	var body GetProjectsResponse
-	response, err := client.Config.HttpClient.
-		R().
-		SetResult(&body).
-		SetHeader("User-Agent", USER_AGENT).
-		Get("api/v1/projects")
-
-	if err != nil {
-		return GetProjectsResponse{}, errors.NewGenericRequestError(operationGetProjects, err)
-	}
+// GetProjects returns the projects the machine identity can access, up to opts.MaxResults.
+func (client Client) GetProjects(ctx context.Context, opts ListOptions) (ListResult[ProjectWithEnvironments], error) {
+	return paginate(defaultPageSize, opts, func(offset, limit int) ([]ProjectWithEnvironments, int, error) {
+		var body GetProjectsResponse
+		response, err := client.Config.HttpClient.
+			R().SetContext(ctx).
+			SetResult(&body).
+			SetHeader("User-Agent", USER_AGENT).
+			SetQueryParams(pageQuery(offset, limit)).
+			Get("api/v1/projects")
+
+		if err != nil {
+			return nil, 0, errors.NewGenericRequestError(operationGetProjects, err)
+		}
 
-	if response.IsError() {
-		return GetProjectsResponse{}, errors.NewAPIErrorWithResponse(operationGetProjects, response, nil)
-	}
+		if response.IsError() {
+			return nil, 0, errors.NewAPIErrorWithResponse(operationGetProjects, response, nil)
+		}
 
-	return body, nil
+		if body.TotalCount == nil {
+			return body.Projects, unknownTotalCount, nil
+		}
+		return body.Projects, *body.TotalCount, nil
+	})
 }
 
-func (client Client) UpdateProject(request UpdateProjectRequest) (UpdateProjectResponse, error) {
+func (client Client) UpdateProject(ctx context.Context, request UpdateProjectRequest) (UpdateProjectResponse, error) {
 	var projectResponse UpdateProjectResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&projectResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -126,10 +134,10 @@ func (client Client) UpdateProject(request UpdateProjectRequest) (UpdateProjectR
 	return projectResponse, nil
 }
 
-func (client Client) UpdateProjectAuditLogRetention(request UpdateProjectAuditLogRetentionRequest) (UpdateProjectAuditLogRetentionResponse, error) {
+func (client Client) UpdateProjectAuditLogRetention(ctx context.Context, request UpdateProjectAuditLogRetentionRequest) (UpdateProjectAuditLogRetentionResponse, error) {
 	var projectResponse UpdateProjectAuditLogRetentionResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&projectResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -146,13 +154,9 @@ func (client Client) UpdateProjectAuditLogRetention(request UpdateProjectAuditLo
 	return projectResponse, nil
 }
 
-func (client Client) GetProjectById(request GetProjectByIdRequest) (ProjectWithEnvironments, error) {
+func (client Client) GetProjectById(ctx context.Context, request GetProjectByIdRequest) (ProjectWithEnvironments, error) {
 	var projectResponse GetProjectByIdResponse
-	response, err := client.Config.HttpClient.
-		R().
-		SetResult(&projectResponse).
-		SetHeader("User-Agent", USER_AGENT).
-		Get(fmt.Sprintf("api/v1/workspace/%s", request.ID))
+	response, err := client.cachedGet(ctx, fmt.Sprintf("api/v1/workspace/%s", request.ID), nil, &projectResponse)
 
 	if err != nil {
 		return ProjectWithEnvironments{}, errors.NewGenericRequestError(operationGetProjectById, err)
@@ -168,13 +172,9 @@ func (client Client) GetProjectById(request GetProjectByIdRequest) (ProjectWithE
 	return projectResponse.Workspace, nil
 }
 
-func (client Client) GetProjectEnvironmentBySlug(request GetProjectEnvironmentBySlugRequest) (ProjectEnvironmentWithPosition, error) {
+func (client Client) GetProjectEnvironmentBySlug(ctx context.Context, request GetProjectEnvironmentBySlugRequest) (ProjectEnvironmentWithPosition, error) {
 	var environment ProjectEnvironmentWithPosition
-	response, err := client.Config.HttpClient.
-		R().
-		SetResult(&environment).
-		SetHeader("User-Agent", USER_AGENT).
-		Get(fmt.Sprintf("api/v1/projects/%s/environments/slug/%s", request.ProjectID, request.EnvironmentSlug))
+	response, err := client.cachedGet(ctx, fmt.Sprintf("api/v1/projects/%s/environments/slug/%s", request.ProjectID, request.EnvironmentSlug), nil, &environment)
 
 	if err != nil {
 		return ProjectEnvironmentWithPosition{}, errors.NewGenericRequestError(operationGetProjectEnvironmentBySlug, err)
@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"terraform-provider-infisical/internal/errors"
@@ -13,10 +14,10 @@ const (
 	operationUpdateProjectEnvironment  = "CallUpdateProjectEnvironment"
 )
 
-func (client Client) CreateProjectEnvironment(request CreateProjectEnvironmentRequest) (CreateProjectEnvironmentResponse, error) {
+func (client Client) CreateProjectEnvironment(ctx context.Context, request CreateProjectEnvironmentRequest) (CreateProjectEnvironmentResponse, error) {
 	var body CreateProjectEnvironmentResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -33,10 +34,10 @@ func (client Client) CreateProjectEnvironment(request CreateProjectEnvironmentRe
 	return body, nil
 }
 
-func (client Client) DeleteProjectEnvironment(request DeleteProjectEnvironmentRequest) (DeleteProjectEnvironmentResponse, error) {
+func (client Client) DeleteProjectEnvironment(ctx context.Context, request DeleteProjectEnvironmentRequest) (DeleteProjectEnvironmentResponse, error) {
 	var body DeleteProjectEnvironmentResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -53,11 +54,11 @@ func (client Client) DeleteProjectEnvironment(request DeleteProjectEnvironmentRe
 	return body, nil
 }
 
-func (client Client) GetProjectEnvironmentByID(request GetProjectEnvironmentByIDRequest) (GetProjectEnvironmentByIDResponse, error) {
+func (client Client) GetProjectEnvironmentByID(ctx context.Context, request GetProjectEnvironmentByIDRequest) (GetProjectEnvironmentByIDResponse, error) {
 	var body GetProjectEnvironmentByIDResponse
 
 	httpRequest := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT)
 
@@ -78,10 +79,10 @@ func (client Client) GetProjectEnvironmentByID(request GetProjectEnvironmentByID
 	return body, nil
 }
 
-func (client Client) UpdateProjectEnvironment(request UpdateProjectEnvironmentRequest) (UpdateProjectEnvironmentResponse, error) {
+func (client Client) UpdateProjectEnvironment(ctx context.Context, request UpdateProjectEnvironmentRequest) (UpdateProjectEnvironmentResponse, error) {
 	var body UpdateProjectEnvironmentResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"terraform-provider-infisical/internal/errors"
@@ -13,10 +14,10 @@ const (
 	operationDeleteProjectGroup        = "CallDeleteProjectGroup"
 )
 
-func (client Client) CreateProjectGroup(request CreateProjectGroupRequest) (CreateProjectGroupResponse, error) {
+func (client Client) CreateProjectGroup(ctx context.Context, request CreateProjectGroupRequest) (CreateProjectGroupResponse, error) {
 	var responseData CreateProjectGroupResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&responseData).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -33,10 +34,10 @@ func (client Client) CreateProjectGroup(request CreateProjectGroupRequest) (Crea
 	return responseData, nil
 }
 
-func (client Client) GetProjectGroupMembership(request GetProjectGroupMembershipRequest) (GetProjectGroupMembershipResponse, error) {
+func (client Client) GetProjectGroupMembership(ctx context.Context, request GetProjectGroupMembershipRequest) (GetProjectGroupMembershipResponse, error) {
 	var responseData GetProjectGroupMembershipResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&responseData).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -57,10 +58,10 @@ func (client Client) GetProjectGroupMembership(request GetProjectGroupMembership
 	return responseData, nil
 }
 
-func (client Client) UpdateProjectGroup(request UpdateProjectGroupRequest) (UpdateProjectGroupResponse, error) {
+func (client Client) UpdateProjectGroup(ctx context.Context, request UpdateProjectGroupRequest) (UpdateProjectGroupResponse, error) {
 	var responseData UpdateProjectGroupResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&responseData).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -77,10 +78,10 @@ func (client Client) UpdateProjectGroup(request UpdateProjectGroupRequest) (Upda
 	return responseData, nil
 }
 
-func (client Client) DeleteProjectGroup(request DeleteProjectGroupRequest) (DeleteProjectGroupResponse, error) {
+func (client Client) DeleteProjectGroup(ctx context.Context, request DeleteProjectGroupRequest) (DeleteProjectGroupResponse, error) {
 	var responseData DeleteProjectGroupResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&responseData).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"terraform-provider-infisical/internal/errors"
@@ -14,10 +15,10 @@ const (
 	operationGetProjectIdentityByMembershipID = "CallGetProjectIdentityByMembershipID"
 )
 
-func (client Client) CreateProjectIdentity(request CreateProjectIdentityRequest) (CreateProjectIdentityResponse, error) {
+func (client Client) CreateProjectIdentity(ctx context.Context, request CreateProjectIdentityRequest) (CreateProjectIdentityResponse, error) {
 	var responeData CreateProjectIdentityResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&responeData).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -34,10 +35,10 @@ func (client Client) CreateProjectIdentity(request CreateProjectIdentityRequest)
 	return responeData, nil
 }
 
-func (client Client) DeleteProjectIdentity(request DeleteProjectIdentityRequest) (DeleteProjectIdentityResponse, error) {
+func (client Client) DeleteProjectIdentity(ctx context.Context, request DeleteProjectIdentityRequest) (DeleteProjectIdentityResponse, error) {
 	var responseData DeleteProjectIdentityResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&responseData).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -54,10 +55,10 @@ func (client Client) DeleteProjectIdentity(request DeleteProjectIdentityRequest)
 	return responseData, nil
 }
 
-func (client Client) UpdateProjectIdentity(request UpdateProjectIdentityRequest) (UpdateProjectIdentityResponse, error) {
+func (client Client) UpdateProjectIdentity(ctx context.Context, request UpdateProjectIdentityRequest) (UpdateProjectIdentityResponse, error) {
 	var responseData UpdateProjectIdentityResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&responseData).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -74,10 +75,10 @@ func (client Client) UpdateProjectIdentity(request UpdateProjectIdentityRequest)
 	return responseData, nil
 }
 
-func (client Client) GetProjectIdentityByID(request GetProjectIdentityByIDRequest) (GetProjectIdentityByIDResponse, error) {
+func (client Client) GetProjectIdentityByID(ctx context.Context, request GetProjectIdentityByIDRequest) (GetProjectIdentityByIDResponse, error) {
 	var responseData GetProjectIdentityByIDResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&responseData).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -98,10 +99,10 @@ func (client Client) GetProjectIdentityByID(request GetProjectIdentityByIDReques
 	return responseData, nil
 }
 
-func (client Client) GetProjectIdentityByMembershipID(request GetProjectIdentityByMembershipIDRequest) (GetProjectIdentityByIDResponse, error) {
+func (client Client) GetProjectIdentityByMembershipID(ctx context.Context, request GetProjectIdentityByMembershipIDRequest) (GetProjectIdentityByIDResponse, error) {
 	var responseData GetProjectIdentityByIDResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&responseData).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
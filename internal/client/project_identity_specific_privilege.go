@@ -1,7 +1,9 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"terraform-provider-infisical/internal/errors"
 )
 
@@ -16,10 +18,10 @@ const (
 	operationGetProjectIdentitySpecificPrivilegeV2           = "CallGetProjectIdentitySpecificPrivilegeV2"
 )
 
-func (client Client) CreatePermanentProjectIdentitySpecificPrivilege(request CreatePermanentProjectIdentitySpecificPrivilegeRequest) (CreateProjectIdentitySpecificPrivilegeResponse, error) {
+func (client Client) CreatePermanentProjectIdentitySpecificPrivilege(ctx context.Context, request CreatePermanentProjectIdentitySpecificPrivilegeRequest) (CreateProjectIdentitySpecificPrivilegeResponse, error) {
 	var responeData CreateProjectIdentitySpecificPrivilegeResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&responeData).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -36,10 +38,10 @@ func (client Client) CreatePermanentProjectIdentitySpecificPrivilege(request Cre
 	return responeData, nil
 }
 
-func (client Client) CreateTemporaryProjectIdentitySpecificPrivilege(request CreateTemporaryProjectIdentitySpecificPrivilegeRequest) (CreateProjectIdentitySpecificPrivilegeResponse, error) {
+func (client Client) CreateTemporaryProjectIdentitySpecificPrivilege(ctx context.Context, request CreateTemporaryProjectIdentitySpecificPrivilegeRequest) (CreateProjectIdentitySpecificPrivilegeResponse, error) {
 	var responeData CreateProjectIdentitySpecificPrivilegeResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&responeData).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -56,10 +58,10 @@ func (client Client) CreateTemporaryProjectIdentitySpecificPrivilege(request Cre
 	return responeData, nil
 }
 
-func (client Client) CreateProjectIdentitySpecificPrivilegeV2(request CreateProjectIdentitySpecificPrivilegeV2Request) (CreateProjectIdentitySpecificPrivilegeV2Response, error) {
+func (client Client) CreateProjectIdentitySpecificPrivilegeV2(ctx context.Context, request CreateProjectIdentitySpecificPrivilegeV2Request) (CreateProjectIdentitySpecificPrivilegeV2Response, error) {
 	var responeData CreateProjectIdentitySpecificPrivilegeV2Response
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&responeData).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -76,10 +78,10 @@ func (client Client) CreateProjectIdentitySpecificPrivilegeV2(request CreateProj
 	return responeData, nil
 }
 
-func (client Client) DeleteProjectIdentitySpecificPrivilege(request DeleteProjectIdentitySpecificPrivilegeRequest) (DeleteProjectIdentitySpecificPrivilegeResponse, error) {
+func (client Client) DeleteProjectIdentitySpecificPrivilege(ctx context.Context, request DeleteProjectIdentitySpecificPrivilegeRequest) (DeleteProjectIdentitySpecificPrivilegeResponse, error) {
 	var responseData DeleteProjectIdentitySpecificPrivilegeResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&responseData).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -96,10 +98,10 @@ func (client Client) DeleteProjectIdentitySpecificPrivilege(request DeleteProjec
 	return responseData, nil
 }
 
-func (client Client) UpdateProjectIdentitySpecificPrivilege(request UpdateProjectIdentitySpecificPrivilegeRequest) (UpdateProjectIdentitySpecificPrivilegeResponse, error) {
+func (client Client) UpdateProjectIdentitySpecificPrivilege(ctx context.Context, request UpdateProjectIdentitySpecificPrivilegeRequest) (UpdateProjectIdentitySpecificPrivilegeResponse, error) {
 	var responseData UpdateProjectIdentitySpecificPrivilegeResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&responseData).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -116,10 +118,10 @@ func (client Client) UpdateProjectIdentitySpecificPrivilege(request UpdateProjec
 	return responseData, nil
 }
 
-func (client Client) UpdateProjectIdentitySpecificPrivilegeV2(request UpdateProjectIdentitySpecificPrivilegeV2Request) (UpdateProjectIdentitySpecificPrivilegeV2Response, error) {
+func (client Client) UpdateProjectIdentitySpecificPrivilegeV2(ctx context.Context, request UpdateProjectIdentitySpecificPrivilegeV2Request) (UpdateProjectIdentitySpecificPrivilegeV2Response, error) {
 	var responseData UpdateProjectIdentitySpecificPrivilegeV2Response
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&responseData).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -136,10 +138,10 @@ func (client Client) UpdateProjectIdentitySpecificPrivilegeV2(request UpdateProj
 	return responseData, nil
 }
 
-func (client Client) GetProjectIdentitySpecificPrivilegeBySlug(request GetProjectIdentitySpecificPrivilegeRequest) (GetProjectIdentitySpecificPrivilegeResponse, error) {
+func (client Client) GetProjectIdentitySpecificPrivilegeBySlug(ctx context.Context, request GetProjectIdentitySpecificPrivilegeRequest) (GetProjectIdentitySpecificPrivilegeResponse, error) {
 	var responseData GetProjectIdentitySpecificPrivilegeResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&responseData).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -150,16 +152,19 @@ func (client Client) GetProjectIdentitySpecificPrivilegeBySlug(request GetProjec
 	}
 
 	if response.IsError() {
+		if response.StatusCode() == http.StatusNotFound {
+			return GetProjectIdentitySpecificPrivilegeResponse{}, ErrNotFound
+		}
 		return GetProjectIdentitySpecificPrivilegeResponse{}, errors.NewAPIErrorWithResponse(operationGetProjectIdentitySpecificPrivilegeBySlug, response, nil)
 	}
 
 	return responseData, nil
 }
 
-func (client Client) GetProjectIdentitySpecificPrivilegeV2(request GetProjectIdentitySpecificPrivilegeV2Request) (GetProjectIdentitySpecificPrivilegeV2Response, error) {
+func (client Client) GetProjectIdentitySpecificPrivilegeV2(ctx context.Context, request GetProjectIdentitySpecificPrivilegeV2Request) (GetProjectIdentitySpecificPrivilegeV2Response, error) {
 	var responseData GetProjectIdentitySpecificPrivilegeV2Response
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&responseData).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -170,6 +175,9 @@ func (client Client) GetProjectIdentitySpecificPrivilegeV2(request GetProjectIde
 	}
 
 	if response.IsError() {
+		if response.StatusCode() == http.StatusNotFound {
+			return GetProjectIdentitySpecificPrivilegeV2Response{}, ErrNotFound
+		}
 		return GetProjectIdentitySpecificPrivilegeV2Response{}, errors.NewAPIErrorWithResponse(operationGetProjectIdentitySpecificPrivilegeV2, response, nil)
 	}
 
@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"terraform-provider-infisical/internal/errors"
@@ -18,10 +19,10 @@ const (
 	operationGetProjectRoleById     = "CallGetProjectRoleById"
 )
 
-func (client Client) CreateProjectRole(request CreateProjectRoleRequest) (CreateProjectRoleResponse, error) {
+func (client Client) CreateProjectRole(ctx context.Context, request CreateProjectRoleRequest) (CreateProjectRoleResponse, error) {
 	var responeData CreateProjectRoleResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&responeData).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -38,10 +39,10 @@ func (client Client) CreateProjectRole(request CreateProjectRoleRequest) (Create
 	return responeData, nil
 }
 
-func (client Client) CreateProjectRoleV2(request CreateProjectRoleV2Request) (CreateProjectRoleV2Response, error) {
+func (client Client) CreateProjectRoleV2(ctx context.Context, request CreateProjectRoleV2Request) (CreateProjectRoleV2Response, error) {
 	var responseData CreateProjectRoleV2Response
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&responseData).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -58,10 +59,10 @@ func (client Client) CreateProjectRoleV2(request CreateProjectRoleV2Request) (Cr
 	return responseData, nil
 }
 
-func (client Client) DeleteProjectRole(request DeleteProjectRoleRequest) (DeleteProjectRoleResponse, error) {
+func (client Client) DeleteProjectRole(ctx context.Context, request DeleteProjectRoleRequest) (DeleteProjectRoleResponse, error) {
 	var responseData DeleteProjectRoleResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&responseData).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -78,10 +79,10 @@ func (client Client) DeleteProjectRole(request DeleteProjectRoleRequest) (Delete
 	return responseData, nil
 }
 
-func (client Client) UpdateProjectRole(request UpdateProjectRoleRequest) (UpdateProjectRoleResponse, error) {
+func (client Client) UpdateProjectRole(ctx context.Context, request UpdateProjectRoleRequest) (UpdateProjectRoleResponse, error) {
 	var responseData UpdateProjectRoleResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&responseData).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -98,10 +99,10 @@ func (client Client) UpdateProjectRole(request UpdateProjectRoleRequest) (Update
 	return responseData, nil
 }
 
-func (client Client) DeleteProjectRoleV2(request DeleteProjectRoleV2Request) (DeleteProjectRoleV2Response, error) {
+func (client Client) DeleteProjectRoleV2(ctx context.Context, request DeleteProjectRoleV2Request) (DeleteProjectRoleV2Response, error) {
 	var responseData DeleteProjectRoleV2Response
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&responseData).
 		SetHeader("User-Agent", USER_AGENT).
 		Delete(fmt.Sprintf("api/v2/workspace/%s/roles/%s", request.ProjectId, request.RoleId))
@@ -117,10 +118,10 @@ func (client Client) DeleteProjectRoleV2(request DeleteProjectRoleV2Request) (De
 	return responseData, nil
 }
 
-func (client Client) UpdateProjectRoleV2(request UpdateProjectRoleV2Request) (UpdateProjectRoleV2Response, error) {
+func (client Client) UpdateProjectRoleV2(ctx context.Context, request UpdateProjectRoleV2Request) (UpdateProjectRoleV2Response, error) {
 	var responseData UpdateProjectRoleV2Response
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&responseData).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -137,30 +138,28 @@ func (client Client) UpdateProjectRoleV2(request UpdateProjectRoleV2Request) (Up
 	return responseData, nil
 }
 
-func (client Client) GetProjectRoleBySlug(request GetProjectRoleBySlugRequest) (GetProjectRoleBySlugResponse, error) {
+func (client Client) GetProjectRoleBySlug(ctx context.Context, request GetProjectRoleBySlugRequest) (GetProjectRoleBySlugResponse, error) {
 	var responseData GetProjectRoleBySlugResponse
-	response, err := client.Config.HttpClient.
-		R().
-		SetResult(&responseData).
-		SetHeader("User-Agent", USER_AGENT).
-		SetBody(request).
-		Get(fmt.Sprintf("api/v1/workspace/%s/roles/slug/%s", request.ProjectSlug, request.RoleSlug))
+	response, err := client.cachedGet(ctx, fmt.Sprintf("api/v1/workspace/%s/roles/slug/%s", request.ProjectSlug, request.RoleSlug), nil, &responseData)
 
 	if err != nil {
 		return GetProjectRoleBySlugResponse{}, errors.NewGenericRequestError(operationGetProjectRoleBySlug, err)
 	}
 
 	if response.IsError() {
+		if response.StatusCode() == http.StatusNotFound {
+			return GetProjectRoleBySlugResponse{}, ErrNotFound
+		}
 		return GetProjectRoleBySlugResponse{}, errors.NewAPIErrorWithResponse(operationGetProjectRoleBySlug, response, nil)
 	}
 
 	return responseData, nil
 }
 
-func (client Client) GetProjectRoleBySlugV2(request GetProjectRoleBySlugV2Request) (GetProjectRoleBySlugV2Response, error) {
+func (client Client) GetProjectRoleBySlugV2(ctx context.Context, request GetProjectRoleBySlugV2Request) (GetProjectRoleBySlugV2Response, error) {
 	var responseData GetProjectRoleBySlugV2Response
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&responseData).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -180,10 +179,10 @@ func (client Client) GetProjectRoleBySlugV2(request GetProjectRoleBySlugV2Reques
 	return responseData, nil
 }
 
-func (client Client) GetProjectRoleById(request GetProjectRoleByIdRequest) (GetProjectRoleBySlugV2Response, error) {
+func (client Client) GetProjectRoleById(ctx context.Context, request GetProjectRoleByIdRequest) (GetProjectRoleBySlugV2Response, error) {
 	var responseData GetProjectRoleBySlugV2Response
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&responseData).
 		SetHeader("User-Agent", USER_AGENT).
 		Get(fmt.Sprintf("api/v1/projects/roles/%s", request.RoleId))
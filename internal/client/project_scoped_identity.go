@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -17,10 +18,10 @@ const (
 )
 
 // CreateProjectScopedIdentity creates a project-scoped machine identity.
-func (client Client) CreateProjectScopedIdentity(request CreateProjectScopedIdentityRequest) (ProjectScopedIdentity, error) {
+func (client Client) CreateProjectScopedIdentity(ctx context.Context, request CreateProjectScopedIdentityRequest) (ProjectScopedIdentity, error) {
 	var response CreateProjectScopedIdentityResponse
 	httpResponse, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&response).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -38,10 +39,10 @@ func (client Client) CreateProjectScopedIdentity(request CreateProjectScopedIden
 }
 
 // GetProjectScopedIdentityByID fetches a project-scoped machine identity by its ID.
-func (client Client) GetProjectScopedIdentityByID(request GetProjectScopedIdentityRequest) (ProjectScopedIdentity, error) {
+func (client Client) GetProjectScopedIdentityByID(ctx context.Context, request GetProjectScopedIdentityRequest) (ProjectScopedIdentity, error) {
 	var response GetProjectScopedIdentityResponse
 	httpResponse, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&response).
 		SetHeader("User-Agent", USER_AGENT).
 		Get(fmt.Sprintf("api/v1/projects/%s/identities/%s", url.PathEscape(request.ProjectID), url.PathEscape(request.IdentityID)))
@@ -62,10 +63,10 @@ func (client Client) GetProjectScopedIdentityByID(request GetProjectScopedIdenti
 }
 
 // UpdateProjectScopedIdentity updates a project-scoped machine identity.
-func (client Client) UpdateProjectScopedIdentity(request UpdateProjectScopedIdentityRequest) (ProjectScopedIdentity, error) {
+func (client Client) UpdateProjectScopedIdentity(ctx context.Context, request UpdateProjectScopedIdentityRequest) (ProjectScopedIdentity, error) {
 	var response UpdateProjectScopedIdentityResponse
 	httpResponse, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&response).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -83,10 +84,10 @@ func (client Client) UpdateProjectScopedIdentity(request UpdateProjectScopedIden
 }
 
 // DeleteProjectScopedIdentity deletes a project-scoped machine identity.
-func (client Client) DeleteProjectScopedIdentity(request DeleteProjectScopedIdentityRequest) (ProjectScopedIdentity, error) {
+func (client Client) DeleteProjectScopedIdentity(ctx context.Context, request DeleteProjectScopedIdentityRequest) (ProjectScopedIdentity, error) {
 	var response DeleteProjectScopedIdentityResponse
 	httpResponse, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&response).
 		SetHeader("User-Agent", USER_AGENT).
 		Delete(fmt.Sprintf("api/v1/projects/%s/identities/%s", url.PathEscape(request.ProjectID), url.PathEscape(request.IdentityID)))
@@ -107,9 +108,9 @@ func (client Client) DeleteProjectScopedIdentity(request DeleteProjectScopedIden
 
 // UpdateProjectScopedIdentityRoles sets the project roles on the membership of a project-scoped
 // identity. A project-scoped identity always has an auto-created membership, so this updates it.
-func (client Client) UpdateProjectScopedIdentityRoles(request UpdateProjectScopedIdentityRolesRequest) error {
+func (client Client) UpdateProjectScopedIdentityRoles(ctx context.Context, request UpdateProjectScopedIdentityRolesRequest) error {
 	httpResponse, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
 		Patch(fmt.Sprintf("api/v1/projects/%s/memberships/identities/%s", url.PathEscape(request.ProjectID), url.PathEscape(request.IdentityID)))
@@ -127,10 +128,10 @@ func (client Client) UpdateProjectScopedIdentityRoles(request UpdateProjectScope
 
 // GetProjectScopedIdentityMembership fetches the project membership (including roles) of a
 // project-scoped identity.
-func (client Client) GetProjectScopedIdentityMembership(request GetProjectIdentityByIDRequest) (GetProjectIdentityByIDResponse, error) {
+func (client Client) GetProjectScopedIdentityMembership(ctx context.Context, request GetProjectIdentityByIDRequest) (GetProjectIdentityByIDResponse, error) {
 	var response GetProjectIdentityByIDResponse
 	httpResponse, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&response).
 		SetHeader("User-Agent", USER_AGENT).
 		Get(fmt.Sprintf("api/v1/projects/%s/memberships/identities/%s", url.PathEscape(request.ProjectID), url.PathEscape(request.IdentityID)))
@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"net/http"
 	"terraform-provider-infisical/internal/errors"
 )
@@ -14,11 +15,11 @@ const (
 	operationDeleteProjectTag    = "CallDeleteProjectTag"
 )
 
-func (client Client) GetProjectTags(request GetProjectTagsRequest) (GetProjectTagsResponse, error) {
+func (client Client) GetProjectTags(ctx context.Context, request GetProjectTagsRequest) (GetProjectTagsResponse, error) {
 	var body GetProjectTagsResponse
 
 	httpRequest := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT)
 
@@ -35,10 +36,10 @@ func (client Client) GetProjectTags(request GetProjectTagsRequest) (GetProjectTa
 	return body, nil
 }
 
-func (client Client) CreateProjectTag(request CreateProjectTagRequest) (CreateProjectTagResponse, error) {
+func (client Client) CreateProjectTag(ctx context.Context, request CreateProjectTagRequest) (CreateProjectTagResponse, error) {
 	var body CreateProjectTagResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -55,10 +56,10 @@ func (client Client) CreateProjectTag(request CreateProjectTagRequest) (CreatePr
 	return body, nil
 }
 
-func (client Client) UpdateProjectTag(request UpdateProjectTagRequest) (UpdateProjectTagResponse, error) {
+func (client Client) UpdateProjectTag(ctx context.Context, request UpdateProjectTagRequest) (UpdateProjectTagResponse, error) {
 	var body UpdateProjectTagResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -75,10 +76,10 @@ func (client Client) UpdateProjectTag(request UpdateProjectTagRequest) (UpdatePr
 	return body, nil
 }
 
-func (client Client) GetProjectTagByID(request GetProjectTagByIDRequest) (GetProjectTagByIDResponse, error) {
+func (client Client) GetProjectTagByID(ctx context.Context, request GetProjectTagByIDRequest) (GetProjectTagByIDResponse, error) {
 	var body GetProjectTagByIDResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -99,10 +100,10 @@ func (client Client) GetProjectTagByID(request GetProjectTagByIDRequest) (GetPro
 	return body, nil
 }
 
-func (client Client) GetProjectTagBySlug(request GetProjectTagBySlugRequest) (GetProjectTagBySlugResponse, error) {
+func (client Client) GetProjectTagBySlug(ctx context.Context, request GetProjectTagBySlugRequest) (GetProjectTagBySlugResponse, error) {
 	var body GetProjectTagBySlugResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -123,10 +124,10 @@ func (client Client) GetProjectTagBySlug(request GetProjectTagBySlugRequest) (Ge
 	return body, nil
 }
 
-func (client Client) DeleteProjectTag(request DeleteProjectTagRequest) (DeleteProjectTagResponse, error) {
+func (client Client) DeleteProjectTag(ctx context.Context, request DeleteProjectTagRequest) (DeleteProjectTagResponse, error) {
 	var body DeleteProjectTagResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
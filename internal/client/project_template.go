@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"terraform-provider-infisical/internal/errors"
@@ -13,10 +14,10 @@ const (
 	operationDeleteProjectTemplate  = "CallDeleteProjectTemplate"
 )
 
-func (client Client) CreateProjectTemplate(request CreateProjectTemplateRequest) (ProjectTemplate, error) {
+func (client Client) CreateProjectTemplate(ctx context.Context, request CreateProjectTemplateRequest) (ProjectTemplate, error) {
 	var response CreateProjectTemplateResponse
 	httpResponse, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&response).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -33,10 +34,10 @@ func (client Client) CreateProjectTemplate(request CreateProjectTemplateRequest)
 	return response.ProjectTemplate, nil
 }
 
-func (client Client) GetProjectTemplateById(id string) (ProjectTemplate, error) {
+func (client Client) GetProjectTemplateById(ctx context.Context, id string) (ProjectTemplate, error) {
 	var response GetProjectTemplateByIdResponse
 	httpResponse, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&response).
 		SetHeader("User-Agent", USER_AGENT).
 		Get(fmt.Sprintf("api/v1/project-templates/%s", id))
@@ -56,10 +57,10 @@ func (client Client) GetProjectTemplateById(id string) (ProjectTemplate, error)
 	return response.ProjectTemplate, nil
 }
 
-func (client Client) UpdateProjectTemplate(request UpdateProjectTemplateRequest) (ProjectTemplate, error) {
+func (client Client) UpdateProjectTemplate(ctx context.Context, request UpdateProjectTemplateRequest) (ProjectTemplate, error) {
 	var response UpdateProjectTemplateResponse
 	httpResponse, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&response).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -76,10 +77,10 @@ func (client Client) UpdateProjectTemplate(request UpdateProjectTemplateRequest)
 	return response.ProjectTemplate, nil
 }
 
-func (client Client) DeleteProjectTemplate(id string) (ProjectTemplate, error) {
+func (client Client) DeleteProjectTemplate(ctx context.Context, id string) (ProjectTemplate, error) {
 	var response DeleteProjectTemplateResponse
 	httpResponse, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&response).
 		SetHeader("User-Agent", USER_AGENT).
 		Delete(fmt.Sprintf("api/v1/project-templates/%s", id))
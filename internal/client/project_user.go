@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"terraform-provider-infisical/internal/errors"
@@ -14,10 +15,10 @@ const (
 	operationGetProjectMembershipByUserID = "CallGetProjectMembershipByUserID"
 )
 
-func (client Client) InviteUsersToProject(request InviteUsersToProjectRequest) ([]ProjectMemberships, error) {
+func (client Client) InviteUsersToProject(ctx context.Context, request InviteUsersToProjectRequest) ([]ProjectMemberships, error) {
 	var inviteUsersToProjectResponse InviteUsersToProjectResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&inviteUsersToProjectResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -34,10 +35,10 @@ func (client Client) InviteUsersToProject(request InviteUsersToProjectRequest) (
 	return inviteUsersToProjectResponse.Members, nil
 }
 
-func (client Client) DeleteProjectUser(request DeleteProjectUserRequest) (DeleteProjectUserResponse, error) {
+func (client Client) DeleteProjectUser(ctx context.Context, request DeleteProjectUserRequest) (DeleteProjectUserResponse, error) {
 	var projectUserResponse DeleteProjectUserResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&projectUserResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -54,10 +55,10 @@ func (client Client) DeleteProjectUser(request DeleteProjectUserRequest) (Delete
 	return projectUserResponse, nil
 }
 
-func (client Client) UpdateProjectUser(request UpdateProjectUserRequest) (UpdateProjectUserResponse, error) {
+func (client Client) UpdateProjectUser(ctx context.Context, request UpdateProjectUserRequest) (UpdateProjectUserResponse, error) {
 	var projectUserResponse UpdateProjectUserResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&projectUserResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -74,10 +75,10 @@ func (client Client) UpdateProjectUser(request UpdateProjectUserRequest) (Update
 	return projectUserResponse, nil
 }
 
-func (client Client) GetProjectUserByUsername(request GetProjectUserByUserNameRequest) (GetProjectUserByUserNameResponse, error) {
+func (client Client) GetProjectUserByUsername(ctx context.Context, request GetProjectUserByUserNameRequest) (GetProjectUserByUserNameResponse, error) {
 	var projectUserResponse GetProjectUserByUserNameResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&projectUserResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -99,11 +100,11 @@ func (client Client) GetProjectUserByUsername(request GetProjectUserByUserNameRe
 	return projectUserResponse, nil
 }
 
-func (client Client) GetProjectMembershipByUserID(request GetProjectMembershipByUserIDRequest) (GetProjectUserByUserNameResponse, error) {
+func (client Client) GetProjectMembershipByUserID(ctx context.Context, request GetProjectMembershipByUserIDRequest) (GetProjectUserByUserNameResponse, error) {
 	var projectMembershipResponse GetProjectUserByUserNameResponse
 
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&projectMembershipResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
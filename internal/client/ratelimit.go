@@ -0,0 +1,119 @@
+package infisicalclient
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/go-resty/resty/v2"
+	"golang.org/x/time/rate"
+)
+
+// rateLimitLowBudgetRatio is the share of the API's rate limit window below which the
+// client slows down ahead of being rejected with 429s.
+const rateLimitLowBudgetRatio = 0.1
+
+// RateLimitPolicy throttles the requests of every operation sharing the client, so parallel
+// resource operations stay within the API's rate limits instead of each backing off on 429s.
+type RateLimitPolicy struct {
+	// RequestsPerSecond is the sustained rate of the token bucket; zero disables it.
+	RequestsPerSecond float64
+	// Burst is the bucket size. When zero, it is the per-second rate rounded up.
+	Burst int
+	// MaxConcurrentRequests caps the requests in flight, retries included; zero disables it.
+	MaxConcurrentRequests int
+}
+
+type clientRateLimiter struct {
+	limiter    *rate.Limiter
+	configured rate.Limit
+
+	slots chan struct{}
+	held  sync.Map // *resty.Request holding a slot
+}
+
+// configureRateLimit installs the policy's token bucket and concurrency limit on the resty
+// client. A request waits for both before each attempt, or fails once its context is done.
+func configureRateLimit(c *resty.Client, policy RateLimitPolicy) {
+	if policy.RequestsPerSecond <= 0 && policy.MaxConcurrentRequests <= 0 {
+		return
+	}
+
+	l := &clientRateLimiter{}
+	if policy.RequestsPerSecond > 0 {
+		burst := policy.Burst
+		if burst < 1 {
+			burst = max(1, int(math.Ceil(policy.RequestsPerSecond)))
+		}
+		l.configured = rate.Limit(policy.RequestsPerSecond)
+		l.limiter = rate.NewLimiter(l.configured, burst)
+	}
+	if policy.MaxConcurrentRequests > 0 {
+		l.slots = make(chan struct{}, policy.MaxConcurrentRequests)
+	}
+
+	c.OnBeforeRequest(l.beforeRequest)
+	c.OnAfterResponse(l.afterResponse)
+	c.OnSuccess(func(_ *resty.Client, r *resty.Response) { l.release(r.Request) })
+	c.OnError(func(r *resty.Request, _ error) { l.release(r) })
+	c.OnPanic(func(r *resty.Request, _ error) { l.release(r) })
+}
+
+func (l *clientRateLimiter) beforeRequest(_ *resty.Client, r *resty.Request) error {
+	ctx := r.Context()
+
+	// A slot is held from the first attempt until the request completes, so retries do not
+	// queue up behind requests that have not been sent yet.
+	if l.slots != nil {
+		if _, ok := l.held.Load(r); !ok {
+			select {
+			case l.slots <- struct{}{}:
+				l.held.Store(r, struct{}{})
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+
+	if l.limiter != nil {
+		return l.limiter.Wait(ctx)
+	}
+	return nil
+}
+
+func (l *clientRateLimiter) afterResponse(_ *resty.Client, r *resty.Response) error {
+	if l.limiter != nil {
+		l.limiter.SetLimit(adaptedRateLimit(l.configured, r.Header()))
+	}
+	return nil
+}
+
+func (l *clientRateLimiter) release(r *resty.Request) {
+	if l.slots == nil || r == nil {
+		return
+	}
+	if _, ok := l.held.LoadAndDelete(r); ok {
+		<-l.slots
+	}
+}
+
+// adaptedRateLimit returns the configured rate, or a slower one when the X-RateLimit-* headers
+// report that less than a tenth of the current window's budget remains. The slower rate spreads
+// the remaining requests over the time left until the window resets.
+func adaptedRateLimit(configured rate.Limit, header http.Header) rate.Limit {
+	limit, err := strconv.Atoi(header.Get("X-RateLimit-Limit"))
+	if err != nil || limit <= 0 {
+		return configured
+	}
+	remaining, err := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if err != nil || float64(remaining) >= float64(limit)*rateLimitLowBudgetRatio {
+		return configured
+	}
+	reset, ok := parseRateLimitReset(header.Get("X-RateLimit-Reset"))
+	if !ok || reset <= 0 {
+		return configured
+	}
+
+	return min(rate.Limit(float64(max(remaining, 1))/reset.Seconds()), configured)
+}
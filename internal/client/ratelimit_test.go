@@ -0,0 +1,138 @@
+package infisicalclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"golang.org/x/time/rate"
+)
+
+func TestAdaptedRateLimit(t *testing.T) {
+	const configured = rate.Limit(10)
+
+	header := func(limit, remaining, reset string) http.Header {
+		h := http.Header{}
+		h.Set("X-RateLimit-Limit", limit)
+		h.Set("X-RateLimit-Remaining", remaining)
+		h.Set("X-RateLimit-Reset", reset)
+		return h
+	}
+
+	cases := []struct {
+		name   string
+		header http.Header
+		want   rate.Limit
+	}{
+		{"no headers", http.Header{}, configured},
+		{"healthy budget", header("600", "300", "30"), configured},
+		{"low budget", header("600", "20", "10"), 2},
+		{"exhausted budget", header("600", "0", "20"), 0.05},
+		{"low budget above the configured rate", header("600", "50", "1"), configured},
+		{"unparsable reset", header("600", "20", "soon"), configured},
+	}
+	for _, c := range cases {
+		if got := adaptedRateLimit(configured, c.header); got != c.want {
+			t.Errorf("%s: adaptedRateLimit = %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestConfigureRateLimit_SpacesRequests(t *testing.T) {
+	server := httptest.NewServer(jsonResponse(http.StatusOK, `{}`))
+	defer server.Close()
+
+	rc := resty.New().SetBaseURL(server.URL)
+	configureRateLimit(rc, RateLimitPolicy{RequestsPerSecond: 20, Burst: 1})
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if _, err := rc.R().Get("/"); err != nil {
+			t.Fatalf("request error: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 190*time.Millisecond {
+		t.Fatalf("elapsed = %v, want at least 200ms for 5 requests at 20/s", elapsed)
+	}
+}
+
+func TestConfigureRateLimit_CapsConcurrentRequests(t *testing.T) {
+	var inFlight, peak int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	rc := resty.New().SetBaseURL(server.URL)
+	configureRateLimit(rc, RateLimitPolicy{MaxConcurrentRequests: 2})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := rc.R().Get("/"); err != nil {
+				t.Errorf("request error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&peak); got != 2 {
+		t.Fatalf("peak concurrent requests = %d, want 2", got)
+	}
+}
+
+func TestConfigureRateLimit_ReleasesSlotsOfFailedRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := server.URL
+	server.Close()
+
+	rc := resty.New().SetBaseURL(url)
+	configureRetries(rc, RetryPolicy{MaxRetries: 1, MinWait: time.Millisecond, MaxWait: time.Millisecond})
+	configureRateLimit(rc, RateLimitPolicy{MaxConcurrentRequests: 1})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	for i := 0; i < 3; i++ {
+		if _, err := rc.R().SetContext(ctx).Get("/"); err == nil {
+			t.Fatal("expected an error from a closed server")
+		}
+	}
+	if ctx.Err() != nil {
+		t.Fatal("requests blocked on a slot that was never released")
+	}
+}
+
+func TestConfigureRateLimit_StopsWaitingWhenTheContextIsDone(t *testing.T) {
+	server := httptest.NewServer(jsonResponse(http.StatusOK, `{}`))
+	defer server.Close()
+
+	rc := resty.New().SetBaseURL(server.URL)
+	configureRateLimit(rc, RateLimitPolicy{RequestsPerSecond: 0.1, Burst: 1})
+
+	if _, err := rc.R().Get("/"); err != nil {
+		t.Fatalf("request error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := rc.R().SetContext(ctx).Get("/"); err == nil {
+		t.Fatal("expected the throttled request to fail once its context is done")
+	}
+}
@@ -1,40 +1,106 @@
 package infisicalclient
 
 import (
+	"context"
+	"errors"
+	"io"
+	"net"
 	"net/http"
 	"strconv"
 	"strings"
+	"syscall"
+	infisicalerrors "terraform-provider-infisical/internal/errors"
 	"time"
 
 	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 const (
 	defaultRetryCount       = 5
 	defaultRetryWaitTime    = 1 * time.Second
 	defaultRetryMaxWaitTime = 60 * time.Second
+
+	// IdempotencyKeyHeader marks a POST as safe to replay: a POST carrying it is retried on
+	// transient failures like the idempotent methods are.
+	IdempotencyKeyHeader = "Idempotency-Key"
 )
 
+// RetryPolicy bounds how often and how patiently a failed request is retried.
+type RetryPolicy struct {
+	MaxRetries int
+	MinWait    time.Duration
+	MaxWait    time.Duration
+}
+
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: defaultRetryCount,
+	MinWait:    defaultRetryWaitTime,
+	MaxWait:    defaultRetryMaxWaitTime,
+}
+
+type retrySafeContextKey struct{}
+
+// withSafeRetry marks the requests made with ctx as safe to replay regardless of their method,
+// for POST endpoints that have no side effects beyond the response, such as the login routes.
+func withSafeRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retrySafeContextKey{}, true)
+}
+
 // configureRetries installs retry behavior on the resty client so transient
-// errors (notably 429 Too Many Requests) do not fail the Terraform run.
+// errors do not fail the Terraform run:
+//   - 429 Too Many Requests is retried for every method, as the request was not processed.
+//   - 502, 503 and 504, connection resets and timeouts are retried for idempotent methods, and
+//     for POSTs that are marked with withSafeRetry or carry an Idempotency-Key header.
+//   - Failures to connect are retried for every method, as the request never left the client.
+//
 // The Retry-After header is honored when present; otherwise jittered
-// exponential backoff is used, capped at defaultRetryMaxWaitTime.
-func configureRetries(c *resty.Client) {
-	c.SetRetryCount(defaultRetryCount)
-	c.SetRetryWaitTime(defaultRetryWaitTime)
-	c.SetRetryMaxWaitTime(defaultRetryMaxWaitTime)
+// exponential backoff is used, capped at the policy's MaxWait.
+func configureRetries(c *resty.Client, policy RetryPolicy) {
+	c.SetRetryCount(policy.MaxRetries)
+	c.SetRetryWaitTime(policy.MinWait)
+	c.SetRetryMaxWaitTime(policy.MaxWait)
 
 	c.AddRetryCondition(func(r *resty.Response, err error) bool {
-		if r == nil {
+		if r == nil || r.Request == nil {
 			return false
 		}
+		if err != nil {
+			if isConnectError(err) {
+				return true
+			}
+			return isTransientNetworkError(err) && isReplayable(r.Request)
+		}
 		switch r.StatusCode() {
 		case http.StatusTooManyRequests:
 			return true
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return isReplayable(r.Request)
 		}
 		return false
 	})
 
+	c.AddRetryHook(func(r *resty.Response, err error) {
+		// The hook also runs for the final failed attempt, which is not followed by a retry.
+		if r == nil || r.Request == nil || r.Request.Attempt > policy.MaxRetries {
+			return
+		}
+		fields := map[string]interface{}{
+			"method":  r.Request.Method,
+			"url":     r.Request.URL,
+			"attempt": r.Request.Attempt,
+		}
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status_code"] = r.StatusCode()
+			if reqId := infisicalerrors.TryExtractReqId(r); reqId != "" {
+				fields["request_id"] = reqId
+			}
+		}
+		tflog.SubsystemWarn(withLogSubsystem(r.Request.Context()), LogSubsystem, "Retrying Infisical API request after a transient failure", fields)
+	})
+
 	c.SetRetryAfter(func(_ *resty.Client, r *resty.Response) (time.Duration, error) {
 		if r == nil {
 			return 0, nil
@@ -49,6 +115,45 @@ func configureRetries(c *resty.Client) {
 	})
 }
 
+// isReplayable reports whether sending the request a second time cannot apply a change twice.
+func isReplayable(req *resty.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	case http.MethodPost:
+		if safe, ok := req.Context().Value(retrySafeContextKey{}).(bool); ok && safe {
+			return true
+		}
+		return req.Header.Get(IdempotencyKeyHeader) != ""
+	}
+	return false
+}
+
+// isConnectError reports whether the request failed while dialing, before anything was sent.
+func isConnectError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial" && !isContextError(err)
+}
+
+// isTransientNetworkError reports whether the request failed on the wire in a way a later
+// attempt may not: the connection was reset or closed early, or the exchange timed out.
+// Cancellation and deadlines of the caller's context are not transient.
+func isTransientNetworkError(err error) bool {
+	if isContextError(err) {
+		return false
+	}
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
 // parseRetryAfter parses an HTTP Retry-After header. It supports both the
 // delta-seconds and HTTP-date forms defined by RFC 7231.
 func parseRetryAfter(v string) (time.Duration, bool) {
@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -86,7 +87,7 @@ func TestConfigureRetries_Retries429AndHonorsRetryAfter(t *testing.T) {
 
 	rc := resty.New()
 	rc.SetBaseURL(server.URL)
-	configureRetries(rc)
+	configureRetries(rc, DefaultRetryPolicy)
 
 	start := time.Now()
 	resp, err := rc.R().Get("/")
@@ -114,7 +115,7 @@ func TestConfigureRetries_DoesNotRetryOn4xxOtherThan429(t *testing.T) {
 
 	rc := resty.New()
 	rc.SetBaseURL(server.URL)
-	configureRetries(rc)
+	configureRetries(rc, DefaultRetryPolicy)
 
 	_, err := rc.R().Get("/")
 	if err != nil {
@@ -124,3 +125,145 @@ func TestConfigureRetries_DoesNotRetryOn4xxOtherThan429(t *testing.T) {
 		t.Fatalf("handler calls = %d, want 1 (no retries on 400)", got)
 	}
 }
+
+func TestConfigureRetries_RetriesGatewayErrorsOnlyWhenReplayable(t *testing.T) {
+	policy := RetryPolicy{MaxRetries: 3, MinWait: time.Millisecond, MaxWait: 10 * time.Millisecond}
+
+	cases := []struct {
+		name    string
+		request func(*resty.Client) (*resty.Response, error)
+		want    int32
+	}{
+		{"get", func(rc *resty.Client) (*resty.Response, error) { return rc.R().Get("/") }, 3},
+		{"put", func(rc *resty.Client) (*resty.Response, error) { return rc.R().Put("/") }, 3},
+		{"delete", func(rc *resty.Client) (*resty.Response, error) { return rc.R().Delete("/") }, 3},
+		{"post", func(rc *resty.Client) (*resty.Response, error) { return rc.R().Post("/") }, 1},
+		{"patch", func(rc *resty.Client) (*resty.Response, error) { return rc.R().Patch("/") }, 1},
+		{"post with idempotency key", func(rc *resty.Client) (*resty.Response, error) {
+			return rc.R().SetHeader(IdempotencyKeyHeader, "key").Post("/")
+		}, 3},
+		{"post marked safe", func(rc *resty.Client) (*resty.Response, error) {
+			return rc.R().SetContext(withSafeRetry(context.Background())).Post("/")
+		}, 3},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var count int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&count, 1) < 3 {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			rc := resty.New().SetBaseURL(server.URL)
+			configureRetries(rc, policy)
+
+			if _, err := c.request(rc); err != nil {
+				t.Fatalf("request error: %v", err)
+			}
+			if got := atomic.LoadInt32(&count); got != c.want {
+				t.Fatalf("handler calls = %d, want %d", got, c.want)
+			}
+		})
+	}
+}
+
+func TestConfigureRetries_RetriesConnectionResets(t *testing.T) {
+	var count int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&count, 1) == 1 {
+			if hijacker, ok := w.(http.Hijacker); ok {
+				if conn, _, err := hijacker.Hijack(); err == nil {
+					_ = conn.Close()
+				}
+			}
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	rc := resty.New().SetBaseURL(server.URL)
+	configureRetries(rc, RetryPolicy{MaxRetries: 3, MinWait: time.Millisecond, MaxWait: 10 * time.Millisecond})
+
+	resp, err := rc.R().Get("/")
+	if err != nil {
+		t.Fatalf("request error: %v", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode())
+	}
+	if got := atomic.LoadInt32(&count); got != 2 {
+		t.Fatalf("handler calls = %d, want 2", got)
+	}
+
+	atomic.StoreInt32(&count, 0)
+	if _, err := rc.R().Post("/"); err == nil {
+		t.Fatal("expected the reset POST to fail without a retry")
+	}
+	if got := atomic.LoadInt32(&count); got != 1 {
+		t.Fatalf("handler calls = %d, want 1 (POST is not replayed)", got)
+	}
+}
+
+func TestConfigureRetries_RetriesFailedConnectsForEveryMethod(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := server.URL
+	server.Close()
+
+	rc := resty.New().SetBaseURL(url)
+	configureRetries(rc, RetryPolicy{MaxRetries: 2, MinWait: time.Millisecond, MaxWait: 10 * time.Millisecond})
+
+	resp, err := rc.R().Post("/")
+	if err == nil {
+		t.Fatal("expected an error from a closed server")
+	}
+	if got := resp.Request.Attempt; got != 3 {
+		t.Fatalf("attempts = %d, want 3", got)
+	}
+}
+
+func TestConfigureRetries_StopsWhenTheContextIsDone(t *testing.T) {
+	var count int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&count, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	rc := resty.New().SetBaseURL(server.URL)
+	configureRetries(rc, RetryPolicy{MaxRetries: 5, MinWait: time.Second, MaxWait: time.Second})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	if _, err := rc.R().SetContext(ctx).Get("/"); err == nil {
+		t.Fatal("expected the request to be cancelled")
+	}
+	if got := atomic.LoadInt32(&count); got != 1 {
+		t.Fatalf("handler calls = %d, want 1", got)
+	}
+}
+
+func TestConfigureRetries_ZeroRetriesDisablesRetrying(t *testing.T) {
+	var count int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&count, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	rc := resty.New().SetBaseURL(server.URL)
+	configureRetries(rc, RetryPolicy{MaxRetries: 0, MinWait: time.Millisecond, MaxWait: time.Millisecond})
+
+	if _, err := rc.R().Get("/"); err != nil {
+		t.Fatalf("request error: %v", err)
+	}
+	if got := atomic.LoadInt32(&count); got != 1 {
+		t.Fatalf("handler calls = %d, want 1", got)
+	}
+}
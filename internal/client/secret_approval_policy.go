@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"terraform-provider-infisical/internal/errors"
@@ -13,10 +14,10 @@ const (
 	operationDeleteSecretApprovalPolicy  = "CallDeleteSecretApprovalPolicy"
 )
 
-func (client Client) CreateSecretApprovalPolicy(request CreateSecretApprovalPolicyRequest) (CreateSecretApprovalPolicyResponse, error) {
+func (client Client) CreateSecretApprovalPolicy(ctx context.Context, request CreateSecretApprovalPolicyRequest) (CreateSecretApprovalPolicyResponse, error) {
 	var body CreateSecretApprovalPolicyResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -33,11 +34,11 @@ func (client Client) CreateSecretApprovalPolicy(request CreateSecretApprovalPoli
 	return body, nil
 }
 
-func (client Client) GetSecretApprovalPolicyByID(request GetSecretApprovalPolicyByIDRequest) (GetSecretApprovalPolicyByIDResponse, error) {
+func (client Client) GetSecretApprovalPolicyByID(ctx context.Context, request GetSecretApprovalPolicyByIDRequest) (GetSecretApprovalPolicyByIDResponse, error) {
 	var body GetSecretApprovalPolicyByIDResponse
 
 	httpRequest := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT)
 
@@ -58,10 +59,10 @@ func (client Client) GetSecretApprovalPolicyByID(request GetSecretApprovalPolicy
 	return body, nil
 }
 
-func (client Client) UpdateSecretApprovalPolicy(request UpdateSecretApprovalPolicyRequest) (UpdateSecretApprovalPolicyResponse, error) {
+func (client Client) UpdateSecretApprovalPolicy(ctx context.Context, request UpdateSecretApprovalPolicyRequest) (UpdateSecretApprovalPolicyResponse, error) {
 	var body UpdateSecretApprovalPolicyResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -78,10 +79,10 @@ func (client Client) UpdateSecretApprovalPolicy(request UpdateSecretApprovalPoli
 	return body, nil
 }
 
-func (client Client) DeleteSecretApprovalPolicy(request DeleteSecretApprovalPolicyRequest) (DeleteSecretApprovalPolicyResponse, error) {
+func (client Client) DeleteSecretApprovalPolicy(ctx context.Context, request DeleteSecretApprovalPolicyRequest) (DeleteSecretApprovalPolicyResponse, error) {
 	var responseData DeleteSecretApprovalPolicyResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&responseData).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
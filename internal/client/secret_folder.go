@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -16,11 +17,11 @@ const (
 	operationGetSecretFolderByPath = "CallGetSecretFolderByPath"
 )
 
-func (client Client) GetSecretFolderByID(request GetSecretFolderByIDRequest) (GetSecretFolderByIDResponse, error) {
+func (client Client) GetSecretFolderByID(ctx context.Context, request GetSecretFolderByIDRequest) (GetSecretFolderByIDResponse, error) {
 	var body GetSecretFolderByIDResponse
 
 	httpRequest := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT)
 
@@ -41,11 +42,11 @@ func (client Client) GetSecretFolderByID(request GetSecretFolderByIDRequest) (Ge
 	return body, nil
 }
 
-func (client Client) GetSecretFolderList(request ListSecretFolderRequest) (ListSecretFolderResponse, error) {
+func (client Client) GetSecretFolderList(ctx context.Context, request ListSecretFolderRequest) (ListSecretFolderResponse, error) {
 	var body ListSecretFolderResponse
 
 	httpRequest := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetQueryParam("workspaceId", request.ProjectID).
@@ -65,10 +66,10 @@ func (client Client) GetSecretFolderList(request ListSecretFolderRequest) (ListS
 	return body, nil
 }
 
-func (client Client) CreateSecretFolder(request CreateSecretFolderRequest) (CreateSecretFolderResponse, error) {
+func (client Client) CreateSecretFolder(ctx context.Context, request CreateSecretFolderRequest) (CreateSecretFolderResponse, error) {
 	var body CreateSecretFolderResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -85,10 +86,10 @@ func (client Client) CreateSecretFolder(request CreateSecretFolderRequest) (Crea
 	return body, nil
 }
 
-func (client Client) UpdateSecretFolder(request UpdateSecretFolderRequest) (UpdateSecretFolderResponse, error) {
+func (client Client) UpdateSecretFolder(ctx context.Context, request UpdateSecretFolderRequest) (UpdateSecretFolderResponse, error) {
 	var body UpdateSecretFolderResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -105,10 +106,10 @@ func (client Client) UpdateSecretFolder(request UpdateSecretFolderRequest) (Upda
 	return body, nil
 }
 
-func (client Client) DeleteSecretFolder(request DeleteSecretFolderRequest) (DeleteSecretFolderResponse, error) {
+func (client Client) DeleteSecretFolder(ctx context.Context, request DeleteSecretFolderRequest) (DeleteSecretFolderResponse, error) {
 	var body DeleteSecretFolderResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -125,14 +126,9 @@ func (client Client) DeleteSecretFolder(request DeleteSecretFolderRequest) (Dele
 	return body, nil
 }
 
-func (client Client) GetFolderByPath(request GetSecretFolderByPathRequest) (GetSecretFolderByPathResponse, error) {
+func (client Client) GetFolderByPath(ctx context.Context, request GetSecretFolderByPathRequest) (GetSecretFolderByPathResponse, error) {
 	var body GetSecretFolderByPathResponse
-
-	httpRequest := client.Config.HttpClient.
-		R().
-		SetResult(&body).
-		SetHeader("User-Agent", USER_AGENT)
-	response, err := httpRequest.Get(fmt.Sprintf("api/v1/folders/%s/%s/%s", request.ProjectID, request.Environment, url.PathEscape(request.SecretPath)))
+	response, err := client.cachedGet(ctx, fmt.Sprintf("api/v1/folders/%s/%s/%s", request.ProjectID, request.Environment, url.PathEscape(request.SecretPath)), nil, &body)
 
 	if err != nil {
 		return GetSecretFolderByPathResponse{}, errors.NewGenericRequestError(operationGetSecretFolderByPath, err)
@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"net/http"
 	"terraform-provider-infisical/internal/errors"
 )
@@ -25,11 +26,11 @@ func findSecretImportByID(secretImports []SecretImport, id string) (GetSecretImp
 	return GetSecretImportResponse{}, NewNotFoundError("SecretImport", id)
 }
 
-func (client Client) GetSecretImport(request GetSecretImportRequest) (GetSecretImportResponse, error) {
+func (client Client) GetSecretImport(ctx context.Context, request GetSecretImportRequest) (GetSecretImportResponse, error) {
 	var body ListSecretImportResponse
 
 	httpRequest := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetQueryParam("workspaceId", request.ProjectID).
@@ -43,6 +44,9 @@ func (client Client) GetSecretImport(request GetSecretImportRequest) (GetSecretI
 	}
 
 	if response.IsError() {
+		if response.StatusCode() == http.StatusNotFound {
+			return GetSecretImportResponse{}, ErrNotFound
+		}
 		return GetSecretImportResponse{}, errors.NewAPIErrorWithResponse(operationGetSecretImport, response, nil)
 	}
 
@@ -50,11 +54,11 @@ func (client Client) GetSecretImport(request GetSecretImportRequest) (GetSecretI
 
 }
 
-func (client Client) GetSecretImportByID(request GetSecretImportByIDRequest) (GetSecretImportByIDResponse, error) {
+func (client Client) GetSecretImportByID(ctx context.Context, request GetSecretImportByIDRequest) (GetSecretImportByIDResponse, error) {
 	var body GetSecretImportByIDResponse
 
 	httpRequest := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT)
 
@@ -74,11 +78,11 @@ func (client Client) GetSecretImportByID(request GetSecretImportByIDRequest) (Ge
 	return body, nil
 }
 
-func (client Client) GetSecretImportList(request ListSecretImportRequest) (ListSecretImportResponse, error) {
+func (client Client) GetSecretImportList(ctx context.Context, request ListSecretImportRequest) (ListSecretImportResponse, error) {
 	var body ListSecretImportResponse
 
 	httpRequest := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetQueryParam("workspaceId", request.ProjectID).
@@ -98,10 +102,10 @@ func (client Client) GetSecretImportList(request ListSecretImportRequest) (ListS
 	return body, nil
 }
 
-func (client Client) CreateSecretImport(request CreateSecretImportRequest) (CreateSecretImportResponse, error) {
+func (client Client) CreateSecretImport(ctx context.Context, request CreateSecretImportRequest) (CreateSecretImportResponse, error) {
 	var body CreateSecretImportResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -118,10 +122,10 @@ func (client Client) CreateSecretImport(request CreateSecretImportRequest) (Crea
 	return body, nil
 }
 
-func (client Client) UpdateSecretImport(request UpdateSecretImportRequest) (UpdateSecretImportResponse, error) {
+func (client Client) UpdateSecretImport(ctx context.Context, request UpdateSecretImportRequest) (UpdateSecretImportResponse, error) {
 	var body UpdateSecretImportResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -142,10 +146,10 @@ func (client Client) UpdateSecretImport(request UpdateSecretImportRequest) (Upda
 	return body, nil
 }
 
-func (client Client) DeleteSecretImport(request DeleteSecretImportRequest) (DeleteSecretImportResponse, error) {
+func (client Client) DeleteSecretImport(ctx context.Context, request DeleteSecretImportRequest) (DeleteSecretImportResponse, error) {
 	var body DeleteSecretImportResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"net/http"
 	"terraform-provider-infisical/internal/errors"
 )
@@ -8,13 +9,18 @@ import (
 type SecretRotationProvider string
 
 const (
-	SecretRotationProviderMySqlCredentials    SecretRotationProvider = "mysql-credentials"
-	SecretRotationProviderMsSqlCredentials    SecretRotationProvider = "mssql-credentials"
-	SecretRotationProviderPostgresCredentials SecretRotationProvider = "postgres-credentials"
-	SecretRotationProviderOracleCredentials   SecretRotationProvider = "oracledb-credentials"
-	SecretRotationProviderAzureClientSecret   SecretRotationProvider = "azure-client-secret"
-	SecretRotationProviderAwsIamUserSecret    SecretRotationProvider = "aws-iam-user-secret"
-	SecretRotationProviderLdapPassword        SecretRotationProvider = "ldap-password"
+	SecretRotationProviderMySqlCredentials                 SecretRotationProvider = "mysql-credentials"
+	SecretRotationProviderMsSqlCredentials                 SecretRotationProvider = "mssql-credentials"
+	SecretRotationProviderPostgresCredentials              SecretRotationProvider = "postgres-credentials"
+	SecretRotationProviderOracleCredentials                SecretRotationProvider = "oracledb-credentials"
+	SecretRotationProviderAzureClientSecret                SecretRotationProvider = "azure-client-secret"
+	SecretRotationProviderAwsIamUserSecret                 SecretRotationProvider = "aws-iam-user-secret"
+	SecretRotationProviderLdapPassword                     SecretRotationProvider = "ldap-password"
+	SecretRotationProviderMongoDbCredentials               SecretRotationProvider = "mongodb-credentials"
+	SecretRotationProviderRedisCredentials                 SecretRotationProvider = "redis-credentials"
+	SecretRotationProviderAuth0ClientSecret                SecretRotationProvider = "auth0-client-secret"
+	SecretRotationProviderOktaClientSecret                 SecretRotationProvider = "okta-client-secret"
+	SecretRotationProviderDatabricksServicePrincipalSecret SecretRotationProvider = "databricks-service-principal-secret"
 )
 
 const (
@@ -22,12 +28,18 @@ const (
 	operationGetSecretRotationById = "CallGetSecretRotationById"
 	operationUpdateSecretRotation  = "CallUpdateSecretRotation"
 	operationDeleteSecretRotation  = "CallDeleteSecretRotation"
+	operationRotateSecretRotation  = "CallRotateSecretRotation"
 )
 
-func (client Client) CreateSecretRotation(request CreateSecretRotationRequest) (SecretRotation, error) {
+const (
+	SecretRotationStatusSuccess = "success"
+	SecretRotationStatusFailed  = "failed"
+)
+
+func (client Client) CreateSecretRotation(ctx context.Context, request CreateSecretRotationRequest) (SecretRotation, error) {
 	var body CreateSecretRotationResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -44,10 +56,10 @@ func (client Client) CreateSecretRotation(request CreateSecretRotationRequest) (
 	return body.SecretRotation, nil
 }
 
-func (client Client) GetSecretRotationById(request GetSecretRotationByIdRequest) (SecretRotation, error) {
+func (client Client) GetSecretRotationById(ctx context.Context, request GetSecretRotationByIdRequest) (SecretRotation, error) {
 	var body GetSecretRotationByIdResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetDebug(true).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
@@ -68,10 +80,10 @@ func (client Client) GetSecretRotationById(request GetSecretRotationByIdRequest)
 	return body.SecretRotation, nil
 }
 
-func (client Client) UpdateSecretRotation(request UpdateSecretRotationRequest) (SecretRotation, error) {
+func (client Client) UpdateSecretRotation(ctx context.Context, request UpdateSecretRotationRequest) (SecretRotation, error) {
 	var body UpdateSecretRotationResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -88,10 +100,10 @@ func (client Client) UpdateSecretRotation(request UpdateSecretRotationRequest) (
 	return body.SecretRotation, nil
 }
 
-func (client Client) DeleteSecretRotation(request DeleteSecretRotationRequest) (SecretRotation, error) {
+func (client Client) DeleteSecretRotation(ctx context.Context, request DeleteSecretRotationRequest) (SecretRotation, error) {
 	var body DeleteSecretRotationResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetBody(request).
 		SetHeader("User-Agent", USER_AGENT).
@@ -107,3 +119,27 @@ func (client Client) DeleteSecretRotation(request DeleteSecretRotationRequest) (
 
 	return body.SecretRotation, nil
 }
+
+// RotateSecretRotation rotates the secrets of a secret rotation now, regardless of its schedule.
+func (client Client) RotateSecretRotation(ctx context.Context, request RotateSecretRotationRequest) (SecretRotation, error) {
+	var body RotateSecretRotationResponse
+	response, err := client.Config.HttpClient.
+		R().SetContext(ctx).
+		SetResult(&body).
+		SetHeader("User-Agent", USER_AGENT).
+		Post("api/v2/secret-rotations/" + string(request.Provider) + "/" + request.ID + "/rotate-secrets")
+
+	if err != nil {
+		return SecretRotation{}, errors.NewGenericRequestError(operationRotateSecretRotation, err)
+	}
+
+	if response.StatusCode() == http.StatusNotFound {
+		return SecretRotation{}, ErrNotFound
+	}
+
+	if response.IsError() {
+		return SecretRotation{}, errors.NewAPIErrorWithResponse(operationRotateSecretRotation, response, nil)
+	}
+
+	return body.SecretRotation, nil
+}
@@ -0,0 +1,65 @@
+package infisicalclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+func TestRotateSecretRotation(t *testing.T) {
+	var method, path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"secretRotation":{
+			"id":"rotation-1",
+			"rotationStatus":"success",
+			"lastRotatedAt":"2026-10-19T08:00:00.000Z",
+			"lastRotationAttemptedAt":"2026-10-19T08:00:00.000Z",
+			"nextRotationAt":null,
+			"lastRotationMessage":null
+		}}`))
+	}))
+	defer server.Close()
+
+	client := Client{Config{HttpClient: resty.New().SetBaseURL(server.URL)}}
+	rotation, err := client.RotateSecretRotation(context.Background(), RotateSecretRotationRequest{
+		Provider: SecretRotationProviderMySqlCredentials,
+		ID:       "rotation-1",
+	})
+	if err != nil {
+		t.Fatalf("rotating: %v", err)
+	}
+
+	if method != http.MethodPost || path != "/api/v2/secret-rotations/mysql-credentials/rotation-1/rotate-secrets" {
+		t.Errorf("sent %s %s, want POST to the rotate-secrets endpoint of the rotation", method, path)
+	}
+	if rotation.RotationStatus != SecretRotationStatusSuccess {
+		t.Errorf("got status %q, want %q", rotation.RotationStatus, SecretRotationStatusSuccess)
+	}
+	if want := time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC); rotation.LastRotatedAt == nil || !rotation.LastRotatedAt.Equal(want) {
+		t.Errorf("got last rotated at %v, want %v", rotation.LastRotatedAt, want)
+	}
+	if rotation.NextRotationAt != nil || rotation.LastRotationMessage != nil {
+		t.Errorf("got next rotation at %v and message %v, want both unset", rotation.NextRotationAt, rotation.LastRotationMessage)
+	}
+}
+
+func TestRotateSecretRotation_ReturnsErrNotFoundOn404(t *testing.T) {
+	server := httptest.NewServer(jsonResponse(http.StatusNotFound, `{"statusCode":404,"message":"Not found","error":"NotFound"}`))
+	defer server.Close()
+
+	client := Client{Config{HttpClient: resty.New().SetBaseURL(server.URL)}}
+	_, err := client.RotateSecretRotation(context.Background(), RotateSecretRotationRequest{
+		Provider: SecretRotationProviderMySqlCredentials,
+		ID:       "rotation-1",
+	})
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("got %v, want ErrNotFound", err)
+	}
+}
@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"terraform-provider-infisical/internal/errors"
@@ -26,6 +27,12 @@ const (
 	SecretSyncAppFlyio                 SecretSyncApp = "flyio"
 	SecretSyncAppGitlab                SecretSyncApp = "gitlab"
 	SecretSyncAppCircleCI              SecretSyncApp = "circleci"
+	SecretSyncAppHashicorpVault        SecretSyncApp = "hashicorp-vault"
+	SecretSyncAppVercel                SecretSyncApp = "vercel"
+	SecretSyncAppNetlify               SecretSyncApp = "netlify"
+	SecretSyncAppHeroku                SecretSyncApp = "heroku"
+	SecretSyncAppTerraformCloud        SecretSyncApp = "terraform-cloud"
+	SecretSyncAppKubernetes            SecretSyncApp = "kubernetes"
 )
 
 type SecretSyncBehavior string
@@ -44,10 +51,10 @@ const (
 	operationCheckDuplicateDestination = "CallCheckDuplicateDestination"
 )
 
-func (client Client) CreateSecretSync(request CreateSecretSyncRequest) (SecretSync, error) {
+func (client Client) CreateSecretSync(ctx context.Context, request CreateSecretSyncRequest) (SecretSync, error) {
 	var body CreateSecretSyncResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -64,10 +71,10 @@ func (client Client) CreateSecretSync(request CreateSecretSyncRequest) (SecretSy
 	return body.SecretSync, nil
 }
 
-func (client Client) UpdateSecretSync(request UpdateSecretSyncRequest) (SecretSync, error) {
+func (client Client) UpdateSecretSync(ctx context.Context, request UpdateSecretSyncRequest) (SecretSync, error) {
 	var body UpdateSecretSyncResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -84,10 +91,10 @@ func (client Client) UpdateSecretSync(request UpdateSecretSyncRequest) (SecretSy
 	return body.SecretSync, nil
 }
 
-func (client Client) GetSecretSyncById(request GetSecretSyncByIdRequest) (SecretSync, error) {
+func (client Client) GetSecretSyncById(ctx context.Context, request GetSecretSyncByIdRequest) (SecretSync, error) {
 	var body GetSecretSyncByIdResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		Get(fmt.Sprintf("api/v1/secret-syncs/%s/%s", string(request.App), request.ID))
@@ -107,10 +114,10 @@ func (client Client) GetSecretSyncById(request GetSecretSyncByIdRequest) (Secret
 	return body.SecretSync, nil
 }
 
-func (client Client) DeleteSecretSync(request DeleteSecretSyncRequest) (SecretSync, error) {
+func (client Client) DeleteSecretSync(ctx context.Context, request DeleteSecretSyncRequest) (SecretSync, error) {
 	var body DeleteSecretSyncResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		Delete(fmt.Sprintf("api/v1/secret-syncs/%s/%s", string(request.App), request.ID))
@@ -126,10 +133,10 @@ func (client Client) DeleteSecretSync(request DeleteSecretSyncRequest) (SecretSy
 	return body.SecretSync, nil
 }
 
-func (client Client) CheckDuplicateDestination(request CheckDuplicateDestinationRequest) (CheckDuplicateDestinationResponse, error) {
+func (client Client) CheckDuplicateDestination(ctx context.Context, request CheckDuplicateDestinationRequest) (CheckDuplicateDestinationResponse, error) {
 	var body CheckDuplicateDestinationResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
 	"net/http"
@@ -24,11 +25,11 @@ const (
 	operationGetSingleRawSecretByNameV3 = "CallGetSingleRawSecretByNameV3"
 )
 
-func (client Client) GetSecretsV3(request GetEncryptedSecretsV3Request) (GetEncryptedSecretsV3Response, error) {
+func (client Client) GetSecretsV3(ctx context.Context, request GetEncryptedSecretsV3Request) (GetEncryptedSecretsV3Response, error) {
 	var secretsResponse GetEncryptedSecretsV3Response
 
 	httpRequest := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&secretsResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetQueryParam("environment", request.Environment).
@@ -52,10 +53,10 @@ func (client Client) GetSecretsV3(request GetEncryptedSecretsV3Request) (GetEncr
 	return secretsResponse, nil
 }
 
-func (client Client) CreateSecretsV3(request CreateSecretV3Request) (EncryptedSecretV3, error) {
+func (client Client) CreateSecretsV3(ctx context.Context, request CreateSecretV3Request) (EncryptedSecretV3, error) {
 	var secretsResponse EncryptedSecretV3
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&secretsResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -73,10 +74,10 @@ func (client Client) CreateSecretsV3(request CreateSecretV3Request) (EncryptedSe
 	return secretsResponse, nil
 }
 
-func (client Client) DeleteSecretsV3(request DeleteSecretV3Request) error {
+func (client Client) DeleteSecretsV3(ctx context.Context, request DeleteSecretV3Request) error {
 	var secretsResponse GetEncryptedSecretsV3Response
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&secretsResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -94,11 +95,11 @@ func (client Client) DeleteSecretsV3(request DeleteSecretV3Request) error {
 	return nil
 }
 
-func (client Client) UpdateSecretsV3(request UpdateSecretByNameV3Request) error {
+func (client Client) UpdateSecretsV3(ctx context.Context, request UpdateSecretByNameV3Request) error {
 
 	var secretsResponse GetEncryptedSecretsV3Response
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&secretsResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -116,10 +117,10 @@ func (client Client) UpdateSecretsV3(request UpdateSecretByNameV3Request) error
 	return nil
 }
 
-func (client Client) GetSingleSecretByIDV3(request GetSingleSecretByIDV3Request) (GetSingleSecretByIDV3Response, error) {
+func (client Client) GetSingleSecretByIDV3(ctx context.Context, request GetSingleSecretByIDV3Request) (GetSingleSecretByIDV3Response, error) {
 	var secretsResponse GetSingleSecretByIDV3Response
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&secretsResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		Get(fmt.Sprintf("api/v3/secrets/raw/id/%s", request.ID))
@@ -138,11 +139,11 @@ func (client Client) GetSingleSecretByIDV3(request GetSingleSecretByIDV3Request)
 	return secretsResponse, nil
 }
 
-func (client Client) GetSecretsRawV3(request GetRawSecretsV3Request) (GetRawSecretsV3Response, error) {
+func (client Client) GetSecretsRawV3(ctx context.Context, request GetRawSecretsV3Request) (GetRawSecretsV3Response, error) {
 	var secretsResponse GetRawSecretsV3Response
 
 	httpRequest := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&secretsResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetQueryParams(map[string]string{
@@ -169,10 +170,10 @@ func (client Client) GetSecretsRawV3(request GetRawSecretsV3Request) (GetRawSecr
 	return secretsResponse, nil
 }
 
-func (client Client) CreateRawSecretsV3(request CreateRawSecretV3Request) (RawV3Secret, error) {
+func (client Client) CreateRawSecretsV3(ctx context.Context, request CreateRawSecretV3Request) (RawV3Secret, error) {
 	var secretsResponse CreateRawSecretsV3Response
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&secretsResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -190,10 +191,10 @@ func (client Client) CreateRawSecretsV3(request CreateRawSecretV3Request) (RawV3
 	return secretsResponse.Secret, nil
 }
 
-func (client Client) DeleteRawSecretV3(request DeleteRawSecretV3Request) error {
+func (client Client) DeleteRawSecretV3(ctx context.Context, request DeleteRawSecretV3Request) error {
 	var secretsResponse GetRawSecretsV3Response
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&secretsResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -211,12 +212,12 @@ func (client Client) DeleteRawSecretV3(request DeleteRawSecretV3Request) error {
 	return nil
 }
 
-func (client Client) UpdateRawSecretV3(request UpdateRawSecretByNameV3Request) (RawV3Secret, error) {
+func (client Client) UpdateRawSecretV3(ctx context.Context, request UpdateRawSecretByNameV3Request) (RawV3Secret, error) {
 	var secretsResponse struct {
 		Secret RawV3Secret `json:"secret"`
 	}
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&secretsResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -234,7 +235,7 @@ func (client Client) UpdateRawSecretV3(request UpdateRawSecretByNameV3Request) (
 	return secretsResponse.Secret, nil
 }
 
-func (client Client) GetSingleRawSecretByNameV3(request GetSingleSecretByNameV3Request, viewSecretValue *bool) (GetSingleRawSecretByNameSecretResponse, error) {
+func (client Client) GetSingleRawSecretByNameV3(ctx context.Context, request GetSingleSecretByNameV3Request, viewSecretValue *bool) (GetSingleRawSecretByNameSecretResponse, error) {
 	shouldViewSecretValue := true
 	if viewSecretValue != nil {
 		shouldViewSecretValue = *viewSecretValue
@@ -242,7 +243,7 @@ func (client Client) GetSingleRawSecretByNameV3(request GetSingleSecretByNameV3R
 
 	var secretsResponse GetSingleRawSecretByNameSecretResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&secretsResponse).
 		SetHeader("User-Agent", USER_AGENT).
 		SetQueryParam("workspaceId", request.WorkspaceId).
@@ -269,7 +270,7 @@ func (client Client) GetSingleRawSecretByNameV3(request GetSingleSecretByNameV3R
 	return secretsResponse, nil
 }
 
-func (client Client) GetPlainTextSecretsViaServiceToken(secretFolderPath string, envSlug string) ([]SingleEnvironmentVariable, *GetServiceTokenDetailsResponse, error) {
+func (client Client) GetPlainTextSecretsViaServiceToken(ctx context.Context, secretFolderPath string, envSlug string) ([]SingleEnvironmentVariable, *GetServiceTokenDetailsResponse, error) {
 	if client.Config.ServiceToken == "" {
 		return nil, nil, fmt.Errorf("service token must be defined to fetch secrets")
 	}
@@ -279,7 +280,7 @@ func (client Client) GetPlainTextSecretsViaServiceToken(secretFolderPath string,
 		return nil, nil, fmt.Errorf("invalid service token entered. Please double check your service token and try again")
 	}
 
-	serviceTokenDetails, err := client.GetServiceTokenDetailsV2()
+	serviceTokenDetails, err := client.GetServiceTokenDetailsV2(ctx)
 	if err != nil {
 		return nil, nil, fmt.Errorf("unable to get service token details. [err=%v]", err)
 	}
@@ -293,7 +294,7 @@ func (client Client) GetPlainTextSecretsViaServiceToken(secretFolderPath string,
 		request.SecretPath = secretFolderPath
 	}
 
-	encryptedSecrets, err := client.GetSecretsV3(request)
+	encryptedSecrets, err := client.GetSecretsV3(ctx, request)
 
 	if err != nil {
 		return nil, nil, err
@@ -317,7 +318,7 @@ func (client Client) GetPlainTextSecretsViaServiceToken(secretFolderPath string,
 	return plainTextSecrets, &serviceTokenDetails, nil
 }
 
-func (client Client) GetRawSecrets(secretFolderPath string, envSlug string, workspaceId string) ([]RawV3Secret, error) {
+func (client Client) GetRawSecrets(ctx context.Context, secretFolderPath string, envSlug string, workspaceId string) ([]RawV3Secret, error) {
 	request := GetRawSecretsV3Request{
 		Environment:            envSlug,
 		WorkspaceId:            workspaceId,
@@ -328,7 +329,7 @@ func (client Client) GetRawSecrets(secretFolderPath string, envSlug string, work
 		request.SecretPath = secretFolderPath
 	}
 
-	secrets, err := client.GetSecretsRawV3(request)
+	secrets, err := client.GetSecretsRawV3(ctx, request)
 
 	if err != nil {
 		return nil, err
@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"terraform-provider-infisical/internal/errors"
 )
@@ -12,10 +13,10 @@ const (
 	operationDeleteSubOrganization = "CallDeleteSubOrganization"
 )
 
-func (client Client) CreateSubOrganization(request CreateSubOrganizationRequest) (CreateSubOrganizationResponse, error) {
+func (client Client) CreateSubOrganization(ctx context.Context, request CreateSubOrganizationRequest) (CreateSubOrganizationResponse, error) {
 	var responseData CreateSubOrganizationResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&responseData).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -33,50 +34,37 @@ func (client Client) CreateSubOrganization(request CreateSubOrganizationRequest)
 }
 
 // ListSubOrganizations returns every sub-organization under the caller's root org
-// (subject to root-org RBAC). It paginates over the offset until the full set is
-// retrieved. isAccessible is intentionally NOT set so that sub-orgs the caller is
-// not a member of are still returned, which keeps Read and Import robust.
-func (client Client) ListSubOrganizations() ([]SubOrganization, error) {
+// (subject to root-org RBAC). isAccessible is intentionally NOT set so that sub-orgs the
+// caller is not a member of are still returned, which keeps Read and Import robust.
+func (client Client) ListSubOrganizations(ctx context.Context) ([]SubOrganization, error) {
 	const pageSize = 1000
-	offset := 0
-	var allSubOrgs []SubOrganization
 
-	for {
+	result, err := paginate(pageSize, ListOptions{}, func(offset, limit int) ([]SubOrganization, int, error) {
 		var responseData ListSubOrganizationsResponse
-		response, err := client.Config.HttpClient.
-			R().
-			SetResult(&responseData).
-			SetHeader("User-Agent", USER_AGENT).
-			SetQueryParams(map[string]string{
-				"limit":  fmt.Sprintf("%d", pageSize),
-				"offset": fmt.Sprintf("%d", offset),
-			}).
-			Get("api/v1/sub-organizations")
+		response, err := client.cachedGet(ctx, "api/v1/sub-organizations", pageQuery(offset, limit), &responseData)
 
 		if err != nil {
-			return nil, errors.NewGenericRequestError(operationListSubOrganizations, err)
+			return nil, 0, errors.NewGenericRequestError(operationListSubOrganizations, err)
 		}
 
 		if response.IsError() {
-			return nil, errors.NewAPIErrorWithResponse(operationListSubOrganizations, response, nil)
+			return nil, 0, errors.NewAPIErrorWithResponse(operationListSubOrganizations, response, nil)
 		}
 
-		allSubOrgs = append(allSubOrgs, responseData.Organizations...)
-		offset += pageSize
-
-		if len(responseData.Organizations) == 0 || offset >= responseData.TotalCount {
-			break
-		}
+		return responseData.Organizations, responseData.TotalCount, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return allSubOrgs, nil
+	return result.Items, nil
 }
 
 // GetSubOrganizationById resolves a single sub-organization by ID. The API exposes
 // no GET-by-id endpoint, so this lists sub-orgs and filters client-side. It returns
 // ErrNotFound when no sub-org with the given ID exists.
-func (client Client) GetSubOrganizationById(id string) (SubOrganization, error) {
-	subOrgs, err := client.ListSubOrganizations()
+func (client Client) GetSubOrganizationById(ctx context.Context, id string) (SubOrganization, error) {
+	subOrgs, err := client.ListSubOrganizations(ctx)
 	if err != nil {
 		return SubOrganization{}, err
 	}
@@ -90,10 +78,10 @@ func (client Client) GetSubOrganizationById(id string) (SubOrganization, error)
 	return SubOrganization{}, ErrNotFound
 }
 
-func (client Client) UpdateSubOrganization(request UpdateSubOrganizationRequest) (UpdateSubOrganizationResponse, error) {
+func (client Client) UpdateSubOrganization(ctx context.Context, request UpdateSubOrganizationRequest) (UpdateSubOrganizationResponse, error) {
 	var responseData UpdateSubOrganizationResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&responseData).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -110,10 +98,10 @@ func (client Client) UpdateSubOrganization(request UpdateSubOrganizationRequest)
 	return responseData, nil
 }
 
-func (client Client) DeleteSubOrganization(request DeleteSubOrganizationRequest) (DeleteSubOrganizationResponse, error) {
+func (client Client) DeleteSubOrganization(ctx context.Context, request DeleteSubOrganizationRequest) (DeleteSubOrganizationResponse, error) {
 	var responseData DeleteSubOrganizationResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&responseData).
 		SetHeader("User-Agent", USER_AGENT).
 		Delete(fmt.Sprintf("api/v1/sub-organizations/%s", request.SubOrgID))
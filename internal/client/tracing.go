@@ -0,0 +1,116 @@
+package infisicalclient
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	infisicalerrors "terraform-provider-infisical/internal/errors"
+
+	"github.com/go-resty/resty/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the name of the tracer the API client records its spans with.
+const TracerName = "terraform-provider-infisical/internal/client"
+
+// RequestIdAttribute is the span attribute holding the id Infisical assigned to a failed request.
+const RequestIdAttribute = "infisical.request_id"
+
+type requestSpanContextKey struct{}
+
+// configureTracing records a client span for every API call, retries included, as a child of
+// the span in the request context. The trace context is propagated to the API with the
+// traceparent header. Without a tracer provider configured, the global no-op provider makes
+// this free.
+func configureTracing(c *resty.Client) {
+	c.OnBeforeRequest(func(c *resty.Client, r *resty.Request) error {
+		// the span covers every attempt, so it is only started by the first
+		if r.Attempt > 1 {
+			return nil
+		}
+
+		// the request URL is only resolved against the base URL once the hooks have run
+		target := r.URL
+		if parsed, err := url.Parse(r.URL); err == nil && !parsed.IsAbs() && c.BaseURL != "" {
+			target = strings.TrimRight(c.BaseURL, "/") + "/" + strings.TrimLeft(r.URL, "/")
+		}
+		path := requestPath(target)
+
+		ctx, span := otel.Tracer(TracerName).Start(r.Context(), fmt.Sprintf("%s %s", r.Method, path),
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(r.Method),
+				semconv.URLPath(path),
+			),
+		)
+		if parsed, err := url.Parse(target); err == nil && parsed.Host != "" {
+			span.SetAttributes(semconv.ServerAddress(parsed.Hostname()))
+		}
+
+		otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(r.Header))
+		r.SetContext(context.WithValue(ctx, requestSpanContextKey{}, span))
+		return nil
+	})
+
+	c.OnSuccess(func(_ *resty.Client, r *resty.Response) {
+		span, ok := requestSpan(r.Request)
+		if !ok {
+			return
+		}
+
+		endRequestSpan(span, r.Request, r, nil)
+	})
+
+	c.OnError(func(r *resty.Request, err error) {
+		span, ok := requestSpan(r)
+		if !ok {
+			return
+		}
+
+		var response *resty.Response
+		if responseErr, ok := err.(*resty.ResponseError); ok {
+			response = responseErr.Response
+		}
+		endRequestSpan(span, r, response, err)
+	})
+
+	c.OnPanic(func(r *resty.Request, err error) {
+		if span, ok := requestSpan(r); ok {
+			endRequestSpan(span, r, nil, err)
+		}
+	})
+}
+
+func requestSpan(r *resty.Request) (trace.Span, bool) {
+	span, ok := r.Context().Value(requestSpanContextKey{}).(trace.Span)
+	return span, ok
+}
+
+func endRequestSpan(span trace.Span, r *resty.Request, response *resty.Response, err error) {
+	defer span.End()
+
+	if r.Attempt > 1 {
+		span.SetAttributes(semconv.HTTPRequestResendCount(r.Attempt - 1))
+	}
+
+	if response != nil && response.RawResponse != nil {
+		span.SetAttributes(semconv.HTTPResponseStatusCode(response.StatusCode()))
+		if reqId := infisicalerrors.TryExtractReqId(response); reqId != "" {
+			span.SetAttributes(attribute.String(RequestIdAttribute, reqId))
+		}
+	}
+
+	switch {
+	case err != nil:
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	case response != nil && response.IsError():
+		span.SetStatus(codes.Error, response.Status())
+	}
+}
@@ -0,0 +1,129 @@
+package infisicalclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// recordSpans installs a global tracer provider recording into the returned recorder for the
+// duration of the test.
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+
+	recorder := tracetest.NewSpanRecorder()
+	previousProvider, previousPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previousProvider)
+		otel.SetTextMapPropagator(previousPropagator)
+	})
+
+	return recorder
+}
+
+func spanAttributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	attributes := map[attribute.Key]attribute.Value{}
+	for _, kv := range span.Attributes() {
+		attributes[kv.Key] = kv.Value
+	}
+	return attributes
+}
+
+func TestConfigureTracing_RecordsAChildSpanPerCall(t *testing.T) {
+	recorder := recordSpans(t)
+
+	var attempts int32
+	var traceparent atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent.Store(r.Header.Get("traceparent"))
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		jsonResponse(http.StatusNotFound, `{"message":"not found","reqId":"req-123"}`)(w, r)
+	}))
+	defer server.Close()
+
+	rc := resty.New().SetBaseURL(server.URL)
+	configureTracing(rc)
+	configureRetries(rc, RetryPolicy{MaxRetries: 2, MinWait: time.Millisecond, MaxWait: time.Millisecond})
+
+	ctx, operation := otel.Tracer("test").Start(context.Background(), "infisical_secret read")
+	if _, err := rc.R().SetContext(ctx).Get("api/v3/secrets/raw/DB_URL?workspaceId=project-id"); err != nil {
+		t.Fatalf("request error: %s", err)
+	}
+	operation.End()
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("got %d spans, want the request and the operation", len(spans))
+	}
+	span := spans[0]
+
+	if span.Name() != "GET /api/v3/secrets/raw/DB_URL" {
+		t.Errorf("span name = %q", span.Name())
+	}
+	if span.SpanKind() != trace.SpanKindClient {
+		t.Errorf("span kind = %s, want client", span.SpanKind())
+	}
+	if span.Parent().SpanID() != operation.SpanContext().SpanID() {
+		t.Error("the request span is not a child of the operation span")
+	}
+	if span.Status().Code != codes.Error {
+		t.Errorf("span status = %v, want an error", span.Status())
+	}
+
+	attributes := spanAttributes(span)
+	if got := attributes["http.response.status_code"].AsInt64(); got != http.StatusNotFound {
+		t.Errorf("status code attribute = %d, want 404", got)
+	}
+	if got := attributes[RequestIdAttribute].AsString(); got != "req-123" {
+		t.Errorf("request id attribute = %q, want req-123", got)
+	}
+	if got := attributes["http.request.resend_count"].AsInt64(); got != 1 {
+		t.Errorf("resend count attribute = %d, want 1", got)
+	}
+
+	sent, _ := traceparent.Load().(string)
+	propagated := propagation.TraceContext{}.Extract(context.Background(), propagation.HeaderCarrier{"Traceparent": []string{sent}})
+	if trace.SpanContextFromContext(propagated).SpanID() != span.SpanContext().SpanID() {
+		t.Errorf("traceparent %q does not identify the request span", sent)
+	}
+}
+
+func TestConfigureTracing_EndsTheSpanOfAFailedCall(t *testing.T) {
+	recorder := recordSpans(t)
+
+	server := httptest.NewServer(jsonResponse(http.StatusOK, `{}`))
+	serverURL := server.URL
+	server.Close()
+
+	rc := resty.New().SetBaseURL(serverURL)
+	configureTracing(rc)
+
+	if _, err := rc.R().SetContext(context.Background()).Post("api/v1/folders"); err == nil {
+		t.Fatal("expected the request to fail")
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("got %d spans, want 1", len(spans))
+	}
+	if spans[0].Status().Code != codes.Error || len(spans[0].Events()) == 0 {
+		t.Errorf("the span of the failed call does not record the error: %v", spans[0].Status())
+	}
+}
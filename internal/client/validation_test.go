@@ -0,0 +1,74 @@
+package infisicalclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	infisicalerrors "terraform-provider-infisical/internal/errors"
+
+	"github.com/go-resty/resty/v2"
+)
+
+func TestCreateSecretSync_ReportsValidationIssues(t *testing.T) {
+	bodies := map[string]string{
+		"issues as the message": `{"reqId":"req-1","statusCode":422,"error":"ValidationFailure","message":[
+			{"code":"custom","path":["syncOptions","keySchema"],"message":"Key schema must include {{secretKey}}"},
+			{"code":"invalid_type","path":["destinationConfig","paths",0],"message":"Required"}
+		]}`,
+		"issues as details": `{"reqId":"req-1","statusCode":422,"error":"ValidationFailure","message":"Request validation failed","details":[
+			{"code":"custom","path":["syncOptions","keySchema"],"message":"Key schema must include {{secretKey}}"},
+			{"code":"invalid_type","path":["destinationConfig","paths",0],"message":"Required"}
+		]}`,
+	}
+
+	for name, body := range bodies {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(jsonResponse(http.StatusUnprocessableEntity, body))
+			defer server.Close()
+
+			client := Client{Config{HttpClient: resty.New().SetBaseURL(server.URL)}}
+
+			_, err := client.CreateSecretSync(context.Background(), CreateSecretSyncRequest{App: "aws-parameter-store"})
+
+			var apiErr *infisicalerrors.APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("got error %v, want an APIError", err)
+			}
+
+			want := []infisicalerrors.ValidationIssue{
+				{Path: []string{"syncOptions", "keySchema"}, Message: "Key schema must include {{secretKey}}"},
+				{Path: []string{"destinationConfig", "paths", "0"}, Message: "Required"},
+			}
+			if !reflect.DeepEqual(apiErr.ValidationIssues, want) {
+				t.Errorf("got issues %+v, want %+v", apiErr.ValidationIssues, want)
+			}
+			if wantMessage := "syncOptions.keySchema: Key schema must include {{secretKey}}; destinationConfig.paths.0: Required"; apiErr.ErrorMessage != wantMessage {
+				t.Errorf("got message %q, want %q", apiErr.ErrorMessage, wantMessage)
+			}
+			if apiErr.ReqId != "req-1" {
+				t.Errorf("got request id %q, want req-1", apiErr.ReqId)
+			}
+		})
+	}
+}
+
+func TestCreateSecretSync_KeepsPlainErrorMessages(t *testing.T) {
+	server := httptest.NewServer(jsonResponse(http.StatusBadRequest, `{"statusCode":400,"message":"Secret sync with name already exists","error":"BadRequest"}`))
+	defer server.Close()
+
+	client := Client{Config{HttpClient: resty.New().SetBaseURL(server.URL)}}
+
+	_, err := client.CreateSecretSync(context.Background(), CreateSecretSyncRequest{App: "aws-parameter-store"})
+
+	var apiErr *infisicalerrors.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("got error %v, want an APIError", err)
+	}
+	if apiErr.ValidationIssues != nil || apiErr.ErrorMessage != "Secret sync with name already exists" {
+		t.Errorf("got message %q and issues %+v, want the plain message only", apiErr.ErrorMessage, apiErr.ValidationIssues)
+	}
+}
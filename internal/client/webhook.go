@@ -1,6 +1,7 @@
 package infisicalclient
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"terraform-provider-infisical/internal/errors"
@@ -13,10 +14,10 @@ const (
 	operationDeleteWebhook  = "CallDeleteWebhook"
 )
 
-func (client Client) CreateWebhook(request CreateWebhookRequest) (CreateWebhookResponse, error) {
+func (client Client) CreateWebhook(ctx context.Context, request CreateWebhookRequest) (CreateWebhookResponse, error) {
 	var body CreateWebhookResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -33,10 +34,10 @@ func (client Client) CreateWebhook(request CreateWebhookRequest) (CreateWebhookR
 	return body, nil
 }
 
-func (client Client) GetWebhookByID(request GetWebhookByIDRequest) (GetWebhookByIDResponse, error) {
+func (client Client) GetWebhookByID(ctx context.Context, request GetWebhookByIDRequest) (GetWebhookByIDResponse, error) {
 	var body GetWebhookByIDResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		Get("api/v1/webhooks/" + request.ID)
@@ -56,10 +57,10 @@ func (client Client) GetWebhookByID(request GetWebhookByIDRequest) (GetWebhookBy
 	return body, nil
 }
 
-func (client Client) UpdateWebhook(request UpdateWebhookRequest) (UpdateWebhookResponse, error) {
+func (client Client) UpdateWebhook(ctx context.Context, request UpdateWebhookRequest) (UpdateWebhookResponse, error) {
 	var body UpdateWebhookResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		SetBody(request).
@@ -76,10 +77,10 @@ func (client Client) UpdateWebhook(request UpdateWebhookRequest) (UpdateWebhookR
 	return body, nil
 }
 
-func (client Client) DeleteWebhook(request DeleteWebhookRequest) (DeleteWebhookResponse, error) {
+func (client Client) DeleteWebhook(ctx context.Context, request DeleteWebhookRequest) (DeleteWebhookResponse, error) {
 	var body DeleteWebhookResponse
 	response, err := client.Config.HttpClient.
-		R().
+		R().SetContext(ctx).
 		SetResult(&body).
 		SetHeader("User-Agent", USER_AGENT).
 		Delete(fmt.Sprintf("api/v1/webhooks/%s", request.ID))
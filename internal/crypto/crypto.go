@@ -4,7 +4,11 @@ import (
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
 	"io"
+	"strings"
 
 	"golang.org/x/crypto/nacl/box"
 )
@@ -83,3 +87,34 @@ func EncryptAssymmetric(message []byte, nonce []byte, publicKey []byte, privateK
 	encryptedPlainText := box.Seal(nil, message, (*[24]byte)(nonce), (*[32]byte)(publicKey), (*[32]byte)(privateKey))
 	return encryptedPlainText
 }
+
+// HashSecretValue returns a salted SHA-256 digest of value in the form "<salt>:<digest>", both hex encoded.
+// It is stored in state in place of write-only values so out-of-band changes can still be detected.
+func HashSecretValue(value string) (string, error) {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	return hashWithSalt(salt, value), nil
+}
+
+// SecretValueMatchesHash reports whether value produces the given salted digest.
+func SecretValueMatchesHash(value string, hash string) bool {
+	saltHex, _, found := strings.Cut(hash, ":")
+	if !found {
+		return false
+	}
+
+	salt, err := hex.DecodeString(saltHex)
+	if err != nil {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(hashWithSalt(salt, value)), []byte(hash)) == 1
+}
+
+func hashWithSalt(salt []byte, value string) string {
+	digest := sha256.Sum256(append(append([]byte{}, salt...), value...))
+	return hex.EncodeToString(salt) + ":" + hex.EncodeToString(digest[:])
+}
@@ -0,0 +1,51 @@
+package crypto
+
+import "testing"
+
+func TestHashSecretValue(t *testing.T) {
+	first, err := HashSecretValue("postgres://u:p@db")
+	if err != nil {
+		t.Fatalf("hashing: %v", err)
+	}
+	second, err := HashSecretValue("postgres://u:p@db")
+	if err != nil {
+		t.Fatalf("hashing: %v", err)
+	}
+
+	if first == second {
+		t.Error("hashing the same value twice gave the same digest, want a fresh salt each time")
+	}
+	for _, hash := range []string{first, second} {
+		if !SecretValueMatchesHash("postgres://u:p@db", hash) {
+			t.Errorf("the value does not match its hash %q", hash)
+		}
+	}
+}
+
+func TestSecretValueMatchesHash(t *testing.T) {
+	hash, err := HashSecretValue("applied")
+	if err != nil {
+		t.Fatalf("hashing: %v", err)
+	}
+
+	cases := map[string]struct {
+		value string
+		hash  string
+		want  bool
+	}{
+		"same value":        {value: "applied", hash: hash, want: true},
+		"changed value":     {value: "changed", hash: hash},
+		"empty value":       {value: "", hash: hash},
+		"no salt separator": {value: "applied", hash: "0011"},
+		"salt is not hex":   {value: "applied", hash: "zz:0011"},
+		"empty hash":        {value: "applied", hash: ""},
+		"truncated digest":  {value: "applied", hash: hash[:len(hash)-1]},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := SecretValueMatchesHash(c.value, c.hash); got != c.want {
+				t.Errorf("SecretValueMatchesHash(%q, %q) = %t, want %t", c.value, c.hash, got, c.want)
+			}
+		})
+	}
+}
@@ -2,7 +2,11 @@ package errors
 
 import (
 	"encoding/json"
+	stderrors "errors"
 	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/go-resty/resty/v2"
 )
@@ -20,15 +24,57 @@ func NewGenericRequestError(operation string, err error) *GenericRequestError {
 	return &GenericRequestError{err: err, operation: operation}
 }
 
+// ErrNotFound is returned when the requested resource does not exist. An APIError for a 404
+// response matches it with errors.Is, so a missing resource is recognised however the client
+// reported it.
+var ErrNotFound = stderrors.New("resource not found")
+
+// Status is the HTTP status code of an unsuccessful API response.
+type Status int
+
+// IsNotFound reports whether the status means the requested resource does not exist.
+func (s Status) IsNotFound() bool {
+	return s == http.StatusNotFound
+}
+
 // APIError represents an error response from the API.
 type APIError struct {
 	AdditionalContext string `json:"additionalContext,omitempty"`
 	Operation         string `json:"operation"`
 	Method            string `json:"method"`
 	URL               string `json:"url"`
-	StatusCode        int    `json:"statusCode"`
+	StatusCode        Status `json:"statusCode"`
 	ErrorMessage      string `json:"message,omitempty"`
 	ReqId             string `json:"reqId,omitempty"`
+
+	// ValidationIssues lists the fields Infisical rejected when it failed to validate the
+	// request payload.
+	ValidationIssues []ValidationIssue `json:"validationIssues,omitempty"`
+}
+
+// ValidationIssue is a problem Infisical found with one field of a request payload.
+type ValidationIssue struct {
+	// Path locates the field in the payload, such as ["syncOptions", "keySchema"]. Array
+	// indices are given in decimal.
+	Path    []string `json:"path"`
+	Message string   `json:"message"`
+}
+
+// Field returns the dotted path of the field, such as "syncOptions.keySchema".
+func (i ValidationIssue) Field() string {
+	return strings.Join(i.Path, ".")
+}
+
+func (i ValidationIssue) String() string {
+	if len(i.Path) == 0 {
+		return i.Message
+	}
+	return fmt.Sprintf("%s: %s", i.Field(), i.Message)
+}
+
+// Is matches an APIError for a missing resource against ErrNotFound.
+func (e *APIError) Is(target error) bool {
+	return target == ErrNotFound && e.StatusCode.IsNotFound()
 }
 
 func (e *APIError) Error() string {
@@ -53,19 +99,20 @@ func (e *APIError) Error() string {
 }
 
 func NewAPIErrorWithResponse(operation string, res *resty.Response, additionalContext *string) error {
-	errorMessage := tryParseErrorBody(res)
-	reqId := tryExtractReqId(res)
+	errorMessage, validationIssues := tryParseErrorBody(res)
+	reqId := TryExtractReqId(res)
 
 	if res == nil {
 		return NewGenericRequestError(operation, fmt.Errorf("response is nil"))
 	}
 
 	apiError := &APIError{
-		Operation:  operation,
-		Method:     res.Request.Method,
-		URL:        res.Request.URL,
-		StatusCode: res.StatusCode(),
-		ReqId:      reqId,
+		Operation:        operation,
+		Method:           res.Request.Method,
+		URL:              res.Request.URL,
+		StatusCode:       Status(res.StatusCode()),
+		ReqId:            reqId,
+		ValidationIssues: validationIssues,
 	}
 
 	if additionalContext != nil && *additionalContext != "" {
@@ -79,14 +126,22 @@ func NewAPIErrorWithResponse(operation string, res *resty.Response, additionalCo
 	return apiError
 }
 
-func tryParseErrorBody(res *resty.Response) string {
+func tryParseErrorBody(res *resty.Response) (string, []ValidationIssue) {
 	if res == nil || !res.IsError() {
-		return ""
+		return "", nil
 	}
 
 	body := res.String()
 	if body == "" {
-		return ""
+		return "", nil
+	}
+
+	if issues := tryParseValidationIssues(body); len(issues) > 0 {
+		messages := make([]string, 0, len(issues))
+		for _, issue := range issues {
+			messages = append(messages, issue.String())
+		}
+		return strings.Join(messages, "; "), issues
 	}
 
 	type ErrorResponse struct {
@@ -96,7 +151,7 @@ func tryParseErrorBody(res *resty.Response) string {
 
 	// stringify zod body entirely
 	if res.StatusCode() == 422 {
-		return body
+		return body, nil
 	}
 
 	// now we have a string, we need to try to parse it as json
@@ -104,13 +159,58 @@ func tryParseErrorBody(res *resty.Response) string {
 	err := json.Unmarshal([]byte(body), &errorResponse)
 
 	if err != nil {
-		return ""
+		return "", nil
+	}
+
+	return errorResponse.Message, nil
+}
+
+// tryParseValidationIssues extracts the zod issues from the body of a validation error. Infisical
+// reports them either as the message itself, or under details with a summary message.
+func tryParseValidationIssues(body string) []ValidationIssue {
+	type zodIssue struct {
+		Path    []any  `json:"path"`
+		Message string `json:"message"`
+	}
+
+	type ValidationErrorResponse struct {
+		Message json.RawMessage `json:"message"`
+		Details json.RawMessage `json:"details"`
+	}
+
+	var errorResponse ValidationErrorResponse
+	if err := json.Unmarshal([]byte(body), &errorResponse); err != nil {
+		return nil
+	}
+
+	for _, candidate := range []json.RawMessage{errorResponse.Message, errorResponse.Details} {
+		var zodIssues []zodIssue
+		if err := json.Unmarshal(candidate, &zodIssues); err != nil || len(zodIssues) == 0 {
+			continue
+		}
+
+		issues := make([]ValidationIssue, 0, len(zodIssues))
+		for _, raw := range zodIssues {
+			issue := ValidationIssue{Path: make([]string, 0, len(raw.Path)), Message: raw.Message}
+			for _, segment := range raw.Path {
+				switch segment := segment.(type) {
+				case string:
+					issue.Path = append(issue.Path, segment)
+				case float64:
+					issue.Path = append(issue.Path, strconv.Itoa(int(segment)))
+				}
+			}
+			issues = append(issues, issue)
+		}
+		return issues
 	}
 
-	return errorResponse.Message
+	return nil
 }
 
-func tryExtractReqId(res *resty.Response) string {
+// TryExtractReqId returns the request id Infisical reports in the body of an error response,
+// or an empty string when the response carries none.
+func TryExtractReqId(res *resty.Response) string {
 	if res == nil || !res.IsError() {
 		return ""
 	}
@@ -0,0 +1,46 @@
+package pkg
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UnknownWhenChangedModifier plans a computed string as unknown when any of the given attributes
+// changes. It follows UseStateForUnknown for values that are derived from those attributes, so the
+// prior value is only kept while they stay the same.
+type UnknownWhenChangedModifier struct {
+	Attributes []path.Path
+}
+
+func (m UnknownWhenChangedModifier) Description(ctx context.Context) string {
+	return "Marks the value as unknown when an attribute it is derived from changes"
+}
+
+func (m UnknownWhenChangedModifier) MarkdownDescription(ctx context.Context) string {
+	return "Marks the value as unknown when an attribute it is derived from changes"
+}
+
+func (m UnknownWhenChangedModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Nothing to keep on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	for _, attribute := range m.Attributes {
+		var planned, prior attr.Value
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, attribute, &planned)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, attribute, &prior)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !planned.Equal(prior) {
+			resp.PlanValue = types.StringUnknown()
+			return
+		}
+	}
+}
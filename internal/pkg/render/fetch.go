@@ -0,0 +1,59 @@
+package render
+
+import (
+	"context"
+	"fmt"
+	infisical "terraform-provider-infisical/internal/client"
+)
+
+// ClientFetcher returns a FetchSecretsFunc backed by the provider's client. With service token
+// auth the project is implied by the token, so projectId is ignored.
+func ClientFetcher(ctx context.Context, client *infisical.Client) FetchSecretsFunc {
+	return func(projectId string, envSlug string, secretPath string) ([]Secret, error) {
+		if client.Config.AuthStrategy == infisical.AuthStrategy.SERVICE_TOKEN {
+			plainTextSecrets, serviceTokenDetails, err := client.GetPlainTextSecretsViaServiceToken(ctx, secretPath, envSlug)
+			if err != nil {
+				return nil, err
+			}
+
+			secrets := make([]Secret, 0, len(plainTextSecrets))
+			for _, secret := range plainTextSecrets {
+				secrets = append(secrets, Secret{
+					ID:          secret.ID,
+					Key:         secret.Key,
+					Value:       secret.Value,
+					Type:        secret.Type,
+					Comment:     secret.Comment,
+					SecretPath:  secretPath,
+					WorkspaceId: serviceTokenDetails.Workspace,
+					Environment: envSlug,
+				})
+			}
+			return secrets, nil
+		}
+
+		if !client.Config.IsMachineIdentityAuth {
+			return nil, fmt.Errorf("unable to determine authentication strategy")
+		}
+
+		rawSecrets, err := client.GetRawSecrets(ctx, secretPath, envSlug, projectId)
+		if err != nil {
+			return nil, err
+		}
+
+		secrets := make([]Secret, 0, len(rawSecrets))
+		for _, secret := range rawSecrets {
+			secrets = append(secrets, Secret{
+				ID:          secret.ID,
+				Key:         secret.SecretKey,
+				Value:       secret.SecretValue,
+				Type:        secret.Type,
+				Comment:     secret.SecretComment,
+				SecretPath:  secret.SecretPath,
+				WorkspaceId: projectId,
+				Environment: envSlug,
+			})
+		}
+		return secrets, nil
+	}
+}
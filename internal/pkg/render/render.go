@@ -0,0 +1,197 @@
+package render
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const (
+	FormatDotenv   = "dotenv"
+	FormatJson     = "json"
+	FormatYaml     = "yaml"
+	FormatTemplate = "template"
+)
+
+var Formats = []string{FormatDotenv, FormatJson, FormatYaml, FormatTemplate}
+
+// Secret is the shape exposed to templates. Field names match the ones the Infisical agent
+// exposes, so agent templates can be reused as-is.
+type Secret struct {
+	ID          string
+	Key         string
+	Value       string
+	Type        string
+	Comment     string
+	SecretPath  string
+	WorkspaceId string
+	Environment string
+}
+
+// FetchSecretsFunc returns the secrets of a single folder.
+type FetchSecretsFunc func(projectId string, envSlug string, secretPath string) ([]Secret, error)
+
+// Renderer renders secrets into one of the supported formats. Folder fetches are memoized,
+// so a template that references the same folder repeatedly only fetches it once.
+type Renderer struct {
+	fetch FetchSecretsFunc
+	cache map[string][]Secret
+}
+
+func NewRenderer(fetch FetchSecretsFunc) *Renderer {
+	return &Renderer{
+		fetch: fetch,
+		cache: map[string][]Secret{},
+	}
+}
+
+// Render fetches the secrets of the given folder and renders them in the given format. For
+// the template format, tmpl is executed with those secrets as its data, and can pull in
+// other folders through the template functions.
+func (r *Renderer) Render(format string, projectId string, envSlug string, secretPath string, tmpl string) (string, error) {
+	secrets, err := r.listSecrets(projectId, envSlug, secretPath)
+	if err != nil {
+		return "", err
+	}
+
+	switch format {
+	case FormatDotenv:
+		return Dotenv(secrets), nil
+	case FormatJson:
+		return Json(secrets)
+	case FormatYaml:
+		return Yaml(secrets)
+	case FormatTemplate:
+		return r.Template(tmpl, secrets)
+	default:
+		return "", fmt.Errorf("unsupported format %q, must be one of: %s", format, strings.Join(Formats, ", "))
+	}
+}
+
+// FuncMap returns the template functions of the Infisical agent.
+func (r *Renderer) FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"secret":          r.listSecrets,
+		"listSecrets":     r.listSecrets,
+		"getSecretByName": r.getSecretByName,
+	}
+}
+
+// Template executes tmpl with the agent function set, passing secrets as its data.
+func (r *Renderer) Template(tmpl string, secrets []Secret) (string, error) {
+	parsed, err := template.New("secrets").Funcs(r.FuncMap()).Option("missingkey=error").Parse(tmpl)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse template [err=%s]", err)
+	}
+
+	var out bytes.Buffer
+	if err := parsed.Execute(&out, secrets); err != nil {
+		return "", fmt.Errorf("unable to execute template [err=%s]", err)
+	}
+
+	return out.String(), nil
+}
+
+func (r *Renderer) listSecrets(projectId string, envSlug string, secretPath string) ([]Secret, error) {
+	cacheKey := strings.Join([]string{projectId, envSlug, secretPath}, "\x00")
+	if secrets, ok := r.cache[cacheKey]; ok {
+		return secrets, nil
+	}
+
+	secrets, err := r.fetch(projectId, envSlug, secretPath)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(secrets, func(i, j int) bool {
+		return secrets[i].Key < secrets[j].Key
+	})
+
+	r.cache[cacheKey] = secrets
+	return secrets, nil
+}
+
+func (r *Renderer) getSecretByName(projectId string, envSlug string, secretPath string, secretName string) (Secret, error) {
+	secrets, err := r.listSecrets(projectId, envSlug, secretPath)
+	if err != nil {
+		return Secret{}, err
+	}
+
+	for _, secret := range secrets {
+		if secret.Key == secretName {
+			return secret, nil
+		}
+	}
+
+	return Secret{}, fmt.Errorf("secret %s not found in %s (environment %s)", secretName, secretPath, envSlug)
+}
+
+var dotenvEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`"`, `\"`,
+	`$`, `\$`,
+	"`", "\\`",
+	"\n", `\n`,
+	"\r", `\r`,
+)
+
+// Dotenv renders one KEY="value" line per secret. Values are always double quoted, with
+// backslashes, quotes, `$` and line breaks escaped, so multiline values stay on one line and
+// no variable expansion happens when the file is loaded.
+func Dotenv(secrets []Secret) string {
+	var out strings.Builder
+	for _, secret := range secrets {
+		fmt.Fprintf(&out, "%s=\"%s\"\n", secret.Key, dotenvEscaper.Replace(secret.Value))
+	}
+	return out.String()
+}
+
+// Json renders the secrets as a single JSON object of key to value.
+func Json(secrets []Secret) (string, error) {
+	values := make(map[string]string, len(secrets))
+	for _, secret := range secrets {
+		values[secret.Key] = secret.Value
+	}
+
+	var out bytes.Buffer
+	encoder := json.NewEncoder(&out)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(values); err != nil {
+		return "", err
+	}
+
+	return out.String(), nil
+}
+
+// Yaml renders the secrets as a YAML mapping. Keys and values are emitted as double-quoted
+// scalars, whose escape rules are a superset of JSON's, so no value can be reinterpreted as
+// a number, boolean, anchor or multi-document marker.
+func Yaml(secrets []Secret) (string, error) {
+	var out strings.Builder
+	for _, secret := range secrets {
+		key, err := yamlQuote(secret.Key)
+		if err != nil {
+			return "", err
+		}
+		value, err := yamlQuote(secret.Value)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(&out, "%s: %s\n", key, value)
+	}
+	return out.String(), nil
+}
+
+func yamlQuote(value string) (string, error) {
+	var out bytes.Buffer
+	encoder := json.NewEncoder(&out)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(out.String(), "\n"), nil
+}
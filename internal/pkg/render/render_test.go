@@ -0,0 +1,111 @@
+package render
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func staticFetcher(folders map[string][]Secret, calls *int) FetchSecretsFunc {
+	return func(projectId string, envSlug string, secretPath string) ([]Secret, error) {
+		*calls++
+		return append([]Secret{}, folders[projectId+"/"+envSlug+secretPath]...), nil
+	}
+}
+
+var trickySecrets = []Secret{
+	{Key: "MULTILINE", Value: "-----BEGIN KEY-----\nabc\n-----END KEY-----"},
+	{Key: "QUOTED", Value: `say "hi" to $HOME and \n`},
+	{Key: "BOOLEAN_LOOKING", Value: "yes"},
+}
+
+func TestDotenvEscapesValues(t *testing.T) {
+	got := Dotenv(trickySecrets)
+	want := `MULTILINE="-----BEGIN KEY-----\nabc\n-----END KEY-----"` + "\n" +
+		`QUOTED="say \"hi\" to \$HOME and \\n"` + "\n" +
+		`BOOLEAN_LOOKING="yes"` + "\n"
+	if got != want {
+		t.Errorf("Dotenv() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestJsonRoundTrips(t *testing.T) {
+	got, err := Json(trickySecrets)
+	if err != nil {
+		t.Fatalf("Json() error: %v", err)
+	}
+
+	var decoded map[string]string
+	if err := json.Unmarshal([]byte(got), &decoded); err != nil {
+		t.Fatalf("Json() produced invalid JSON: %v\n%s", err, got)
+	}
+	for _, secret := range trickySecrets {
+		if decoded[secret.Key] != secret.Value {
+			t.Errorf("%s = %q, want %q", secret.Key, decoded[secret.Key], secret.Value)
+		}
+	}
+}
+
+// Every scalar is double quoted so YAML cannot coerce it into another type or split it across lines.
+func TestYamlQuotesScalars(t *testing.T) {
+	got, err := Yaml(trickySecrets)
+	if err != nil {
+		t.Fatalf("Yaml() error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(got, "\n"), "\n")
+	if len(lines) != len(trickySecrets) {
+		t.Fatalf("expected one line per secret, got:\n%s", got)
+	}
+	if lines[2] != `"BOOLEAN_LOOKING": "yes"` {
+		t.Errorf("unexpected line %q", lines[2])
+	}
+}
+
+func TestRenderSortsByKey(t *testing.T) {
+	calls := 0
+	renderer := NewRenderer(staticFetcher(map[string][]Secret{
+		"p/dev/": {{Key: "B", Value: "2"}, {Key: "A", Value: "1"}},
+	}, &calls))
+
+	got, err := renderer.Render(FormatDotenv, "p", "dev", "/", "")
+	if err != nil {
+		t.Fatalf("Render() error: %v", err)
+	}
+	if got != "A=\"1\"\nB=\"2\"\n" {
+		t.Errorf("Render() = %q", got)
+	}
+}
+
+func TestTemplateUsesAgentFunctions(t *testing.T) {
+	calls := 0
+	renderer := NewRenderer(staticFetcher(map[string][]Secret{
+		"p/dev/":    {{Key: "APP", Value: "web"}},
+		"p/dev/db/": {{Key: "PASSWORD", Value: "hunter2"}, {Key: "USER", Value: "admin"}},
+	}, &calls))
+
+	tmpl := `{{ range . }}{{ .Key }}={{ .Value }};{{ end }}` +
+		`{{ range secret "p" "dev" "/db/" }}{{ .Key }};{{ end }}` +
+		`{{ with getSecretByName "p" "dev" "/db/" "PASSWORD" }}{{ .Value }}{{ end }}`
+
+	got, err := renderer.Render(FormatTemplate, "p", "dev", "/", tmpl)
+	if err != nil {
+		t.Fatalf("Render() error: %v", err)
+	}
+	if got != "APP=web;PASSWORD;USER;hunter2" {
+		t.Errorf("Render() = %q", got)
+	}
+	if calls != 2 {
+		t.Errorf("expected each folder to be fetched once, got %d fetches", calls)
+	}
+}
+
+func TestTemplateMissingSecretFails(t *testing.T) {
+	calls := 0
+	renderer := NewRenderer(staticFetcher(map[string][]Secret{}, &calls))
+
+	_, err := renderer.Render(FormatTemplate, "p", "dev", "/", `{{ (getSecretByName "p" "dev" "/" "NOPE").Value }}`)
+	if err == nil {
+		t.Fatal("expected an error for a missing secret")
+	}
+}
@@ -0,0 +1,76 @@
+package terraform
+
+import (
+	"errors"
+	"maps"
+	"strings"
+	infisicalerrors "terraform-provider-infisical/internal/errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// APIFields maps the fields of a resource's API payload, as Infisical names them in validation
+// errors (such as "syncOptions.keySchema"), to the resource's attributes.
+type APIFields map[string]path.Path
+
+// WithObject returns the fields extended with an object field of the payload, whose own fields are
+// the camelCase names of the attributes of the object attribute it is read from.
+func (f APIFields) WithObject(field string, attribute path.Path, attributes map[string]schema.Attribute) APIFields {
+	fields := maps.Clone(f)
+	if fields == nil {
+		fields = APIFields{}
+	}
+
+	fields[field] = attribute
+	for name := range attributes {
+		fields[field+"."+snakeToCamelCase(name)] = attribute.AtName(name)
+	}
+	return fields
+}
+
+// attribute returns the attribute of the most specific field containing the payload path.
+func (f APIFields) attribute(fieldPath []string) (path.Path, bool) {
+	for i := len(fieldPath); i > 0; i-- {
+		if attribute, ok := f[strings.Join(fieldPath[:i], ".")]; ok {
+			return attribute, true
+		}
+	}
+	return path.Empty(), false
+}
+
+// AddAPIError reports a failed API call. When Infisical rejected the payload, each problem found
+// with a field in fields is reported against its attribute, so Terraform points at the offending
+// configuration; the rest are reported in a single error with summary, after detail.
+func AddAPIError(diags *diag.Diagnostics, summary string, detail string, err error, fields APIFields) {
+	var apiErr *infisicalerrors.APIError
+	if !errors.As(err, &apiErr) || len(apiErr.ValidationIssues) == 0 {
+		diags.AddError(summary, detail+err.Error())
+		return
+	}
+
+	var unmapped []string
+	for _, issue := range apiErr.ValidationIssues {
+		attribute, ok := fields.attribute(issue.Path)
+		if !ok {
+			unmapped = append(unmapped, issue.String())
+			continue
+		}
+		diags.AddAttributeError(attribute, summary, issue.Message)
+	}
+
+	if len(unmapped) > 0 {
+		diags.AddError(summary, detail+strings.Join(unmapped, "; "))
+	}
+}
+
+func snakeToCamelCase(name string) string {
+	words := strings.Split(name, "_")
+	for i := 1; i < len(words); i++ {
+		if words[i] != "" {
+			words[i] = strings.ToUpper(words[i][:1]) + words[i][1:]
+		}
+	}
+	return strings.Join(words, "")
+}
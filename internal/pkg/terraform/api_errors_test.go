@@ -0,0 +1,62 @@
+package terraform
+
+import (
+	"fmt"
+	infisicalerrors "terraform-provider-infisical/internal/errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func TestAddAPIError_ReportsIssuesAgainstTheirAttributes(t *testing.T) {
+	fields := APIFields{
+		"name": path.Root("name"),
+	}.WithObject("syncOptions", path.Root("sync_options"), map[string]schema.Attribute{
+		"key_schema": schema.StringAttribute{},
+	})
+
+	err := fmt.Errorf("create: %w", &infisicalerrors.APIError{
+		StatusCode: 422,
+		ValidationIssues: []infisicalerrors.ValidationIssue{
+			{Path: []string{"syncOptions", "keySchema"}, Message: "Key schema must include {{secretKey}}"},
+			{Path: []string{"syncOptions", "unknownOption"}, Message: "Unrecognized key"},
+			{Path: []string{"metadata", "0", "key"}, Message: "Required"},
+		},
+	})
+
+	var diags diag.Diagnostics
+	AddAPIError(&diags, "Error creating secret sync", "Couldn't create secret sync, unexpected error: ", err, fields)
+
+	if len(diags) != 3 {
+		t.Fatalf("got %d diagnostics, want 3: %v", len(diags), diags)
+	}
+
+	wantPaths := []path.Path{
+		path.Root("sync_options").AtName("key_schema"),
+		path.Root("sync_options"),
+	}
+	for i, want := range wantPaths {
+		withPath, ok := diags[i].(diag.DiagnosticWithPath)
+		if !ok || !withPath.Path().Equal(want) {
+			t.Errorf("diagnostic %d is not reported against %s: %v", i, want, diags[i])
+		}
+	}
+
+	if _, ok := diags[2].(diag.DiagnosticWithPath); ok {
+		t.Errorf("the issue with an unmapped field is reported against an attribute: %v", diags[2])
+	}
+	if want := "Couldn't create secret sync, unexpected error: metadata.0.key: Required"; diags[2].Detail() != want {
+		t.Errorf("got detail %q, want %q", diags[2].Detail(), want)
+	}
+}
+
+func TestAddAPIError_ReportsOtherErrorsAsIs(t *testing.T) {
+	var diags diag.Diagnostics
+	AddAPIError(&diags, "Error creating secret sync", "Couldn't create secret sync, unexpected error: ", fmt.Errorf("connection refused"), nil)
+
+	if len(diags) != 1 || diags[0].Detail() != "Couldn't create secret sync, unexpected error: connection refused" {
+		t.Errorf("got %v, want the error as a single diagnostic", diags)
+	}
+}
@@ -0,0 +1,39 @@
+package terraform
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Drift detection modes for values Terraform cannot read back directly, such as write-only
+// secret values and app connection or external KMS credentials.
+const (
+	DriftDetectionIgnore    = "ignore"
+	DriftDetectionWarn      = "warn"
+	DriftDetectionReconcile = "reconcile"
+)
+
+var DriftDetectionModes = []string{DriftDetectionIgnore, DriftDetectionWarn, DriftDetectionReconcile}
+
+var DriftDetectionValidator = stringvalidator.OneOf(DriftDetectionModes...)
+
+// DriftDetectionDescription builds the schema description for a drift_detection attribute.
+func DriftDetectionDescription(subject string, defaultMode string) string {
+	return fmt.Sprintf(
+		"How changes made to the %s outside of Terraform are handled. Possible values are: %s. `ignore` leaves the state untouched, `warn` reports a warning on refresh, and `reconcile` plans an update that restores the configured value. Defaults to `%s`.",
+		subject,
+		strings.Join(DriftDetectionModes, ", "),
+		defaultMode,
+	)
+}
+
+// ResolveDriftDetectionMode returns the configured mode, or defaultMode when the attribute is not set.
+func ResolveDriftDetectionMode(mode types.String, defaultMode string) string {
+	if mode.IsNull() || mode.IsUnknown() || mode.ValueString() == "" {
+		return defaultMode
+	}
+	return mode.ValueString()
+}
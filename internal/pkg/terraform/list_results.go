@@ -0,0 +1,44 @@
+package terraform
+
+import (
+	"fmt"
+	infisical "terraform-provider-infisical/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// MaxResultsAttribute returns the `max_results` attribute of a list data source, which caps the
+// number of items the data source returns.
+func MaxResultsAttribute(description string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Description: description,
+		Optional:    true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+}
+
+// ListOptions returns the client list options for the value of a `max_results` attribute.
+func ListOptions(maxResults types.Int64) infisical.ListOptions {
+	if maxResults.IsNull() || maxResults.IsUnknown() {
+		return infisical.ListOptions{}
+	}
+	return infisical.ListOptions{MaxResults: int(maxResults.ValueInt64())}
+}
+
+// WarnIfTruncated reports a warning when a list was capped by its `max_results` attribute.
+func WarnIfTruncated[T any](diags *diag.Diagnostics, result infisical.ListResult[T], noun string) {
+	if !result.Truncated {
+		return
+	}
+
+	diags.AddWarning(
+		fmt.Sprintf("Not all %s were returned", noun),
+		fmt.Sprintf("Only the first %d %s were returned because of max_results, but more exist. Raise or remove max_results to return them all.", len(result.Items), noun),
+	)
+}
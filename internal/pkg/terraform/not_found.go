@@ -0,0 +1,25 @@
+package terraform
+
+import (
+	"context"
+	"errors"
+	infisical "terraform-provider-infisical/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// RemoveResourceIfNotFound removes a resource from state when err reports it no longer exists in
+// Infisical, so the next plan recreates it instead of failing the refresh. It reports whether the
+// resource was removed, in which case Read should return without further diagnostics.
+func RemoveResourceIfNotFound(ctx context.Context, err error, resp *resource.ReadResponse) bool {
+	if !errors.Is(err, infisical.ErrNotFound) {
+		return false
+	}
+
+	tflog.Warn(ctx, "Resource not found in Infisical, removing it from state", map[string]any{
+		"error": err.Error(),
+	})
+	resp.State.RemoveResource(ctx)
+	return true
+}
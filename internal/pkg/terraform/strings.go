@@ -2,10 +2,12 @@ package terraform
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
@@ -58,3 +60,22 @@ func PreserveStringIfTrimmedEqual(apiValue string, configValue types.String) str
 	}
 	return apiValue
 }
+
+// ApplyProviderDefault replaces a null value with a default configured on the provider, and
+// reports an attribute error when the value is set on neither.
+func ApplyProviderDefault(value *types.String, defaultValue string, attributePath path.Path, providerAttribute string, diagnostics *diag.Diagnostics) {
+	if !value.IsNull() {
+		return
+	}
+
+	if defaultValue == "" {
+		diagnostics.AddAttributeError(
+			attributePath,
+			"Missing required attribute",
+			fmt.Sprintf("The attribute %s must be set, either here or through %s in the provider configuration.", attributePath, providerAttribute),
+		)
+		return
+	}
+
+	*value = types.StringValue(defaultValue)
+}
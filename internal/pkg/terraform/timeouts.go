@@ -0,0 +1,91 @@
+package terraform
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DefaultTimeout bounds each operation of a long-running resource when its timeouts block
+// leaves the operation unset.
+const DefaultTimeout = 20 * time.Minute
+
+var DurationValidator = stringvalidator.RegexMatches(
+	regexp.MustCompile(`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`),
+	"must be a valid duration (example: 30s, 10m, 1h30m)",
+)
+
+// Timeouts is the model of the `timeouts` block. A nil *Timeouts (block not configured)
+// resolves every operation to its default.
+type Timeouts struct {
+	Create types.String `tfsdk:"create"`
+	Read   types.String `tfsdk:"read"`
+	Update types.String `tfsdk:"update"`
+	Delete types.String `tfsdk:"delete"`
+}
+
+// TimeoutsBlock returns the schema of the `timeouts` block.
+func TimeoutsBlock() schema.SingleNestedBlock {
+	operation := func(name string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Optional:    true,
+			Description: "How long to wait for the " + name + " operation to complete, as a duration string such as `30s` or `10m`. Defaults to `20m`.",
+			Validators:  []validator.String{DurationValidator},
+		}
+	}
+
+	return schema.SingleNestedBlock{
+		Description: "Per-operation timeouts. In-flight requests are cancelled once an operation's timeout elapses.",
+		Attributes: map[string]schema.Attribute{
+			"create": operation("create"),
+			"read":   operation("read"),
+			"update": operation("update"),
+			"delete": operation("delete"),
+		},
+	}
+}
+
+func (t *Timeouts) CreateContext(ctx context.Context, defaultTimeout time.Duration) (context.Context, context.CancelFunc) {
+	if t == nil {
+		return WithTimeout(ctx, types.StringNull(), defaultTimeout)
+	}
+	return WithTimeout(ctx, t.Create, defaultTimeout)
+}
+
+func (t *Timeouts) ReadContext(ctx context.Context, defaultTimeout time.Duration) (context.Context, context.CancelFunc) {
+	if t == nil {
+		return WithTimeout(ctx, types.StringNull(), defaultTimeout)
+	}
+	return WithTimeout(ctx, t.Read, defaultTimeout)
+}
+
+func (t *Timeouts) UpdateContext(ctx context.Context, defaultTimeout time.Duration) (context.Context, context.CancelFunc) {
+	if t == nil {
+		return WithTimeout(ctx, types.StringNull(), defaultTimeout)
+	}
+	return WithTimeout(ctx, t.Update, defaultTimeout)
+}
+
+func (t *Timeouts) DeleteContext(ctx context.Context, defaultTimeout time.Duration) (context.Context, context.CancelFunc) {
+	if t == nil {
+		return WithTimeout(ctx, types.StringNull(), defaultTimeout)
+	}
+	return WithTimeout(ctx, t.Delete, defaultTimeout)
+}
+
+// WithTimeout derives a context bounded by the configured duration, or by defaultTimeout when
+// the value is unset or not a valid duration.
+func WithTimeout(ctx context.Context, value types.String, defaultTimeout time.Duration) (context.Context, context.CancelFunc) {
+	timeout := defaultTimeout
+	if !value.IsNull() && !value.IsUnknown() {
+		if parsed, err := time.ParseDuration(value.ValueString()); err == nil && parsed > 0 {
+			timeout = parsed
+		}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
@@ -0,0 +1,200 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// ResourceTypeAttribute is the span attribute holding the type name of the resource, data
+	// source or ephemeral resource an operation applies to.
+	ResourceTypeAttribute = "terraform.resource.type"
+	// ResourceKindAttribute is the span attribute holding whether the type is a "resource", a
+	// "data_source" or an "ephemeral_resource".
+	ResourceKindAttribute = "terraform.resource.kind"
+	// OperationAttribute is the span attribute holding the operation, such as "create" or "read".
+	OperationAttribute = "terraform.operation"
+)
+
+// WrapProviderServer records a span for every operation the provider server performs against
+// the Infisical API: configuring the provider, planning, applying, reading and importing
+// resources, reading data sources, and opening, renewing and closing ephemeral resources. The
+// API calls an operation makes are recorded as its children. Schema and validation calls,
+// which never reach the API, are not traced.
+func WrapProviderServer(serverFunc func() tfprotov6.ProviderServer) func() tfprotov6.ProviderServer {
+	return func() tfprotov6.ProviderServer {
+		return &tracedProviderServer{
+			ProviderServer: serverFunc(),
+			parent:         trace.SpanContextFromContext(ContextWithParentFromEnv(context.Background())),
+		}
+	}
+}
+
+type tracedProviderServer struct {
+	tfprotov6.ProviderServer
+
+	// parent is the span the pipeline running Terraform is traced under, if any.
+	parent trace.SpanContext
+}
+
+func (s *tracedProviderServer) start(ctx context.Context, kind, typeName, operation string) (context.Context, trace.Span) {
+	if s.parent.IsValid() && !trace.SpanContextFromContext(ctx).IsValid() {
+		ctx = trace.ContextWithRemoteSpanContext(ctx, s.parent)
+	}
+
+	return otel.Tracer(TracerName).Start(ctx, fmt.Sprintf("%s %s", typeName, operation),
+		trace.WithAttributes(
+			attribute.String(ResourceKindAttribute, kind),
+			attribute.String(ResourceTypeAttribute, typeName),
+			attribute.String(OperationAttribute, operation),
+		),
+	)
+}
+
+// end records the outcome of an operation: an error returned by the server, or the first of
+// the error diagnostics it reported.
+func end(span trace.Span, diagnostics []*tfprotov6.Diagnostic, err error) {
+	defer span.End()
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return
+	}
+
+	for _, diagnostic := range diagnostics {
+		if diagnostic != nil && diagnostic.Severity == tfprotov6.DiagnosticSeverityError {
+			span.SetStatus(codes.Error, diagnostic.Summary)
+			return
+		}
+	}
+}
+
+// isNullDynamicValue reports whether v encodes a null object, as the prior state of a create
+// and the planned state of a delete do.
+func isNullDynamicValue(v *tfprotov6.DynamicValue) bool {
+	if v == nil {
+		return true
+	}
+	if len(v.MsgPack) > 0 {
+		// 0xc0 is the MessagePack encoding of nil
+		return len(v.MsgPack) == 1 && v.MsgPack[0] == 0xc0
+	}
+	return len(v.JSON) == 0 || string(v.JSON) == "null"
+}
+
+// applyOperation returns the operation an ApplyResourceChange call performs.
+func applyOperation(req *tfprotov6.ApplyResourceChangeRequest) string {
+	switch {
+	case isNullDynamicValue(req.PriorState):
+		return "create"
+	case isNullDynamicValue(req.PlannedState):
+		return "delete"
+	}
+	return "update"
+}
+
+func (s *tracedProviderServer) ConfigureProvider(ctx context.Context, req *tfprotov6.ConfigureProviderRequest) (*tfprotov6.ConfigureProviderResponse, error) {
+	ctx, span := s.start(ctx, "provider", "infisical", "configure")
+	resp, err := s.ProviderServer.ConfigureProvider(ctx, req)
+	if resp != nil {
+		end(span, resp.Diagnostics, err)
+	} else {
+		end(span, nil, err)
+	}
+	return resp, err
+}
+
+func (s *tracedProviderServer) ReadResource(ctx context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	ctx, span := s.start(ctx, "resource", req.TypeName, "read")
+	resp, err := s.ProviderServer.ReadResource(ctx, req)
+	if resp != nil {
+		end(span, resp.Diagnostics, err)
+	} else {
+		end(span, nil, err)
+	}
+	return resp, err
+}
+
+func (s *tracedProviderServer) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	ctx, span := s.start(ctx, "resource", req.TypeName, "plan")
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, req)
+	if resp != nil {
+		end(span, resp.Diagnostics, err)
+	} else {
+		end(span, nil, err)
+	}
+	return resp, err
+}
+
+func (s *tracedProviderServer) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	ctx, span := s.start(ctx, "resource", req.TypeName, applyOperation(req))
+	resp, err := s.ProviderServer.ApplyResourceChange(ctx, req)
+	if resp != nil {
+		end(span, resp.Diagnostics, err)
+	} else {
+		end(span, nil, err)
+	}
+	return resp, err
+}
+
+func (s *tracedProviderServer) ImportResourceState(ctx context.Context, req *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
+	ctx, span := s.start(ctx, "resource", req.TypeName, "import")
+	resp, err := s.ProviderServer.ImportResourceState(ctx, req)
+	if resp != nil {
+		end(span, resp.Diagnostics, err)
+	} else {
+		end(span, nil, err)
+	}
+	return resp, err
+}
+
+func (s *tracedProviderServer) ReadDataSource(ctx context.Context, req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	ctx, span := s.start(ctx, "data_source", req.TypeName, "read")
+	resp, err := s.ProviderServer.ReadDataSource(ctx, req)
+	if resp != nil {
+		end(span, resp.Diagnostics, err)
+	} else {
+		end(span, nil, err)
+	}
+	return resp, err
+}
+
+func (s *tracedProviderServer) OpenEphemeralResource(ctx context.Context, req *tfprotov6.OpenEphemeralResourceRequest) (*tfprotov6.OpenEphemeralResourceResponse, error) {
+	ctx, span := s.start(ctx, "ephemeral_resource", req.TypeName, "open")
+	resp, err := s.ProviderServer.OpenEphemeralResource(ctx, req)
+	if resp != nil {
+		end(span, resp.Diagnostics, err)
+	} else {
+		end(span, nil, err)
+	}
+	return resp, err
+}
+
+func (s *tracedProviderServer) RenewEphemeralResource(ctx context.Context, req *tfprotov6.RenewEphemeralResourceRequest) (*tfprotov6.RenewEphemeralResourceResponse, error) {
+	ctx, span := s.start(ctx, "ephemeral_resource", req.TypeName, "renew")
+	resp, err := s.ProviderServer.RenewEphemeralResource(ctx, req)
+	if resp != nil {
+		end(span, resp.Diagnostics, err)
+	} else {
+		end(span, nil, err)
+	}
+	return resp, err
+}
+
+func (s *tracedProviderServer) CloseEphemeralResource(ctx context.Context, req *tfprotov6.CloseEphemeralResourceRequest) (*tfprotov6.CloseEphemeralResourceResponse, error) {
+	ctx, span := s.start(ctx, "ephemeral_resource", req.TypeName, "close")
+	resp, err := s.ProviderServer.CloseEphemeralResource(ctx, req)
+	if resp != nil {
+		end(span, resp.Diagnostics, err)
+	} else {
+		end(span, nil, err)
+	}
+	return resp, err
+}
@@ -0,0 +1,97 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+)
+
+// ServiceName is the service the provider's spans are reported under.
+const ServiceName = "terraform-provider-infisical"
+
+// TracerName is the name of the tracer the provider's operation spans are recorded with.
+const TracerName = "terraform-provider-infisical/internal/pkg/tracing"
+
+// Enabled reports whether tracing is configured: an OTLP endpoint is set through
+// OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_EXPORTER_OTLP_TRACES_ENDPOINT and the SDK is not
+// disabled with OTEL_SDK_DISABLED.
+func Enabled() bool {
+	if strings.EqualFold(os.Getenv("OTEL_SDK_DISABLED"), "true") {
+		return false
+	}
+	return os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" || os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != ""
+}
+
+// Setup installs the global tracer provider and W3C trace context propagator when tracing is
+// enabled. The exporter is configured by the standard OTEL_EXPORTER_OTLP_* variables, with the
+// protocol taken from OTEL_EXPORTER_OTLP_TRACES_PROTOCOL or OTEL_EXPORTER_OTLP_PROTOCOL
+// ("http/protobuf" by default, or "grpc"). The returned function flushes the pending spans and
+// must be called before the provider exits; it is a no-op when tracing is disabled.
+func Setup(ctx context.Context, version string) (func(context.Context) error, error) {
+	if !Enabled() {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := newExporter(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create the OTLP trace exporter [err=%s]", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(
+		semconv.ServiceName(ServiceName),
+		semconv.ServiceVersion(version),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("unable to describe the tracing resource [err=%s]", err)
+	}
+
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	return tracerProvider.Shutdown, nil
+}
+
+func newExporter(ctx context.Context) (*otlptrace.Exporter, error) {
+	protocol := os.Getenv("OTEL_EXPORTER_OTLP_TRACES_PROTOCOL")
+	if protocol == "" {
+		protocol = os.Getenv("OTEL_EXPORTER_OTLP_PROTOCOL")
+	}
+
+	switch protocol {
+	case "", "http/protobuf":
+		return otlptracehttp.New(ctx)
+	case "grpc":
+		return otlptracegrpc.New(ctx)
+	default:
+		return nil, fmt.Errorf("unsupported OTLP protocol %q, expected http/protobuf or grpc", protocol)
+	}
+}
+
+// ContextWithParentFromEnv returns ctx carrying the trace context of the TRACEPARENT and
+// TRACESTATE environment variables, as set by CI systems that trace their pipelines, so the
+// provider's spans join the pipeline's trace.
+func ContextWithParentFromEnv(ctx context.Context) context.Context {
+	carrier := propagation.MapCarrier{}
+	if traceparent := os.Getenv("TRACEPARENT"); traceparent != "" {
+		carrier.Set("traceparent", traceparent)
+	}
+	if tracestate := os.Getenv("TRACESTATE"); tracestate != "" {
+		carrier.Set("tracestate", tracestate)
+	}
+	return propagation.TraceContext{}.Extract(ctx, carrier)
+}
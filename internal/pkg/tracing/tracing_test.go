@@ -0,0 +1,177 @@
+package tracing
+
+import (
+	"context"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"go.opentelemetry.io/otel"
+	collectortrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// collectorStub is an OTLP/HTTP collector that keeps the spans it receives.
+func collectorStub(t *testing.T) (*httptest.Server, func() []*tracepb.Span) {
+	t.Helper()
+
+	var mu sync.Mutex
+	var spans []*tracepb.Span
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/traces" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var request collectortrace.ExportTraceServiceRequest
+		if err := proto.Unmarshal(body, &request); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		mu.Lock()
+		for _, resourceSpans := range request.ResourceSpans {
+			for _, scopeSpans := range resourceSpans.ScopeSpans {
+				spans = append(spans, scopeSpans.Spans...)
+			}
+		}
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, func() []*tracepb.Span {
+		mu.Lock()
+		defer mu.Unlock()
+		return spans
+	}
+}
+
+// fakeProviderServer serves ApplyResourceChange; any other call panics on the nil interface.
+type fakeProviderServer struct {
+	tfprotov6.ProviderServer
+
+	diagnostics []*tfprotov6.Diagnostic
+}
+
+func (s fakeProviderServer) ApplyResourceChange(ctx context.Context, _ *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	_, span := otel.Tracer("test").Start(ctx, "POST /api/v3/secrets/raw/DB_URL")
+	span.End()
+	return &tfprotov6.ApplyResourceChangeResponse{Diagnostics: s.diagnostics}, nil
+}
+
+func TestSetup_ExportsOperationSpansToTheCollector(t *testing.T) {
+	collector, received := collectorStub(t)
+
+	const pipelineTraceId = "4bf92f3577b34da6a3ce929d0e0e4736"
+	const pipelineSpanId = "00f067aa0ba902b7"
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", collector.URL)
+	t.Setenv("TRACEPARENT", "00-"+pipelineTraceId+"-"+pipelineSpanId+"-01")
+
+	previousProvider, previousPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previousProvider)
+		otel.SetTextMapPropagator(previousPropagator)
+	})
+
+	shutdown, err := Setup(context.Background(), "test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	server := WrapProviderServer(func() tfprotov6.ProviderServer {
+		return fakeProviderServer{diagnostics: []*tfprotov6.Diagnostic{
+			{Severity: tfprotov6.DiagnosticSeverityError, Summary: "Error creating secret"},
+		}}
+	})()
+
+	_, err = server.ApplyResourceChange(context.Background(), &tfprotov6.ApplyResourceChangeRequest{
+		TypeName:     "infisical_secret",
+		PriorState:   &tfprotov6.DynamicValue{MsgPack: []byte{0xc0}},
+		PlannedState: &tfprotov6.DynamicValue{MsgPack: []byte{0x80}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := shutdown(context.Background()); err != nil {
+		t.Fatalf("unable to flush the spans: %s", err)
+	}
+
+	spans := map[string]*tracepb.Span{}
+	for _, span := range received() {
+		spans[span.Name] = span
+	}
+
+	operation, ok := spans["infisical_secret create"]
+	if !ok {
+		t.Fatalf("the collector did not receive the operation span, got %v", spans)
+	}
+	if got := hex.EncodeToString(operation.TraceId); got != pipelineTraceId {
+		t.Errorf("operation trace id = %s, want the pipeline's %s", got, pipelineTraceId)
+	}
+	if got := hex.EncodeToString(operation.ParentSpanId); got != pipelineSpanId {
+		t.Errorf("operation parent span id = %s, want the pipeline's %s", got, pipelineSpanId)
+	}
+	if operation.Status.GetCode() != tracepb.Status_STATUS_CODE_ERROR || operation.Status.GetMessage() != "Error creating secret" {
+		t.Errorf("operation status = %v, want the error diagnostic", operation.Status)
+	}
+
+	request, ok := spans["POST /api/v3/secrets/raw/DB_URL"]
+	if !ok {
+		t.Fatal("the collector did not receive the request span")
+	}
+	if string(request.ParentSpanId) != string(operation.SpanId) {
+		t.Error("the request span is not a child of the operation span")
+	}
+}
+
+func TestSetup_DisabledWithoutAnEndpoint(t *testing.T) {
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "")
+	t.Setenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", "")
+
+	previousProvider := otel.GetTracerProvider()
+	shutdown, err := Setup(context.Background(), "test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := shutdown(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if otel.GetTracerProvider() != previousProvider {
+		t.Error("a tracer provider was installed without an OTLP endpoint")
+	}
+}
+
+func TestApplyOperation(t *testing.T) {
+	null := &tfprotov6.DynamicValue{MsgPack: []byte{0xc0}}
+	object := &tfprotov6.DynamicValue{MsgPack: []byte{0x80}}
+
+	cases := map[string]*tfprotov6.ApplyResourceChangeRequest{
+		"create": {PriorState: null, PlannedState: object},
+		"update": {PriorState: object, PlannedState: object},
+		"delete": {PriorState: object, PlannedState: null},
+	}
+	for want, req := range cases {
+		if got := applyOperation(req); got != want {
+			t.Errorf("applyOperation() = %s, want %s", got, want)
+		}
+	}
+
+	if !isNullDynamicValue(&tfprotov6.DynamicValue{JSON: []byte("null")}) || isNullDynamicValue(&tfprotov6.DynamicValue{JSON: []byte("{}")}) {
+		t.Error("JSON encoded values are not told apart")
+	}
+}
@@ -89,7 +89,7 @@ func (d *GatewayDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	gateway, err := d.client.GetGatewayByName(data.Name.ValueString())
+	gateway, err := d.client.GetGatewayByName(ctx, data.Name.ValueString())
 	if err != nil {
 		if errors.Is(err, infisical.ErrNotFound) {
 			resp.Diagnostics.AddError(
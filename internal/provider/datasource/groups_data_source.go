@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -26,7 +27,8 @@ type GroupsDataSource struct {
 
 // ExampleDataSourceModel describes the data source data model.
 type GroupsDataSourceModel struct {
-	Groups types.List `tfsdk:"groups"`
+	MaxResults types.Int64 `tfsdk:"max_results"`
+	Groups     types.List  `tfsdk:"groups"`
 }
 
 type InfisicalGroupDetails struct {
@@ -45,6 +47,7 @@ func (d *GroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 	resp.Schema = schema.Schema{
 		Description: "Interact with Infisical groups in your organization.",
 		Attributes: map[string]schema.Attribute{
+			"max_results": infisicaltf.MaxResultsAttribute("The maximum number of groups to return. When omitted, all groups are returned. A warning is reported when more groups exist than were returned."),
 			"groups": schema.ListNestedAttribute{
 				Description: "The groups list",
 				Computed:    true,
@@ -116,17 +119,19 @@ func (d *GroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	groups, err := d.client.GetGroups()
+	groups, err := d.client.GetGroups(ctx, infisicaltf.ListOptions(data.MaxResults))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Something went wrong while fetching the groups",
 			"If the error is not clear, please get in touch at infisical.com/slack\n\n"+
 				"Infisical Client Error: "+err.Error(),
 		)
+		return
 	}
+	infisicaltf.WarnIfTruncated(&resp.Diagnostics, groups, "groups")
 
-	planGroups := make([]InfisicalGroupDetails, len(groups))
-	for i, el := range groups {
+	planGroups := make([]InfisicalGroupDetails, len(groups.Items))
+	for i, el := range groups.Items {
 		planGroups[i] = InfisicalGroupDetails{
 			ID:     types.StringValue(el.ID),
 			Name:   types.StringValue(el.Name),
@@ -146,7 +146,7 @@ func (d *IdentityDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	orgIdentity, err := d.client.GetIdentity(infisical.GetIdentityRequest{
+	orgIdentity, err := d.client.GetIdentity(ctx, infisical.GetIdentityRequest{
 		IdentityID: data.ID.ValueString(),
 	})
 	if err != nil {
@@ -105,7 +105,7 @@ func (d *IdentityDetailsDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
-	identityDetails, err := d.client.GetIdentityDetails()
+	identityDetails, err := d.client.GetIdentityDetails(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Something went wrong while fetching the identity details",
@@ -96,7 +96,7 @@ func (d *KMSKeyDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	kmsKey, err := d.client.GetKMSKey(infisical.GetKMSKeyRequest{
+	kmsKey, err := d.client.GetKMSKey(ctx, infisical.GetKMSKeyRequest{
 		KeyId: config.KeyId.ValueString(),
 	})
 
@@ -113,7 +113,7 @@ func (d *KMSKeyDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	config.EncryptionAlgorithm = types.StringValue(kmsKey.Key.EncryptionAlgorithm)
 
 	if kmsKey.Key.KeyUsage == "sign-verify" {
-		publicKeyResp, pubKeyErr := d.client.GetKMSKeyPublicKey(infisical.GetKMSKeyPublicKeyRequest{
+		publicKeyResp, pubKeyErr := d.client.GetKMSKeyPublicKey(ctx, infisical.GetKMSKeyPublicKeyRequest{
 			KeyId: kmsKey.Key.ID,
 		})
 		if pubKeyErr == nil {
@@ -126,7 +126,7 @@ func (d *KMSKeyDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 			config.PublicKey = types.StringNull()
 		}
 
-		signingAlgResp, sigAlgErr := d.client.GetKMSKeySigningAlgorithms(infisical.GetKMSKeySigningAlgorithmsRequest{
+		signingAlgResp, sigAlgErr := d.client.GetKMSKeySigningAlgorithms(ctx, infisical.GetKMSKeySigningAlgorithmsRequest{
 			KeyId: kmsKey.Key.ID,
 		})
 		if sigAlgErr == nil {
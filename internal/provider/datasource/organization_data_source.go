@@ -94,7 +94,7 @@ func (d *OrganizationDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
-	organization, err := d.client.GetOrganizationBySlug(data.Slug.ValueString())
+	organization, err := d.client.GetOrganizationBySlug(ctx, data.Slug.ValueString())
 	if err != nil {
 		if errors.Is(err, infisical.ErrNotFound) {
 			resp.Diagnostics.AddError(
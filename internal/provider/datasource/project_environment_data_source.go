@@ -99,7 +99,7 @@ func (d *ProjectEnvironmentDataSource) Read(ctx context.Context, req datasource.
 		return
 	}
 
-	environment, err := d.client.GetProjectEnvironmentBySlug(infisical.GetProjectEnvironmentBySlugRequest{
+	environment, err := d.client.GetProjectEnvironmentBySlug(ctx, infisical.GetProjectEnvironmentBySlugRequest{
 		ProjectID:       data.ProjectID.ValueString(),
 		EnvironmentSlug: data.Slug.ValueString(),
 	})
@@ -164,7 +164,7 @@ func (d *ProjectIdentityDataSource) Read(ctx context.Context, req datasource.Rea
 
 	roleObjectType := types.ObjectType{AttrTypes: projectIdentityRoleAttrTypes}
 
-	membership, err := d.client.GetProjectIdentityByID(infisical.GetProjectIdentityByIDRequest{
+	membership, err := d.client.GetProjectIdentityByID(ctx, infisical.GetProjectIdentityByIDRequest{
 		ProjectID:  data.ProjectID.ValueString(),
 		IdentityID: data.IdentityID.ValueString(),
 	})
@@ -104,7 +104,7 @@ func (d *ProjectRoleDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	role, err := d.client.GetProjectRoleBySlugV2(infisical.GetProjectRoleBySlugV2Request{
+	role, err := d.client.GetProjectRoleBySlugV2(ctx, infisical.GetProjectRoleBySlugV2Request{
 		ProjectId: data.ProjectID.ValueString(),
 		RoleSlug:  data.Slug.ValueString(),
 	})
@@ -210,7 +210,7 @@ func (d *ProjectUserDataSource) Read(ctx context.Context, req datasource.ReadReq
 
 	roleObjectType := types.ObjectType{AttrTypes: projectUserRoleAttrTypes}
 
-	projectUserDetails, err := d.client.GetProjectMembershipByUserID(infisical.GetProjectMembershipByUserIDRequest{
+	projectUserDetails, err := d.client.GetProjectMembershipByUserID(ctx, infisical.GetProjectMembershipByUserIDRequest{
 		ProjectID: data.ProjectID.ValueString(),
 		UserID:    data.UserID.ValueString(),
 	})
@@ -166,7 +166,7 @@ func (d *ProjectsDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	project, err := d.client.GetProject(infisical.GetProjectRequest{
+	project, err := d.client.GetProject(ctx, infisical.GetProjectRequest{
 		Slug: data.Slug.ValueString(),
 	})
 	if err != nil {
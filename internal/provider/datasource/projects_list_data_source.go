@@ -7,6 +7,7 @@ import (
 	"time"
 
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -28,8 +29,9 @@ type ProjectsListDataSource struct {
 
 // ProjectsListDataSourceModel describes the data source data model.
 type ProjectsListDataSourceModel struct {
-	Slugs    types.List `tfsdk:"slugs"`
-	Projects types.List `tfsdk:"projects"`
+	Slugs      types.List  `tfsdk:"slugs"`
+	MaxResults types.Int64 `tfsdk:"max_results"`
+	Projects   types.List  `tfsdk:"projects"`
 }
 
 // ProjectsListItemModel describes a single project in the list output.
@@ -93,6 +95,7 @@ func (d *ProjectsListDataSource) Schema(ctx context.Context, req datasource.Sche
 				Optional:    true,
 				ElementType: types.StringType,
 			},
+			"max_results": infisicaltf.MaxResultsAttribute("The maximum number of projects to fetch. The cap applies before the slugs filter, to the projects in the order the API lists them. When omitted, all accessible projects are fetched. A warning is reported when more projects exist than were fetched."),
 			"projects": schema.ListNestedAttribute{
 				Description: "The list of projects matching the provided slugs (or all accessible projects when no slugs are provided).",
 				Computed:    true,
@@ -219,7 +222,7 @@ func (d *ProjectsListDataSource) Read(ctx context.Context, req datasource.ReadRe
 		slugFilter[slug] = struct{}{}
 	}
 
-	projectsResponse, err := d.client.GetProjects()
+	projects, err := d.client.GetProjects(ctx, infisicaltf.ListOptions(data.MaxResults))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Something went wrong while fetching the projects",
@@ -228,11 +231,12 @@ func (d *ProjectsListDataSource) Read(ctx context.Context, req datasource.ReadRe
 		)
 		return
 	}
+	infisicaltf.WarnIfTruncated(&resp.Diagnostics, projects, "projects")
 
 	environmentObjectType := types.ObjectType{AttrTypes: projectsListEnvironmentAttrTypes}
 
-	items := make([]ProjectsListItemModel, 0, len(projectsResponse.Projects))
-	for _, project := range projectsResponse.Projects {
+	items := make([]ProjectsListItemModel, 0, len(projects.Items))
+	for _, project := range projects.Items {
 		// Skip projects that are not in the requested slugs when a filter is set.
 		if len(slugFilter) > 0 {
 			if _, ok := slugFilter[project.Slug]; !ok {
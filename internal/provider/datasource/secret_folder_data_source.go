@@ -118,7 +118,7 @@ func (d *SecretFoldersDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
-	secretFolder, err := d.client.GetSecretFolderList(infisical.ListSecretFolderRequest{
+	secretFolder, err := d.client.GetSecretFolderList(ctx, infisical.ListSecretFolderRequest{
 		ProjectID:   data.ProjectID.ValueString(),
 		Environment: data.EnvironmentSlug.ValueString(),
 		SecretPath:  data.SecretPath.ValueString(),
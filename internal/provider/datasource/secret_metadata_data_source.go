@@ -136,7 +136,7 @@ func (d *SecretMetadataDataSource) Read(ctx context.Context, req datasource.Read
 	}
 
 	viewSecretValue := false
-	result, err := d.client.GetSingleRawSecretByNameV3(infisical.GetSingleSecretByNameV3Request{
+	result, err := d.client.GetSingleRawSecretByNameV3(ctx, infisical.GetSingleSecretByNameV3Request{
 		SecretName:  data.Name.ValueString(),
 		WorkspaceId: data.ProjectID.ValueString(),
 		Environment: data.EnvironmentSlug.ValueString(),
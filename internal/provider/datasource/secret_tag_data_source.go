@@ -104,7 +104,7 @@ func (d *SecretTagsDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	secretTag, err := d.client.GetProjectTagBySlug(infisical.GetProjectTagBySlugRequest{
+	secretTag, err := d.client.GetProjectTagBySlug(ctx, infisical.GetProjectTagBySlugRequest{
 		TagSlug:   data.Slug.ValueString(),
 		ProjectID: data.ProjectID.ValueString(),
 	})
@@ -0,0 +1,155 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	infisical "terraform-provider-infisical/internal/client"
+	"terraform-provider-infisical/internal/pkg/render"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SecretsBundleDataSource{}
+
+func NewSecretsBundleDataSource() datasource.DataSource {
+	return &SecretsBundleDataSource{}
+}
+
+// SecretsBundleDataSource defines the data source implementation.
+type SecretsBundleDataSource struct {
+	client *infisical.Client
+}
+
+// SecretsBundleDataSourceModel describes the data source data model.
+type SecretsBundleDataSourceModel struct {
+	FolderPath  types.String `tfsdk:"folder_path"`
+	WorkspaceId types.String `tfsdk:"workspace_id"`
+	EnvSlug     types.String `tfsdk:"env_slug"`
+	Format      types.String `tfsdk:"format"`
+	Template    types.String `tfsdk:"template"`
+	Content     types.String `tfsdk:"content"`
+}
+
+func (d *SecretsBundleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secrets_bundle"
+}
+
+func (d *SecretsBundleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Render the secrets of a folder as a dotenv, JSON or YAML document, or through a Go template using the same functions as the Infisical agent",
+
+		Attributes: map[string]schema.Attribute{
+			"folder_path": schema.StringAttribute{
+				Description: "The path to the folder from where secrets should be fetched from. Defaults to the provider's `default_folder_path`.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"env_slug": schema.StringAttribute{
+				Description: "The environment from where secrets should be fetched from. Defaults to the provider's `default_environment`.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				Description: "The Infisical project ID (Required for Machine Identity auth, and service tokens with multiple scopes). Defaults to the provider's `default_project_id`.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"format": schema.StringAttribute{
+				Description: fmt.Sprintf("The output format. Possible values are: %s", strings.Join(render.Formats, ", ")),
+				Required:    true,
+				Validators:  []validator.String{stringvalidator.OneOf(render.Formats...)},
+			},
+			"template": schema.StringAttribute{
+				Description: "The Go text/template to render when format is `template`. The folder's secrets are passed as the template data, and the Infisical agent functions are available, for example `{{ range secret \"<project-id>\" \"<env>\" \"/path\" }}{{ .Key }}={{ .Value }}{{ end }}` and `{{ with getSecretByName \"<project-id>\" \"<env>\" \"/path\" \"NAME\" }}{{ .Value }}{{ end }}`.",
+				Optional:    true,
+			},
+			"content": schema.StringAttribute{
+				Description: "The rendered secrets",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (d *SecretsBundleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*infisical.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SecretsBundleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SecretsBundleDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	infisicaltf.ApplyProviderDefault(&data.FolderPath, d.client.Config.DefaultFolderPath, path.Root("folder_path"), "default_folder_path", &resp.Diagnostics)
+	infisicaltf.ApplyProviderDefault(&data.EnvSlug, d.client.Config.DefaultEnvironment, path.Root("env_slug"), "default_environment", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.WorkspaceId.IsNull() && d.client.Config.DefaultProjectId != "" {
+		data.WorkspaceId = types.StringValue(d.client.Config.DefaultProjectId)
+	}
+
+	if data.Format.ValueString() == render.FormatTemplate && data.Template.ValueString() == "" {
+		resp.Diagnostics.AddError(
+			"Missing template",
+			"The template attribute must be set when format is template",
+		)
+		return
+	}
+
+	content, err := render.NewRenderer(render.ClientFetcher(ctx, d.client)).Render(
+		data.Format.ValueString(),
+		data.WorkspaceId.ValueString(),
+		data.EnvSlug.ValueString(),
+		data.FolderPath.ValueString(),
+		data.Template.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Something went wrong while rendering secrets",
+			"If the error is not clear, please get in touch at infisical.com/slack\n\n"+
+				"Infisical Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	if data.WorkspaceId.IsNull() || data.WorkspaceId.IsUnknown() {
+		data.WorkspaceId = types.StringNull()
+	}
+	data.Content = types.StringValue(content)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -5,9 +5,11 @@ import (
 	"fmt"
 
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -47,18 +49,18 @@ func (d *SecretsDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 
 		Attributes: map[string]schema.Attribute{
 			"folder_path": schema.StringAttribute{
-				Description: "The path to the folder from where secrets should be fetched from",
-				Required:    true,
-				Computed:    false,
+				Description: "The path to the folder from where secrets should be fetched from. Defaults to the provider's `default_folder_path`.",
+				Optional:    true,
+				Computed:    true,
 			},
 			"env_slug": schema.StringAttribute{
-				Description: "The environment from where secrets should be fetched from",
-				Required:    true,
-				Computed:    false,
+				Description: "The environment from where secrets should be fetched from. Defaults to the provider's `default_environment`.",
+				Optional:    true,
+				Computed:    true,
 			},
 
 			"workspace_id": schema.StringAttribute{
-				Description: "The Infisical project ID (Required for Machine Identity auth, and service tokens with multiple scopes)",
+				Description: "The Infisical project ID (Required for Machine Identity auth, and service tokens with multiple scopes). Defaults to the provider's `default_project_id`.",
 				Optional:    true,
 				Computed:    true,
 			},
@@ -117,9 +119,19 @@ func (d *SecretsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
+	infisicaltf.ApplyProviderDefault(&data.FolderPath, d.client.Config.DefaultFolderPath, path.Root("folder_path"), "default_folder_path", &resp.Diagnostics)
+	infisicaltf.ApplyProviderDefault(&data.EnvSlug, d.client.Config.DefaultEnvironment, path.Root("env_slug"), "default_environment", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.WorkspaceId.IsNull() && d.client.Config.DefaultProjectId != "" {
+		data.WorkspaceId = types.StringValue(d.client.Config.DefaultProjectId)
+	}
+
 	if d.client.Config.AuthStrategy == infisical.AuthStrategy.SERVICE_TOKEN {
 
-		plainTextSecrets, _, err := d.client.GetPlainTextSecretsViaServiceToken(data.FolderPath.ValueString(), data.EnvSlug.ValueString())
+		plainTextSecrets, _, err := d.client.GetPlainTextSecretsViaServiceToken(ctx, data.FolderPath.ValueString(), data.EnvSlug.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Something went wrong while fetching secrets",
@@ -138,7 +150,7 @@ func (d *SecretsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 			data.Secrets[secret.Key] = InfisicalSecretDetails{Value: types.StringValue(secret.Value), Comment: types.StringValue(secret.Comment), SecretType: types.StringValue(secret.Type)}
 		}
 	} else if d.client.Config.IsMachineIdentityAuth {
-		secrets, err := d.client.GetRawSecrets(data.FolderPath.ValueString(), data.EnvSlug.ValueString(), data.WorkspaceId.ValueString())
+		secrets, err := d.client.GetRawSecrets(ctx, data.FolderPath.ValueString(), data.EnvSlug.ValueString(), data.WorkspaceId.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Something went wrong while fetching secrets",
@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 	infisicalDatasource "terraform-provider-infisical/internal/provider/datasource"
 	infisicalResource "terraform-provider-infisical/internal/provider/resource"
 	alertResource "terraform-provider-infisical/internal/provider/resource/alert"
@@ -17,11 +20,16 @@ import (
 	secretRotationResource "terraform-provider-infisical/internal/provider/resource/secret_rotation"
 	secretSyncResource "terraform-provider-infisical/internal/provider/resource/secret_sync"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -56,15 +64,46 @@ type infisicalProviderModel struct {
 	ClientSecret types.String `tfsdk:"client_secret"`
 
 	Auth *authModel `tfsdk:"auth"`
+
+	DefaultProjectId   types.String `tfsdk:"default_project_id"`
+	DefaultEnvironment types.String `tfsdk:"default_environment"`
+	DefaultFolderPath  types.String `tfsdk:"default_folder_path"`
+
+	MaxRetries   types.Int64  `tfsdk:"max_retries"`
+	RetryMinWait types.String `tfsdk:"retry_min_wait"`
+	RetryMaxWait types.String `tfsdk:"retry_max_wait"`
+
+	RateLimitPerSecond    types.Float64 `tfsdk:"rate_limit_per_second"`
+	RateLimitBurst        types.Int64   `tfsdk:"rate_limit_burst"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+
+	EnableReadCache types.Bool `tfsdk:"enable_read_cache"`
+
+	CACertificate         types.String `tfsdk:"ca_certificate"`
+	CACertificatePath     types.String `tfsdk:"ca_certificate_path"`
+	ClientCertificate     types.String `tfsdk:"client_certificate"`
+	ClientPrivateKey      types.String `tfsdk:"client_private_key"`
+	ClientCertificatePath types.String `tfsdk:"client_certificate_path"`
+	ClientPrivateKeyPath  types.String `tfsdk:"client_private_key_path"`
+	InsecureSkipVerify    types.Bool   `tfsdk:"insecure_skip_verify"`
+	ProxyURL              types.String `tfsdk:"proxy_url"`
+	RequestTimeout        types.String `tfsdk:"request_timeout"`
+	Headers               types.Map    `tfsdk:"headers"`
 }
 
 // authMethodToStrategy maps user-facing auth_method values to their auth strategy.
 var authMethodToStrategy = map[string]infisical.AuthStrategyType{
-	"token":      infisical.AuthStrategy.TOKEN_MACHINE_IDENTITY,
-	"universal":  infisical.AuthStrategy.UNIVERSAL_MACHINE_IDENTITY,
-	"oidc":       infisical.AuthStrategy.OIDC_MACHINE_IDENTITY,
-	"kubernetes": infisical.AuthStrategy.KUBERNETES_MACHINE_IDENTITY,
-	"aws_iam":    infisical.AuthStrategy.AWS_IAM_MACHINE_IDENTITY,
+	"token":        infisical.AuthStrategy.TOKEN_MACHINE_IDENTITY,
+	"universal":    infisical.AuthStrategy.UNIVERSAL_MACHINE_IDENTITY,
+	"oidc":         infisical.AuthStrategy.OIDC_MACHINE_IDENTITY,
+	"kubernetes":   infisical.AuthStrategy.KUBERNETES_MACHINE_IDENTITY,
+	"aws_iam":      infisical.AuthStrategy.AWS_IAM_MACHINE_IDENTITY,
+	"gcp_id_token": infisical.AuthStrategy.GCP_ID_TOKEN_MACHINE_IDENTITY,
+	"gcp_iam":      infisical.AuthStrategy.GCP_IAM_MACHINE_IDENTITY,
+	"azure":        infisical.AuthStrategy.AZURE_MACHINE_IDENTITY,
+	"jwt":          infisical.AuthStrategy.JWT_MACHINE_IDENTITY,
+	"tls_cert":     infisical.AuthStrategy.TLS_CERT_MACHINE_IDENTITY,
+	"ldap":         infisical.AuthStrategy.LDAP_MACHINE_IDENTITY,
 }
 
 type authModel struct {
@@ -74,6 +113,12 @@ type authModel struct {
 	Universal        *universalAuthModel  `tfsdk:"universal"`
 	Kubernetes       *kubernetesAuthModel `tfsdk:"kubernetes"`
 	AWS              *awsIamAuthModel     `tfsdk:"aws_iam"`
+	GcpIdToken       *gcpIdTokenAuthModel `tfsdk:"gcp_id_token"`
+	GcpIam           *gcpIamAuthModel     `tfsdk:"gcp_iam"`
+	Azure            *azureAuthModel      `tfsdk:"azure"`
+	Jwt              *jwtAuthModel        `tfsdk:"jwt"`
+	TlsCert          *tlsCertAuthModel    `tfsdk:"tls_cert"`
+	Ldap             *ldapAuthModel       `tfsdk:"ldap"`
 }
 
 type oidcAuthModel struct {
@@ -90,6 +135,43 @@ type awsIamAuthModel struct {
 	IdentityId types.String `tfsdk:"identity_id"`
 }
 
+type gcpIdTokenAuthModel struct {
+	IdentityId       types.String `tfsdk:"identity_id"`
+	MetadataEndpoint types.String `tfsdk:"metadata_endpoint"`
+}
+
+type gcpIamAuthModel struct {
+	IdentityId                types.String `tfsdk:"identity_id"`
+	ServiceAccountKeyFilePath types.String `tfsdk:"service_account_key_file_path"`
+	MetadataEndpoint          types.String `tfsdk:"metadata_endpoint"`
+}
+
+type azureAuthModel struct {
+	IdentityId       types.String `tfsdk:"identity_id"`
+	Resource         types.String `tfsdk:"resource"`
+	MetadataEndpoint types.String `tfsdk:"metadata_endpoint"`
+}
+
+type jwtAuthModel struct {
+	IdentityId types.String `tfsdk:"identity_id"`
+	Token      types.String `tfsdk:"token"`
+	TokenPath  types.String `tfsdk:"token_path"`
+}
+
+type tlsCertAuthModel struct {
+	IdentityId      types.String `tfsdk:"identity_id"`
+	Certificate     types.String `tfsdk:"certificate"`
+	PrivateKey      types.String `tfsdk:"private_key"`
+	CertificatePath types.String `tfsdk:"certificate_path"`
+	PrivateKeyPath  types.String `tfsdk:"private_key_path"`
+}
+
+type ldapAuthModel struct {
+	IdentityId types.String `tfsdk:"identity_id"`
+	Username   types.String `tfsdk:"username"`
+	Password   types.String `tfsdk:"password"`
+}
+
 type kubernetesAuthModel struct {
 	IdentityId types.String `tfsdk:"identity_id"`
 	TokenPath  types.String `tfsdk:"service_account_token_path"`
@@ -126,6 +208,95 @@ func (p *infisicalProvider) Schema(ctx context.Context, _ provider.SchemaRequest
 				Sensitive:   true,
 				Description: "(DEPRECATED, use `auth` attribute), Machine identity client secret. Used to fetch/modify secrets for a given project",
 			},
+			"default_project_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The project ID used by secrets, secret folders, secret imports and the secrets data sources and ephemeral resources when they do not set their own `workspace_id`/`project_id`.",
+			},
+			"default_environment": schema.StringAttribute{
+				Optional:    true,
+				Description: "The environment slug used by secrets, secret folders, secret imports and the secrets data sources and ephemeral resources when they do not set their own `env_slug`/`environment_slug`.",
+			},
+			"default_folder_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "The folder path used by secrets, secret folders, secret imports and the secrets data sources and ephemeral resources when they do not set their own `folder_path`.",
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional:    true,
+				Description: "How many times a request that failed transiently is retried. Rate-limited requests are retried for every method; 502, 503 and 504 responses, connection resets and timeouts only for requests that are safe to replay. Set to `0` to disable retries. Defaults to `5`.",
+				Validators:  []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"retry_min_wait": schema.StringAttribute{
+				Optional:    true,
+				Description: "The minimum time to wait before retrying a request, as a duration string such as `500ms` or `2s`. Waits grow exponentially from this value unless the API sends a `Retry-After` header. Defaults to `1s`.",
+				Validators:  []validator.String{infisicaltf.DurationValidator},
+			},
+			"retry_max_wait": schema.StringAttribute{
+				Optional:    true,
+				Description: "The maximum time to wait before retrying a request, as a duration string such as `30s` or `1m`. Defaults to `60s`.",
+				Validators:  []validator.String{infisicaltf.DurationValidator},
+			},
+			"rate_limit_per_second": schema.Float64Attribute{
+				Optional:    true,
+				Description: "The sustained number of requests per second the provider sends, shared by all resources and data sources. When the API reports that its rate limit budget is running low, the provider slows down further until the budget resets. Unset by default, which does not throttle requests.",
+				Validators:  []validator.Float64{float64validator.AtLeast(0)},
+			},
+			"rate_limit_burst": schema.Int64Attribute{
+				Optional:    true,
+				Description: "How many requests may be sent at once above `rate_limit_per_second` after a quiet period. Defaults to `rate_limit_per_second` rounded up.",
+				Validators:  []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of requests in flight at once, shared by all resources and data sources. A request keeps its slot while it is retried. Unset by default, which does not limit concurrency.",
+				Validators:  []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"enable_read_cache": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When enabled, lookups that many resources repeat within one run, such as resolving projects, environments, folders, project roles, gateways and organizations, are answered from an in-memory cache, and concurrent identical lookups are sent once. Changes the provider makes invalidate the affected entries. Changes made outside of Terraform during the run are not observed. Defaults to `false`.",
+			},
+			"ca_certificate": schema.StringAttribute{
+				Optional:    true,
+				Description: "A PEM encoded CA certificate bundle to trust, in addition to the system roots, when connecting to Infisical. Takes precedence over `ca_certificate_path`. This attribute can also be set using the `INFISICAL_CA_CERTIFICATE` environment variable",
+			},
+			"ca_certificate_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "The path to a PEM encoded CA certificate bundle to trust, in addition to the system roots, when connecting to Infisical. This attribute can also be set using the `INFISICAL_CA_CERTIFICATE_PATH` environment variable",
+			},
+			"client_certificate": schema.StringAttribute{
+				Optional:    true,
+				Description: "A PEM encoded client certificate presented to Infisical, or to a proxy in front of it, for mutual TLS. Takes precedence over `client_certificate_path`. This attribute can also be set using the `INFISICAL_CLIENT_CERTIFICATE` environment variable",
+			},
+			"client_private_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The PEM encoded private key of `client_certificate`. Takes precedence over `client_private_key_path`. This attribute can also be set using the `INFISICAL_CLIENT_PRIVATE_KEY` environment variable",
+			},
+			"client_certificate_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "The path to a PEM encoded client certificate presented to Infisical, or to a proxy in front of it, for mutual TLS. This attribute can also be set using the `INFISICAL_CLIENT_CERTIFICATE_PATH` environment variable",
+			},
+			"client_private_key_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "The path to the PEM encoded private key of the client certificate. This attribute can also be set using the `INFISICAL_CLIENT_PRIVATE_KEY_PATH` environment variable",
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip the verification of the Infisical server certificate. Only use this against test instances. This attribute can also be set using the `INFISICAL_INSECURE_SKIP_VERIFY` environment variable",
+			},
+			"proxy_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "The URL of the HTTP(S) or SOCKS5 proxy to reach Infisical through. When unset, the `HTTP_PROXY`, `HTTPS_PROXY` and `NO_PROXY` environment variables apply. This attribute can also be set using the `INFISICAL_PROXY_URL` environment variable",
+			},
+			"request_timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "How long a single request to Infisical may take, as a duration string such as `30s`. Retries get a fresh timeout. Unset by default, which leaves requests bounded only by the operation's own timeout. This attribute can also be set using the `INFISICAL_REQUEST_TIMEOUT` environment variable",
+				Validators:  []validator.String{infisicaltf.DurationValidator},
+			},
+			"headers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Static headers sent with every request to Infisical, for example the credentials of an authenticating gateway in front of it. This attribute can also be set using the `INFISICAL_HEADERS` environment variable, as comma-separated `name=value` pairs",
+			},
 			"auth": schema.SingleNestedAttribute{
 				Optional:    true,
 				Description: "The configuration values for authentication",
@@ -203,6 +374,127 @@ func (p *infisicalProvider) Schema(ctx context.Context, _ provider.SchemaRequest
 							},
 						},
 					},
+					"gcp_id_token": schema.SingleNestedAttribute{
+						Optional:    true,
+						Description: "The configuration values for GCP ID Token Auth. The identity token of the instance's service account is fetched from the GCP metadata service",
+						Attributes: map[string]schema.Attribute{
+							"identity_id": schema.StringAttribute{
+								Optional:    true,
+								Sensitive:   true,
+								Description: "Machine identity ID. This attribute can also be set using the `INFISICAL_MACHINE_IDENTITY_ID` environment variable",
+							},
+							"metadata_endpoint": schema.StringAttribute{
+								Optional:    true,
+								Description: "The base URL of the GCP metadata service. This attribute can also be set using the `INFISICAL_GCP_METADATA_ENDPOINT` environment variable. Default is `http://metadata.google.internal`.",
+							},
+						},
+					},
+					"gcp_iam": schema.SingleNestedAttribute{
+						Optional:    true,
+						Description: "The configuration values for GCP IAM Auth. Without a service account key file, the login JWT is signed as the instance's service account using credentials from the GCP metadata service",
+						Attributes: map[string]schema.Attribute{
+							"identity_id": schema.StringAttribute{
+								Optional:    true,
+								Sensitive:   true,
+								Description: "Machine identity ID. This attribute can also be set using the `INFISICAL_MACHINE_IDENTITY_ID` environment variable",
+							},
+							"service_account_key_file_path": schema.StringAttribute{
+								Optional:    true,
+								Description: "The path to a GCP service account key file to sign the login JWT with. This attribute can also be set using the `INFISICAL_GCP_IAM_SERVICE_ACCOUNT_KEY_FILE_PATH` environment variable",
+							},
+							"metadata_endpoint": schema.StringAttribute{
+								Optional:    true,
+								Description: "The base URL of the GCP metadata service. This attribute can also be set using the `INFISICAL_GCP_METADATA_ENDPOINT` environment variable. Default is `http://metadata.google.internal`.",
+							},
+						},
+					},
+					"azure": schema.SingleNestedAttribute{
+						Optional:    true,
+						Description: "The configuration values for Azure Auth. The managed identity token is fetched from the Azure instance metadata service",
+						Attributes: map[string]schema.Attribute{
+							"identity_id": schema.StringAttribute{
+								Optional:    true,
+								Sensitive:   true,
+								Description: "Machine identity ID. This attribute can also be set using the `INFISICAL_MACHINE_IDENTITY_ID` environment variable",
+							},
+							"resource": schema.StringAttribute{
+								Optional:    true,
+								Description: "The resource the managed identity token is requested for. Must match the resource configured on the identity's Azure auth method. This attribute can also be set using the `INFISICAL_AZURE_AUTH_RESOURCE` environment variable. Default is `https://management.azure.com/`.",
+							},
+							"metadata_endpoint": schema.StringAttribute{
+								Optional:    true,
+								Description: "The base URL of the Azure instance metadata service. This attribute can also be set using the `INFISICAL_AZURE_METADATA_ENDPOINT` environment variable. Default is `http://169.254.169.254`.",
+							},
+						},
+					},
+					"jwt": schema.SingleNestedAttribute{
+						Optional:    true,
+						Description: "The configuration values for JWT Auth",
+						Attributes: map[string]schema.Attribute{
+							"identity_id": schema.StringAttribute{
+								Optional:    true,
+								Sensitive:   true,
+								Description: "Machine identity ID. This attribute can also be set using the `INFISICAL_MACHINE_IDENTITY_ID` environment variable",
+							},
+							"token": schema.StringAttribute{
+								Optional:    true,
+								Sensitive:   true,
+								Description: "The JWT to log in with. This attribute can also be set using the `INFISICAL_JWT_AUTH_TOKEN` environment variable",
+							},
+							"token_path": schema.StringAttribute{
+								Optional:    true,
+								Description: "The path to a file containing the JWT to log in with, used when `token` is not set. This attribute can also be set using the `INFISICAL_JWT_AUTH_TOKEN_PATH` environment variable",
+							},
+						},
+					},
+					"tls_cert": schema.SingleNestedAttribute{
+						Optional:    true,
+						Description: "The configuration values for TLS Certificate Auth. The client certificate is presented to the login endpoint over mutual TLS",
+						Attributes: map[string]schema.Attribute{
+							"identity_id": schema.StringAttribute{
+								Optional:    true,
+								Sensitive:   true,
+								Description: "Machine identity ID. This attribute can also be set using the `INFISICAL_MACHINE_IDENTITY_ID` environment variable",
+							},
+							"certificate": schema.StringAttribute{
+								Optional:    true,
+								Description: "The PEM-encoded client certificate. This attribute can also be set using the `INFISICAL_TLS_CERT_AUTH_CERTIFICATE` environment variable",
+							},
+							"private_key": schema.StringAttribute{
+								Optional:    true,
+								Sensitive:   true,
+								Description: "The PEM-encoded private key of the client certificate. This attribute can also be set using the `INFISICAL_TLS_CERT_AUTH_PRIVATE_KEY` environment variable",
+							},
+							"certificate_path": schema.StringAttribute{
+								Optional:    true,
+								Description: "The path to the PEM-encoded client certificate, used when `certificate` is not set. This attribute can also be set using the `INFISICAL_TLS_CERT_AUTH_CERTIFICATE_PATH` environment variable",
+							},
+							"private_key_path": schema.StringAttribute{
+								Optional:    true,
+								Description: "The path to the PEM-encoded private key, used when `private_key` is not set. This attribute can also be set using the `INFISICAL_TLS_CERT_AUTH_PRIVATE_KEY_PATH` environment variable",
+							},
+						},
+					},
+					"ldap": schema.SingleNestedAttribute{
+						Optional:    true,
+						Description: "The configuration values for LDAP Auth",
+						Attributes: map[string]schema.Attribute{
+							"identity_id": schema.StringAttribute{
+								Optional:    true,
+								Sensitive:   true,
+								Description: "Machine identity ID. This attribute can also be set using the `INFISICAL_MACHINE_IDENTITY_ID` environment variable",
+							},
+							"username": schema.StringAttribute{
+								Optional:    true,
+								Description: "The LDAP username. This attribute can also be set using the `INFISICAL_LDAP_AUTH_USERNAME` environment variable",
+							},
+							"password": schema.StringAttribute{
+								Optional:    true,
+								Sensitive:   true,
+								Description: "The LDAP password. This attribute can also be set using the `INFISICAL_LDAP_AUTH_PASSWORD` environment variable",
+							},
+						},
+					},
 				},
 			},
 		},
@@ -239,6 +531,27 @@ func (p *infisicalProvider) Configure(ctx context.Context, req provider.Configur
 	serviceAccountTokenPath := os.Getenv(infisical.INFISICAL_KUBERNETES_SERVICE_ACCOUNT_TOKEN_PATH_NAME)
 	organizationSlug := os.Getenv(infisical.INFISICAL_AUTH_ORGANIZATION_SLUG_ENV_NAME)
 	authMethodName := os.Getenv(infisical.INFISICAL_AUTH_METHOD_NAME)
+	gcpServiceAccountKeyFilePath := os.Getenv(infisical.INFISICAL_GCP_IAM_SERVICE_ACCOUNT_KEY_FILE_PATH_NAME)
+	gcpMetadataEndpoint := os.Getenv(infisical.INFISICAL_GCP_METADATA_ENDPOINT_NAME)
+	azureResource := os.Getenv(infisical.INFISICAL_AZURE_AUTH_RESOURCE_NAME)
+	azureMetadataEndpoint := os.Getenv(infisical.INFISICAL_AZURE_METADATA_ENDPOINT_NAME)
+	jwtAuthToken := os.Getenv(infisical.INFISICAL_JWT_AUTH_TOKEN_NAME)
+	jwtAuthTokenPath := os.Getenv(infisical.INFISICAL_JWT_AUTH_TOKEN_PATH_NAME)
+	tlsCertAuthCertificate := os.Getenv(infisical.INFISICAL_TLS_CERT_AUTH_CERTIFICATE_NAME)
+	tlsCertAuthPrivateKey := os.Getenv(infisical.INFISICAL_TLS_CERT_AUTH_PRIVATE_KEY_NAME)
+	tlsCertAuthCertificatePath := os.Getenv(infisical.INFISICAL_TLS_CERT_AUTH_CERTIFICATE_PATH_NAME)
+	tlsCertAuthPrivateKeyPath := os.Getenv(infisical.INFISICAL_TLS_CERT_AUTH_PRIVATE_KEY_PATH_NAME)
+	ldapUsername := os.Getenv(infisical.INFISICAL_LDAP_AUTH_USERNAME_NAME)
+	ldapPassword := os.Getenv(infisical.INFISICAL_LDAP_AUTH_PASSWORD_NAME)
+
+	// Connection
+	caCertificate := os.Getenv(infisical.INFISICAL_CA_CERTIFICATE_NAME)
+	caCertificatePath := os.Getenv(infisical.INFISICAL_CA_CERTIFICATE_PATH_NAME)
+	clientCertificate := os.Getenv(infisical.INFISICAL_CLIENT_CERTIFICATE_NAME)
+	clientPrivateKey := os.Getenv(infisical.INFISICAL_CLIENT_PRIVATE_KEY_NAME)
+	clientCertificatePath := os.Getenv(infisical.INFISICAL_CLIENT_CERTIFICATE_PATH_NAME)
+	clientPrivateKeyPath := os.Getenv(infisical.INFISICAL_CLIENT_PRIVATE_KEY_PATH_NAME)
+	proxyURL := os.Getenv(infisical.INFISICAL_PROXY_URL_NAME)
 
 	if !config.Host.IsNull() {
 		host = config.Host.ValueString()
@@ -256,11 +569,91 @@ func (p *infisicalProvider) Configure(ctx context.Context, req provider.Configur
 		clientSecret = config.ClientSecret.ValueString()
 	}
 
+	overrideString := func(value types.String, target *string) {
+		if !value.IsNull() {
+			*target = value.ValueString()
+		}
+	}
+	overrideString(config.CACertificate, &caCertificate)
+	overrideString(config.CACertificatePath, &caCertificatePath)
+	overrideString(config.ClientCertificate, &clientCertificate)
+	overrideString(config.ClientPrivateKey, &clientPrivateKey)
+	overrideString(config.ClientCertificatePath, &clientCertificatePath)
+	overrideString(config.ClientPrivateKeyPath, &clientPrivateKeyPath)
+	overrideString(config.ProxyURL, &proxyURL)
+
+	insecureSkipVerify := false
+	if !config.InsecureSkipVerify.IsNull() {
+		insecureSkipVerify = config.InsecureSkipVerify.ValueBool()
+	} else if envValue := os.Getenv(infisical.INFISICAL_INSECURE_SKIP_VERIFY_NAME); envValue != "" {
+		parsed, err := strconv.ParseBool(envValue)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid environment variable", fmt.Sprintf("%s must be a boolean, got %q.", infisical.INFISICAL_INSECURE_SKIP_VERIFY_NAME, envValue))
+		}
+		insecureSkipVerify = parsed
+	}
+
+	var requestTimeout time.Duration
+	if !config.RequestTimeout.IsNull() {
+		requestTimeout = parseProviderDuration(config.RequestTimeout, path.Root("request_timeout"), &resp.Diagnostics)
+	} else if envValue := os.Getenv(infisical.INFISICAL_REQUEST_TIMEOUT_NAME); envValue != "" {
+		parsed, err := time.ParseDuration(envValue)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid environment variable", fmt.Sprintf("%s must be a duration such as 30s, got %q.", infisical.INFISICAL_REQUEST_TIMEOUT_NAME, envValue))
+		}
+		requestTimeout = parsed
+	}
+
+	headers := map[string]string{}
+	if !config.Headers.IsNull() {
+		resp.Diagnostics.Append(config.Headers.ElementsAs(ctx, &headers, false)...)
+	} else if envValue := os.Getenv(infisical.INFISICAL_HEADERS_NAME); envValue != "" {
+		parsed, err := parseHeaderPairs(envValue)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid environment variable", fmt.Sprintf("%s must hold comma-separated name=value pairs: %s", infisical.INFISICAL_HEADERS_NAME, err))
+		}
+		headers = parsed
+	}
+
 	// set default to cloud infisical if host is empty
 	if host == "" {
 		host = "https://app.infisical.com"
 	}
 
+	retryPolicy := infisical.DefaultRetryPolicy
+	if !config.MaxRetries.IsNull() {
+		retryPolicy.MaxRetries = int(config.MaxRetries.ValueInt64())
+	}
+	if !config.RetryMinWait.IsNull() {
+		retryPolicy.MinWait = parseProviderDuration(config.RetryMinWait, path.Root("retry_min_wait"), &resp.Diagnostics)
+	}
+	if !config.RetryMaxWait.IsNull() {
+		retryPolicy.MaxWait = parseProviderDuration(config.RetryMaxWait, path.Root("retry_max_wait"), &resp.Diagnostics)
+	}
+	if retryPolicy.MinWait > retryPolicy.MaxWait {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_min_wait"),
+			"Invalid retry wait",
+			fmt.Sprintf("retry_min_wait (%s) must not be greater than retry_max_wait (%s).", retryPolicy.MinWait, retryPolicy.MaxWait),
+		)
+	}
+
+	var rateLimitPolicy *infisical.RateLimitPolicy
+	if !config.RateLimitPerSecond.IsNull() || !config.MaxConcurrentRequests.IsNull() {
+		rateLimitPolicy = &infisical.RateLimitPolicy{
+			RequestsPerSecond:     config.RateLimitPerSecond.ValueFloat64(),
+			Burst:                 int(config.RateLimitBurst.ValueInt64()),
+			MaxConcurrentRequests: int(config.MaxConcurrentRequests.ValueInt64()),
+		}
+	}
+	if !config.RateLimitBurst.IsNull() && config.RateLimitPerSecond.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rate_limit_burst"),
+			"Missing rate limit",
+			"rate_limit_burst only applies together with rate_limit_per_second.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -307,6 +700,88 @@ func (p *infisicalProvider) Configure(ctx context.Context, req provider.Configur
 			if !config.Auth.AWS.IdentityId.IsNull() {
 				identityId = config.Auth.AWS.IdentityId.ValueString()
 			}
+		} else if config.Auth.GcpIdToken != nil {
+			authStrategy = infisical.AuthStrategy.GCP_ID_TOKEN_MACHINE_IDENTITY
+			if !config.Auth.GcpIdToken.IdentityId.IsNull() {
+				identityId = config.Auth.GcpIdToken.IdentityId.ValueString()
+			}
+
+			if !config.Auth.GcpIdToken.MetadataEndpoint.IsNull() {
+				gcpMetadataEndpoint = config.Auth.GcpIdToken.MetadataEndpoint.ValueString()
+			}
+		} else if config.Auth.GcpIam != nil {
+			authStrategy = infisical.AuthStrategy.GCP_IAM_MACHINE_IDENTITY
+			if !config.Auth.GcpIam.IdentityId.IsNull() {
+				identityId = config.Auth.GcpIam.IdentityId.ValueString()
+			}
+
+			if !config.Auth.GcpIam.ServiceAccountKeyFilePath.IsNull() {
+				gcpServiceAccountKeyFilePath = config.Auth.GcpIam.ServiceAccountKeyFilePath.ValueString()
+			}
+
+			if !config.Auth.GcpIam.MetadataEndpoint.IsNull() {
+				gcpMetadataEndpoint = config.Auth.GcpIam.MetadataEndpoint.ValueString()
+			}
+		} else if config.Auth.Azure != nil {
+			authStrategy = infisical.AuthStrategy.AZURE_MACHINE_IDENTITY
+			if !config.Auth.Azure.IdentityId.IsNull() {
+				identityId = config.Auth.Azure.IdentityId.ValueString()
+			}
+
+			if !config.Auth.Azure.Resource.IsNull() {
+				azureResource = config.Auth.Azure.Resource.ValueString()
+			}
+
+			if !config.Auth.Azure.MetadataEndpoint.IsNull() {
+				azureMetadataEndpoint = config.Auth.Azure.MetadataEndpoint.ValueString()
+			}
+		} else if config.Auth.Jwt != nil {
+			authStrategy = infisical.AuthStrategy.JWT_MACHINE_IDENTITY
+			if !config.Auth.Jwt.IdentityId.IsNull() {
+				identityId = config.Auth.Jwt.IdentityId.ValueString()
+			}
+
+			if !config.Auth.Jwt.Token.IsNull() {
+				jwtAuthToken = config.Auth.Jwt.Token.ValueString()
+			}
+
+			if !config.Auth.Jwt.TokenPath.IsNull() {
+				jwtAuthTokenPath = config.Auth.Jwt.TokenPath.ValueString()
+			}
+		} else if config.Auth.TlsCert != nil {
+			authStrategy = infisical.AuthStrategy.TLS_CERT_MACHINE_IDENTITY
+			if !config.Auth.TlsCert.IdentityId.IsNull() {
+				identityId = config.Auth.TlsCert.IdentityId.ValueString()
+			}
+
+			if !config.Auth.TlsCert.Certificate.IsNull() {
+				tlsCertAuthCertificate = config.Auth.TlsCert.Certificate.ValueString()
+			}
+
+			if !config.Auth.TlsCert.PrivateKey.IsNull() {
+				tlsCertAuthPrivateKey = config.Auth.TlsCert.PrivateKey.ValueString()
+			}
+
+			if !config.Auth.TlsCert.CertificatePath.IsNull() {
+				tlsCertAuthCertificatePath = config.Auth.TlsCert.CertificatePath.ValueString()
+			}
+
+			if !config.Auth.TlsCert.PrivateKeyPath.IsNull() {
+				tlsCertAuthPrivateKeyPath = config.Auth.TlsCert.PrivateKeyPath.ValueString()
+			}
+		} else if config.Auth.Ldap != nil {
+			authStrategy = infisical.AuthStrategy.LDAP_MACHINE_IDENTITY
+			if !config.Auth.Ldap.IdentityId.IsNull() {
+				identityId = config.Auth.Ldap.IdentityId.ValueString()
+			}
+
+			if !config.Auth.Ldap.Username.IsNull() {
+				ldapUsername = config.Auth.Ldap.Username.ValueString()
+			}
+
+			if !config.Auth.Ldap.Password.IsNull() {
+				ldapPassword = config.Auth.Ldap.Password.ValueString()
+			}
 		} else if config.Auth.Token.ValueString() != "" {
 			authStrategy = infisical.AuthStrategy.TOKEN_MACHINE_IDENTITY
 			token = config.Auth.Token.ValueString()
@@ -319,7 +794,7 @@ func (p *infisicalProvider) Configure(ctx context.Context, req provider.Configur
 			if !ok {
 				resp.Diagnostics.AddError(
 					"Invalid auth method",
-					fmt.Sprintf("%q is not a valid authentication method. Valid values are: token, universal, oidc, kubernetes, aws_iam.", authMethodName),
+					fmt.Sprintf("%q is not a valid authentication method. Valid values are: token, universal, oidc, kubernetes, aws_iam, gcp_id_token, gcp_iam, azure, jwt, tls_cert, ldap.", authMethodName),
 				)
 				return
 			}
@@ -337,18 +812,46 @@ func (p *infisicalProvider) Configure(ctx context.Context, req provider.Configur
 
 	}
 
-	client, err := infisical.NewClient(infisical.Config{
-		HostURL:                 host,
-		AuthStrategy:            authStrategy,
-		ServiceToken:            serviceToken,
-		ClientId:                clientId,
-		ClientSecret:            clientSecret,
-		IdentityId:              identityId,
-		OidcTokenEnvName:        oidcTokenEnvName,
-		Token:                   token,
-		ServiceAccountToken:     serviceAccountToken,
-		ServiceAccountTokenPath: serviceAccountTokenPath,
-		OrganizationSlug:        organizationSlug,
+	client, err := infisical.NewClient(ctx, infisical.Config{
+		HostURL:                      host,
+		AuthStrategy:                 authStrategy,
+		ServiceToken:                 serviceToken,
+		ClientId:                     clientId,
+		ClientSecret:                 clientSecret,
+		IdentityId:                   identityId,
+		OidcTokenEnvName:             oidcTokenEnvName,
+		Token:                        token,
+		ServiceAccountToken:          serviceAccountToken,
+		ServiceAccountTokenPath:      serviceAccountTokenPath,
+		OrganizationSlug:             organizationSlug,
+		GcpServiceAccountKeyFilePath: gcpServiceAccountKeyFilePath,
+		GcpMetadataEndpoint:          gcpMetadataEndpoint,
+		AzureResource:                azureResource,
+		AzureMetadataEndpoint:        azureMetadataEndpoint,
+		JwtAuthToken:                 jwtAuthToken,
+		JwtAuthTokenPath:             jwtAuthTokenPath,
+		TlsCertAuthCertificate:       tlsCertAuthCertificate,
+		TlsCertAuthPrivateKey:        tlsCertAuthPrivateKey,
+		TlsCertAuthCertificatePath:   tlsCertAuthCertificatePath,
+		TlsCertAuthPrivateKeyPath:    tlsCertAuthPrivateKeyPath,
+		LdapUsername:                 ldapUsername,
+		LdapPassword:                 ldapPassword,
+		DefaultProjectId:             config.DefaultProjectId.ValueString(),
+		DefaultEnvironment:           config.DefaultEnvironment.ValueString(),
+		DefaultFolderPath:            config.DefaultFolderPath.ValueString(),
+		RetryPolicy:                  &retryPolicy,
+		RateLimitPolicy:              rateLimitPolicy,
+		EnableReadCache:              config.EnableReadCache.ValueBool(),
+		CACertificate:                caCertificate,
+		CACertificatePath:            caCertificatePath,
+		ClientCertificate:            clientCertificate,
+		ClientPrivateKey:             clientPrivateKey,
+		ClientCertificatePath:        clientCertificatePath,
+		ClientPrivateKeyPath:         clientPrivateKeyPath,
+		InsecureSkipVerify:           insecureSkipVerify,
+		ProxyURL:                     proxyURL,
+		RequestTimeout:               requestTimeout,
+		Headers:                      headers,
 	})
 
 	if err != nil {
@@ -368,10 +871,37 @@ func (p *infisicalProvider) Configure(ctx context.Context, req provider.Configur
 
 }
 
+// parseProviderDuration parses a duration attribute the schema has already validated.
+func parseProviderDuration(value types.String, attributePath path.Path, diags *diag.Diagnostics) time.Duration {
+	duration, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(attributePath, "Invalid duration", fmt.Sprintf("%q is not a valid duration: %s", value.ValueString(), err))
+	}
+	return duration
+}
+
+// parseHeaderPairs parses comma-separated name=value pairs, such as "X-Gateway-Token=abc,X-Team=infra".
+func parseHeaderPairs(value string) (map[string]string, error) {
+	headers := map[string]string{}
+	for _, pair := range strings.Split(value, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		name, headerValue, found := strings.Cut(pair, "=")
+		name = strings.TrimSpace(name)
+		if !found || name == "" {
+			return nil, fmt.Errorf("%q is not a name=value pair", pair)
+		}
+		headers[name] = strings.TrimSpace(headerValue)
+	}
+	return headers, nil
+}
+
 // DataSources defines the data sources implemented in the provider.
 func (p *infisicalProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		infisicalDatasource.NewSecretDataSource,
+		infisicalDatasource.NewSecretsBundleDataSource,
 		infisicalDatasource.NewProjectDataSource,
 		infisicalDatasource.NewProjectsListDataSource,
 		infisicalDatasource.NewSecretTagDataSource,
@@ -452,6 +982,15 @@ func (p *infisicalProvider) Resources(_ context.Context) []func() resource.Resou
 		appConnectionResource.NewAppConnectionHashicorpVaultResource,
 		appConnectionResource.NewAppConnectionDatadogResource,
 		appConnectionResource.NewAppConnectionCircleCIResource,
+		appConnectionResource.NewAppConnectionVercelResource,
+		appConnectionResource.NewAppConnectionNetlifyResource,
+		appConnectionResource.NewAppConnectionHerokuResource,
+		appConnectionResource.NewAppConnectionTerraformCloudResource,
+		appConnectionResource.NewAppConnectionKubernetesResource,
+		appConnectionResource.NewAppConnectionAuth0Resource,
+		appConnectionResource.NewAppConnectionOktaResource,
+		appConnectionResource.NewAppConnectionMongoDbResource,
+		appConnectionResource.NewAppConnectionRedisResource,
 		secretSyncResource.NewSecretSyncGcpSecretManagerResource,
 		secretSyncResource.NewSecretSyncAzureAppConfigurationResource,
 		secretSyncResource.NewSecretSyncAzureKeyVaultResource,
@@ -469,6 +1008,12 @@ func (p *infisicalProvider) Resources(_ context.Context) []func() resource.Resou
 		secretSyncResource.NewSecretSyncFlyioResource,
 		secretSyncResource.NewSecretSyncGitlabResource,
 		secretSyncResource.NewSecretSyncCircleCIResource,
+		secretSyncResource.NewSecretSyncHashicorpVaultResource,
+		secretSyncResource.NewSecretSyncVercelResource,
+		secretSyncResource.NewSecretSyncNetlifyResource,
+		secretSyncResource.NewSecretSyncHerokuResource,
+		secretSyncResource.NewSecretSyncTerraformCloudResource,
+		secretSyncResource.NewSecretSyncKubernetesResource,
 		certificateSyncResource.NewCertificateSyncAwsCertificateManagerResource,
 		certificateSyncResource.NewCertificateSyncCertificateResource,
 		dynamicSecretResource.NewDynamicSecretSqlDatabaseResource,
@@ -476,6 +1021,16 @@ func (p *infisicalProvider) Resources(_ context.Context) []func() resource.Resou
 		dynamicSecretResource.NewDynamicSecretKubernetesResource,
 		dynamicSecretResource.NewDynamicSecretMongoAtlasResource,
 		dynamicSecretResource.NewDynamicSecretMongoDbResource,
+		dynamicSecretResource.NewDynamicSecretRedisResource,
+		dynamicSecretResource.NewDynamicSecretCassandraResource,
+		dynamicSecretResource.NewDynamicSecretElasticSearchResource,
+		dynamicSecretResource.NewDynamicSecretRabbitMqResource,
+		dynamicSecretResource.NewDynamicSecretSnowflakeResource,
+		dynamicSecretResource.NewDynamicSecretSapHanaResource,
+		dynamicSecretResource.NewDynamicSecretAzureEntraIdResource,
+		dynamicSecretResource.NewDynamicSecretGcpIamResource,
+		dynamicSecretResource.NewDynamicSecretLdapResource,
+		dynamicSecretResource.NewDynamicSecretTotpResource,
 		secretRotationResource.NewSecretRotationMySqlCredentialsResource,
 		secretRotationResource.NewSecretRotationMsSqlCredentialsResource,
 		secretRotationResource.NewSecretRotationPostgresCredentialsResource,
@@ -483,6 +1038,11 @@ func (p *infisicalProvider) Resources(_ context.Context) []func() resource.Resou
 		secretRotationResource.NewSecretRotationAzureClientSecretResource,
 		secretRotationResource.NewSecretRotationAwsIamUserSecretResource,
 		secretRotationResource.NewSecretRotationLdapPasswordResource,
+		secretRotationResource.NewSecretRotationMongoDbCredentialsResource,
+		secretRotationResource.NewSecretRotationRedisCredentialsResource,
+		secretRotationResource.NewSecretRotationAuth0ClientSecretResource,
+		secretRotationResource.NewSecretRotationOktaClientSecretResource,
+		secretRotationResource.NewSecretRotationDatabricksServicePrincipalSecretResource,
 		infisicalResource.NewProjectTemplateResource,
 		infisicalResource.NewKMSKeyResource,
 		infisicalResource.NewCertManagerInternalCAResource,
@@ -511,5 +1071,8 @@ func (p *infisicalProvider) EphemeralResources(_ context.Context) []func() ephem
 		func() ephemeral.EphemeralResource {
 			return infisicalResource.NewEphemeralSecretResource()
 		},
+		func() ephemeral.EphemeralResource {
+			return infisicalResource.NewEphemeralSecretsBundleResource()
+		},
 	}
 }
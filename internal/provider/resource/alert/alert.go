@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	infisical "terraform-provider-infisical/internal/client"
 
@@ -297,7 +298,7 @@ func (r *alertResource) Create(ctx context.Context, req resource.CreateRequest,
 		createRequest.ProjectID = &projectID
 	}
 
-	alert, err := r.client.CreateAlert(createRequest)
+	alert, err := r.client.CreateAlert(ctx, createRequest)
 	if err != nil {
 		var alreadyExists *infisical.AlertAlreadyExistsError
 		if errors.As(err, &alreadyExists) {
@@ -338,12 +339,11 @@ func (r *alertResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	alert, err := r.client.GetAlertByID(infisical.GetAlertByIDRequest{
+	alert, err := r.client.GetAlertByID(ctx, infisical.GetAlertByIDRequest{
 		ID: state.ID.ValueString(),
 	})
 	if err != nil {
-		if errors.Is(err, infisical.ErrNotFound) {
-			resp.State.RemoveResource(ctx)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
 		resp.Diagnostics.AddError(
@@ -395,7 +395,7 @@ func (r *alertResource) Update(ctx context.Context, req resource.UpdateRequest,
 		updateRequest.Description = &description
 	}
 
-	alert, err := r.client.UpdateAlert(updateRequest)
+	alert, err := r.client.UpdateAlert(ctx, updateRequest)
 	if err != nil {
 		if errors.Is(err, infisical.ErrNotFound) {
 			resp.Diagnostics.AddError(
@@ -429,7 +429,7 @@ func (r *alertResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	_, err := r.client.DeleteAlert(infisical.DeleteAlertRequest{
+	_, err := r.client.DeleteAlert(ctx, infisical.DeleteAlertRequest{
 		ID: state.ID.ValueString(),
 	})
 	if err != nil && !errors.Is(err, infisical.ErrNotFound) {
@@ -0,0 +1,10 @@
+package resource
+
+import (
+	"terraform-provider-infisical/internal/provider/resource/resourcetest"
+	"testing"
+)
+
+func TestReadRemovesAlertsDeletedOutsideOfTerraform(t *testing.T) {
+	resourcetest.AssertReadRemovesWhenNotFound(t, NewAlertResource, map[string]any{"id": "alert-id"})
+}
@@ -0,0 +1,149 @@
+package resource
+
+import (
+	"context"
+	infisical "terraform-provider-infisical/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type AppConnectionAuth0CredentialsModel struct {
+	Domain       types.String `tfsdk:"domain"`
+	ClientId     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	Audience     types.String `tfsdk:"audience"`
+}
+
+const AppConnectionAuth0AuthMethodClientCredentials = "client-credentials"
+
+func NewAppConnectionAuth0Resource() resource.Resource {
+	return &AppConnectionBaseResource{
+		App:               infisical.AppConnectionAppAuth0,
+		AppConnectionName: "Auth0",
+		ResourceTypeName:  "_app_connection_auth0",
+		AllowedMethods:    []string{AppConnectionAuth0AuthMethodClientCredentials},
+		CredentialsAttributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				Required:    true,
+				Description: "The domain of the Auth0 tenant, e.g. `example.us.auth0.com`.",
+			},
+			"client_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The client ID of the machine-to-machine application Infisical authenticates as.",
+			},
+			"client_secret": schema.StringAttribute{
+				Required:    true,
+				Description: "The client secret of the machine-to-machine application Infisical authenticates as.",
+				Sensitive:   true,
+			},
+			"audience": schema.StringAttribute{
+				Required:    true,
+				Description: "The audience of the Auth0 Management API, e.g. `https://example.us.auth0.com/api/v2/`.",
+			},
+		},
+		ReadCredentialsForCreateFromPlan: func(ctx context.Context, plan AppConnectionBaseResourceModel) (map[string]any, diag.Diagnostics) {
+			credentialsConfig := make(map[string]any)
+
+			var credentials AppConnectionAuth0CredentialsModel
+			diags := plan.Credentials.As(ctx, &credentials, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			if plan.Method.ValueString() != AppConnectionAuth0AuthMethodClientCredentials {
+				diags.AddError(
+					"Unable to create Auth0 app connection",
+					"Invalid method. Only client-credentials method is supported",
+				)
+				return nil, diags
+			}
+
+			credentialsConfig["domain"] = credentials.Domain.ValueString()
+			credentialsConfig["clientId"] = credentials.ClientId.ValueString()
+			credentialsConfig["clientSecret"] = credentials.ClientSecret.ValueString()
+			credentialsConfig["audience"] = credentials.Audience.ValueString()
+
+			return credentialsConfig, diags
+		},
+		ReadCredentialsForUpdateFromPlan: func(ctx context.Context, plan AppConnectionBaseResourceModel, state AppConnectionBaseResourceModel) (map[string]any, diag.Diagnostics) {
+			credentialsConfig := make(map[string]any)
+
+			var credentialsFromPlan AppConnectionAuth0CredentialsModel
+			diags := plan.Credentials.As(ctx, &credentialsFromPlan, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			var credentialsFromState AppConnectionAuth0CredentialsModel
+			diags = state.Credentials.As(ctx, &credentialsFromState, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			if plan.Method.ValueString() != AppConnectionAuth0AuthMethodClientCredentials {
+				diags.AddError(
+					"Unable to update Auth0 app connection",
+					"Invalid method. Only client-credentials method is supported",
+				)
+				return nil, diags
+			}
+
+			domain := credentialsFromPlan.Domain
+			if credentialsFromPlan.Domain.IsUnknown() {
+				domain = credentialsFromState.Domain
+			}
+			if !domain.IsNull() {
+				credentialsConfig["domain"] = domain.ValueString()
+			}
+
+			clientId := credentialsFromPlan.ClientId
+			if credentialsFromPlan.ClientId.IsUnknown() {
+				clientId = credentialsFromState.ClientId
+			}
+			if !clientId.IsNull() {
+				credentialsConfig["clientId"] = clientId.ValueString()
+			}
+
+			clientSecret := credentialsFromPlan.ClientSecret
+			if credentialsFromPlan.ClientSecret.IsUnknown() {
+				clientSecret = credentialsFromState.ClientSecret
+			}
+			if !clientSecret.IsNull() {
+				credentialsConfig["clientSecret"] = clientSecret.ValueString()
+			}
+
+			audience := credentialsFromPlan.Audience
+			if credentialsFromPlan.Audience.IsUnknown() {
+				audience = credentialsFromState.Audience
+			}
+			if !audience.IsNull() {
+				credentialsConfig["audience"] = audience.ValueString()
+			}
+
+			return credentialsConfig, diags
+		},
+		OverwriteCredentialsFields: func(state *AppConnectionBaseResourceModel) diag.Diagnostics {
+			credentialsConfig := map[string]attr.Value{
+				"domain":        types.StringNull(),
+				"client_id":     types.StringNull(),
+				"client_secret": types.StringNull(),
+				"audience":      types.StringNull(),
+			}
+
+			var diags diag.Diagnostics
+			state.Credentials, diags = types.ObjectValue(map[string]attr.Type{
+				"domain":        types.StringType,
+				"client_id":     types.StringType,
+				"client_secret": types.StringType,
+				"audience":      types.StringType,
+			}, credentialsConfig)
+
+			return diags
+		},
+	}
+}
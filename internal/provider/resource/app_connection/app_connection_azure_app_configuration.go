@@ -20,27 +20,32 @@ type AppConnectionAzureAppConfigurationCredentialsModel struct {
 }
 
 const AzureAppConfigurationAppConnectionClientSecretsMethod = "client-secret"
+const AzureAppConfigurationAppConnectionFederatedCredentialMethod = "federated-credential"
 
 func NewAppConnectionAzureAppConfigurationResource() resource.Resource {
 	return &AppConnectionBaseResource{
 		App:               infisical.AppConnectionAppAzureAppConfiguration,
 		AppConnectionName: "Azure App Configuration",
 		ResourceTypeName:  "_app_connection_azure_app_configuration",
-		AllowedMethods:    []string{AzureAppConfigurationAppConnectionClientSecretsMethod},
+		AllowedMethods:    []string{AzureAppConfigurationAppConnectionClientSecretsMethod, AzureAppConfigurationAppConnectionFederatedCredentialMethod},
+		MethodCredentials: map[string]AppConnectionMethodCredentials{
+			AzureAppConfigurationAppConnectionClientSecretsMethod:       {Required: []string{"tenant_id", "client_id", "client_secret"}},
+			AzureAppConfigurationAppConnectionFederatedCredentialMethod: {Required: []string{"tenant_id", "client_id"}, Unused: []string{"client_secret"}},
+		},
 		CredentialsAttributes: map[string]schema.Attribute{
 			"tenant_id": schema.StringAttribute{
 				Required:    true,
-				Description: "The Azure Active Directory (AAD) tenant ID. Required for client-secret method. For more details, refer to the documentation here infisical.com/docs/integrations/app-connections/azure-app-configuration",
+				Description: "The Azure Active Directory (AAD) tenant ID. For more details, refer to the documentation here infisical.com/docs/integrations/app-connections/azure-app-configuration",
 				Sensitive:   false,
 			},
 			"client_id": schema.StringAttribute{
 				Required:    true,
-				Description: "The Azure application (client) ID. Required for client-secret method. For more details, refer to the documentation here infisical.com/docs/integrations/app-connections/azure-app-configuration",
+				Description: "The Azure application (client) ID. For the federated-credential method, the application must have a federated identity credential trusting Infisical. For more details, refer to the documentation here infisical.com/docs/integrations/app-connections/azure-app-configuration",
 				Sensitive:   true,
 			},
 			"client_secret": schema.StringAttribute{
-				Required:    true,
-				Description: "The Azure client secret. Required for client-secret method. For more details, refer to the documentation here infisical.com/docs/integrations/app-connections/azure-app-configuration",
+				Optional:    true,
+				Description: "The Azure client secret. Required for client-secret method, and must not be set for federated-credential method. For more details, refer to the documentation here infisical.com/docs/integrations/app-connections/azure-app-configuration",
 				Sensitive:   true,
 			},
 		},
@@ -53,33 +58,10 @@ func NewAppConnectionAzureAppConfigurationResource() resource.Resource {
 				return nil, diags
 			}
 
-			if plan.Method.ValueString() == AzureAppConfigurationAppConnectionClientSecretsMethod {
-				if credentials.TenantId.IsNull() || credentials.TenantId.ValueString() == "" {
-					diags.AddError(
-						"Unable to create Azure app connection",
-						"Tenant ID field must be defined in app configuration method",
-					)
-					return nil, diags
-				}
-
-				if credentials.ClientId.IsNull() || credentials.ClientId.ValueString() == "" {
-					diags.AddError(
-						"Unable to create Azure app connection",
-						"Client ID field must be defined in app configuration method",
-					)
-					return nil, diags
-				}
+			credentialsConfig["tenantId"] = credentials.TenantId.ValueString()
+			credentialsConfig["clientId"] = credentials.ClientId.ValueString()
 
-				if credentials.ClientSecret.IsNull() || credentials.ClientSecret.ValueString() == "" {
-					diags.AddError(
-						"Unable to create Azure app connection",
-						"Client secret field must be defined in app configuration method",
-					)
-					return nil, diags
-				}
-
-				credentialsConfig["tenantId"] = credentials.TenantId.ValueString()
-				credentialsConfig["clientId"] = credentials.ClientId.ValueString()
+			if plan.Method.ValueString() == AzureAppConfigurationAppConnectionClientSecretsMethod {
 				credentialsConfig["clientSecret"] = credentials.ClientSecret.ValueString()
 			}
 
@@ -100,48 +82,25 @@ func NewAppConnectionAzureAppConfigurationResource() resource.Resource {
 				return nil, diags
 			}
 
-			if plan.Method.ValueString() == AzureAppConfigurationAppConnectionClientSecretsMethod {
-				tenantId := credentialsFromPlan.TenantId
-				if credentialsFromPlan.TenantId.IsUnknown() {
-					tenantId = credentialsFromState.TenantId
-				}
+			tenantId := credentialsFromPlan.TenantId
+			if credentialsFromPlan.TenantId.IsUnknown() {
+				tenantId = credentialsFromState.TenantId
+			}
 
-				clientId := credentialsFromPlan.ClientId
-				if credentialsFromPlan.ClientId.IsUnknown() {
-					clientId = credentialsFromState.ClientId
-				}
+			clientId := credentialsFromPlan.ClientId
+			if credentialsFromPlan.ClientId.IsUnknown() {
+				clientId = credentialsFromState.ClientId
+			}
 
+			credentialsConfig["tenantId"] = tenantId.ValueString()
+			credentialsConfig["clientId"] = clientId.ValueString()
+
+			if plan.Method.ValueString() == AzureAppConfigurationAppConnectionClientSecretsMethod {
 				clientSecret := credentialsFromPlan.ClientSecret
 				if credentialsFromPlan.ClientSecret.IsUnknown() {
 					clientSecret = credentialsFromState.ClientSecret
 				}
 
-				if tenantId.IsNull() || tenantId.ValueString() == "" {
-					diags.AddError(
-						"Unable to update Azure app connection",
-						"Tenant ID field must be defined in app configuration method",
-					)
-					return nil, diags
-				}
-
-				if clientId.IsNull() || clientId.ValueString() == "" {
-					diags.AddError(
-						"Unable to update Azure app connection",
-						"Client ID field must be defined in app configuration method",
-					)
-					return nil, diags
-				}
-
-				if clientSecret.IsNull() || clientSecret.ValueString() == "" {
-					diags.AddError(
-						"Unable to update Azure app connection",
-						"Client secret field must be defined in app configuration method",
-					)
-					return nil, diags
-				}
-
-				credentialsConfig["tenantId"] = tenantId.ValueString()
-				credentialsConfig["clientId"] = clientId.ValueString()
 				credentialsConfig["clientSecret"] = clientSecret.ValueString()
 			}
 
@@ -23,13 +23,19 @@ type AppConnectionAzureDevOpsCredentialsModel struct {
 
 const AzureDevOpsAppConnectionAccessTokenMethod = "access-token"
 const AzureDevOpsAppConnectionClientSecretsMethod = "client-secret"
+const AzureDevOpsAppConnectionFederatedCredentialMethod = "federated-credential"
 
 func NewAppConnectionAzureDevOpsResource() resource.Resource {
 	return &AppConnectionBaseResource{
 		App:               infisical.AppConnectionAppAzureDevOps,
 		AppConnectionName: "Azure DevOps",
 		ResourceTypeName:  "_app_connection_azure_devops",
-		AllowedMethods:    []string{AzureDevOpsAppConnectionAccessTokenMethod, AzureDevOpsAppConnectionClientSecretsMethod},
+		AllowedMethods:    []string{AzureDevOpsAppConnectionAccessTokenMethod, AzureDevOpsAppConnectionClientSecretsMethod, AzureDevOpsAppConnectionFederatedCredentialMethod},
+		MethodCredentials: map[string]AppConnectionMethodCredentials{
+			AzureDevOpsAppConnectionAccessTokenMethod:         {Required: []string{"organization_name", "access_token"}},
+			AzureDevOpsAppConnectionClientSecretsMethod:       {Required: []string{"organization_name", "tenant_id", "client_id", "client_secret"}},
+			AzureDevOpsAppConnectionFederatedCredentialMethod: {Required: []string{"organization_name", "tenant_id", "client_id"}, Unused: []string{"access_token", "client_secret"}},
+		},
 		CredentialsAttributes: map[string]schema.Attribute{
 			"organization_name": schema.StringAttribute{
 				Required:    true,
@@ -43,12 +49,12 @@ func NewAppConnectionAzureDevOpsResource() resource.Resource {
 			},
 			"tenant_id": schema.StringAttribute{
 				Optional:    true,
-				Description: "The Azure Active Directory (AAD) tenant ID. Required for client-secret method. For more details, refer to the documentation here infisical.com/docs/integrations/app-connections/azure-client-secrets",
+				Description: "The Azure Active Directory (AAD) tenant ID. Required for client-secret and federated-credential methods. For more details, refer to the documentation here infisical.com/docs/integrations/app-connections/azure-client-secrets",
 				Sensitive:   false,
 			},
 			"client_id": schema.StringAttribute{
 				Optional:    true,
-				Description: "The Azure application (client) ID. Required for client-secret method. For more details, refer to the documentation here infisical.com/docs/integrations/app-connections/azure-client-secrets",
+				Description: "The Azure application (client) ID. Required for client-secret and federated-credential methods. For the federated-credential method, the application must have a federated identity credential trusting Infisical. For more details, refer to the documentation here infisical.com/docs/integrations/app-connections/azure-client-secrets",
 				Sensitive:   true,
 			},
 			"client_secret": schema.StringAttribute{
@@ -66,54 +72,18 @@ func NewAppConnectionAzureDevOpsResource() resource.Resource {
 				return nil, diags
 			}
 
-			if plan.Method.ValueString() == AzureDevOpsAppConnectionClientSecretsMethod {
-				if credentials.TenantId.IsNull() || credentials.TenantId.ValueString() == "" {
-					diags.AddError(
-						"Unable to create Azure app connection",
-						"Tenant ID field must be defined in client-secret method",
-					)
-					return nil, diags
-				}
-
-				if credentials.ClientId.IsNull() || credentials.ClientId.ValueString() == "" {
-					diags.AddError(
-						"Unable to create Azure app connection",
-						"Client ID field must be defined in client-secret method",
-					)
-					return nil, diags
-				}
-
-				if credentials.ClientSecret.IsNull() || credentials.ClientSecret.ValueString() == "" {
-					diags.AddError(
-						"Unable to create Azure app connection",
-						"Client secret field must be defined in client-secret method",
-					)
-					return nil, diags
-				}
-
+			switch plan.Method.ValueString() {
+			case AzureDevOpsAppConnectionClientSecretsMethod:
 				credentialsConfig["tenantId"] = credentials.TenantId.ValueString()
 				credentialsConfig["clientId"] = credentials.ClientId.ValueString()
 				credentialsConfig["clientSecret"] = credentials.ClientSecret.ValueString()
-			} else if plan.Method.ValueString() == AzureDevOpsAppConnectionAccessTokenMethod {
-				if credentials.AccessToken.IsNull() || credentials.AccessToken.ValueString() == "" {
-					diags.AddError(
-						"Unable to create Azure app connection",
-						"Access token field must be defined in access-token method",
-					)
-					return nil, diags
-				}
-
+			case AzureDevOpsAppConnectionFederatedCredentialMethod:
+				credentialsConfig["tenantId"] = credentials.TenantId.ValueString()
+				credentialsConfig["clientId"] = credentials.ClientId.ValueString()
+			case AzureDevOpsAppConnectionAccessTokenMethod:
 				credentialsConfig["accessToken"] = credentials.AccessToken.ValueString()
 			}
 
-			if credentials.OrganizationName.IsNull() || credentials.OrganizationName.ValueString() == "" {
-				diags.AddError(
-					"Unable to create Azure app connection",
-					"Organization name field must be defined",
-				)
-				return nil, diags
-			}
-
 			credentialsConfig["orgName"] = credentials.OrganizationName.ValueString()
 
 			return credentialsConfig, diags
@@ -133,7 +103,8 @@ func NewAppConnectionAzureDevOpsResource() resource.Resource {
 				return nil, diags
 			}
 
-			if plan.Method.ValueString() == AzureDevOpsAppConnectionClientSecretsMethod {
+			switch plan.Method.ValueString() {
+			case AzureDevOpsAppConnectionClientSecretsMethod, AzureDevOpsAppConnectionFederatedCredentialMethod:
 				tenantId := credentialsFromPlan.TenantId
 				if credentialsFromPlan.TenantId.IsUnknown() {
 					tenantId = credentialsFromState.TenantId
@@ -144,50 +115,22 @@ func NewAppConnectionAzureDevOpsResource() resource.Resource {
 					clientId = credentialsFromState.ClientId
 				}
 
-				clientSecret := credentialsFromPlan.ClientSecret
-				if credentialsFromPlan.ClientSecret.IsUnknown() {
-					clientSecret = credentialsFromState.ClientSecret
-				}
-
-				if tenantId.IsNull() || tenantId.ValueString() == "" {
-					diags.AddError(
-						"Unable to update Azure app connection",
-						"Tenant ID field must be defined in client-secret method",
-					)
-					return nil, diags
-				}
+				credentialsConfig["tenantId"] = tenantId.ValueString()
+				credentialsConfig["clientId"] = clientId.ValueString()
 
-				if clientId.IsNull() || clientId.ValueString() == "" {
-					diags.AddError(
-						"Unable to update Azure app connection",
-						"Client ID field must be defined in client-secret method",
-					)
-					return nil, diags
-				}
+				if plan.Method.ValueString() == AzureDevOpsAppConnectionClientSecretsMethod {
+					clientSecret := credentialsFromPlan.ClientSecret
+					if credentialsFromPlan.ClientSecret.IsUnknown() {
+						clientSecret = credentialsFromState.ClientSecret
+					}
 
-				if clientSecret.IsNull() || clientSecret.ValueString() == "" {
-					diags.AddError(
-						"Unable to update Azure app connection",
-						"Client secret field must be defined in client-secret method",
-					)
-					return nil, diags
+					credentialsConfig["clientSecret"] = clientSecret.ValueString()
 				}
-
-				credentialsConfig["tenantId"] = tenantId.ValueString()
-				credentialsConfig["clientId"] = clientId.ValueString()
-				credentialsConfig["clientSecret"] = clientSecret.ValueString()
-			} else if plan.Method.ValueString() == AzureDevOpsAppConnectionAccessTokenMethod {
+			case AzureDevOpsAppConnectionAccessTokenMethod:
 				accessToken := credentialsFromPlan.AccessToken
 				if credentialsFromPlan.AccessToken.IsUnknown() {
 					accessToken = credentialsFromState.AccessToken
 				}
-				if accessToken.IsNull() || accessToken.ValueString() == "" {
-					diags.AddError(
-						"Unable to update Azure app connection",
-						"Access token field must be defined in access-token method",
-					)
-					return nil, diags
-				}
 
 				credentialsConfig["accessToken"] = accessToken.ValueString()
 			}
@@ -196,13 +139,6 @@ func NewAppConnectionAzureDevOpsResource() resource.Resource {
 			if credentialsFromPlan.OrganizationName.IsUnknown() {
 				organizationName = credentialsFromState.OrganizationName
 			}
-			if organizationName.IsNull() || organizationName.ValueString() == "" {
-				diags.AddError(
-					"Unable to update Azure app connection",
-					"Organization name field must be defined",
-				)
-				return nil, diags
-			}
 
 			credentialsConfig["orgName"] = organizationName.ValueString()
 
@@ -20,27 +20,32 @@ type AppConnectionAzureKeyVaultCredentialsModel struct {
 }
 
 const AzureKeyVaultAppConnectionClientSecretsMethod = "client-secret"
+const AzureKeyVaultAppConnectionFederatedCredentialMethod = "federated-credential"
 
 func NewAppConnectionAzureKeyVaultResource() resource.Resource {
 	return &AppConnectionBaseResource{
 		App:               infisical.AppConnectionAppAzureKeyVault,
 		AppConnectionName: "Azure Key Vault",
 		ResourceTypeName:  "_app_connection_azure_key_vault",
-		AllowedMethods:    []string{AzureKeyVaultAppConnectionClientSecretsMethod},
+		AllowedMethods:    []string{AzureKeyVaultAppConnectionClientSecretsMethod, AzureKeyVaultAppConnectionFederatedCredentialMethod},
+		MethodCredentials: map[string]AppConnectionMethodCredentials{
+			AzureKeyVaultAppConnectionClientSecretsMethod:       {Required: []string{"tenant_id", "client_id", "client_secret"}},
+			AzureKeyVaultAppConnectionFederatedCredentialMethod: {Required: []string{"tenant_id", "client_id"}, Unused: []string{"client_secret"}},
+		},
 		CredentialsAttributes: map[string]schema.Attribute{
 			"tenant_id": schema.StringAttribute{
 				Required:    true,
-				Description: "The Azure Active Directory (AAD) tenant ID. Required for key-vault method. For more details, refer to the documentation here infisical.com/docs/integrations/app-connections/azure-key-vault",
+				Description: "The Azure Active Directory (AAD) tenant ID. For more details, refer to the documentation here infisical.com/docs/integrations/app-connections/azure-key-vault",
 				Sensitive:   false,
 			},
 			"client_id": schema.StringAttribute{
 				Required:    true,
-				Description: "The Azure application (client) ID. Required for key-vault method. For more details, refer to the documentation here infisical.com/docs/integrations/app-connections/azure-key-vault",
+				Description: "The Azure application (client) ID. For the federated-credential method, the application must have a federated identity credential trusting Infisical. For more details, refer to the documentation here infisical.com/docs/integrations/app-connections/azure-key-vault",
 				Sensitive:   true,
 			},
 			"client_secret": schema.StringAttribute{
-				Required:    true,
-				Description: "The Azure client secret. Required for key-vault method. For more details, refer to the documentation here infisical.com/docs/integrations/app-connections/azure-key-vault",
+				Optional:    true,
+				Description: "The Azure client secret. Required for client-secret method, and must not be set for federated-credential method. For more details, refer to the documentation here infisical.com/docs/integrations/app-connections/azure-key-vault",
 				Sensitive:   true,
 			},
 		},
@@ -53,33 +58,10 @@ func NewAppConnectionAzureKeyVaultResource() resource.Resource {
 				return nil, diags
 			}
 
-			if plan.Method.ValueString() == AzureKeyVaultAppConnectionClientSecretsMethod {
-				if credentials.TenantId.IsNull() || credentials.TenantId.ValueString() == "" {
-					diags.AddError(
-						"Unable to create Azure app connection",
-						"Tenant ID field must be defined in key-vault method",
-					)
-					return nil, diags
-				}
-
-				if credentials.ClientId.IsNull() || credentials.ClientId.ValueString() == "" {
-					diags.AddError(
-						"Unable to create Azure app connection",
-						"Client ID field must be defined in key-vault method",
-					)
-					return nil, diags
-				}
+			credentialsConfig["tenantId"] = credentials.TenantId.ValueString()
+			credentialsConfig["clientId"] = credentials.ClientId.ValueString()
 
-				if credentials.ClientSecret.IsNull() || credentials.ClientSecret.ValueString() == "" {
-					diags.AddError(
-						"Unable to create Azure app connection",
-						"Client secret field must be defined in key-vault method",
-					)
-					return nil, diags
-				}
-
-				credentialsConfig["tenantId"] = credentials.TenantId.ValueString()
-				credentialsConfig["clientId"] = credentials.ClientId.ValueString()
+			if plan.Method.ValueString() == AzureKeyVaultAppConnectionClientSecretsMethod {
 				credentialsConfig["clientSecret"] = credentials.ClientSecret.ValueString()
 			}
 
@@ -100,48 +82,25 @@ func NewAppConnectionAzureKeyVaultResource() resource.Resource {
 				return nil, diags
 			}
 
-			if plan.Method.ValueString() == AzureKeyVaultAppConnectionClientSecretsMethod {
-				tenantId := credentialsFromPlan.TenantId
-				if credentialsFromPlan.TenantId.IsUnknown() {
-					tenantId = credentialsFromState.TenantId
-				}
+			tenantId := credentialsFromPlan.TenantId
+			if credentialsFromPlan.TenantId.IsUnknown() {
+				tenantId = credentialsFromState.TenantId
+			}
 
-				clientId := credentialsFromPlan.ClientId
-				if credentialsFromPlan.ClientId.IsUnknown() {
-					clientId = credentialsFromState.ClientId
-				}
+			clientId := credentialsFromPlan.ClientId
+			if credentialsFromPlan.ClientId.IsUnknown() {
+				clientId = credentialsFromState.ClientId
+			}
 
+			credentialsConfig["tenantId"] = tenantId.ValueString()
+			credentialsConfig["clientId"] = clientId.ValueString()
+
+			if plan.Method.ValueString() == AzureKeyVaultAppConnectionClientSecretsMethod {
 				clientSecret := credentialsFromPlan.ClientSecret
 				if credentialsFromPlan.ClientSecret.IsUnknown() {
 					clientSecret = credentialsFromState.ClientSecret
 				}
 
-				if tenantId.IsNull() || tenantId.ValueString() == "" {
-					diags.AddError(
-						"Unable to update Azure app connection",
-						"Tenant ID field must be defined in key-vault method",
-					)
-					return nil, diags
-				}
-
-				if clientId.IsNull() || clientId.ValueString() == "" {
-					diags.AddError(
-						"Unable to update Azure app connection",
-						"Client ID field must be defined in key-vault method",
-					)
-					return nil, diags
-				}
-
-				if clientSecret.IsNull() || clientSecret.ValueString() == "" {
-					diags.AddError(
-						"Unable to update Azure app connection",
-						"Client secret field must be defined in key-vault method",
-					)
-					return nil, diags
-				}
-
-				credentialsConfig["tenantId"] = tenantId.ValueString()
-				credentialsConfig["clientId"] = clientId.ValueString()
 				credentialsConfig["clientSecret"] = clientSecret.ValueString()
 			}
 
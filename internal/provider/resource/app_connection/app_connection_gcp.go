@@ -14,21 +14,48 @@ import (
 
 // AppConnectionGcpCredentialsModel describes the data source data model.
 type AppConnectionGcpCredentialsModel struct {
-	ServiceAccountEmail types.String `tfsdk:"service_account_email"`
+	ServiceAccountEmail        types.String `tfsdk:"service_account_email"`
+	ProjectNumber              types.String `tfsdk:"project_number"`
+	WorkloadIdentityPoolId     types.String `tfsdk:"workload_identity_pool_id"`
+	WorkloadIdentityProviderId types.String `tfsdk:"workload_identity_provider_id"`
 }
 
+const GcpAppConnectionServiceAccountImpersonationMethod = "service-account-impersonation"
+const GcpAppConnectionWorkloadIdentityFederationMethod = "workload-identity-federation"
+
 func NewAppConnectionGcpResource() resource.Resource {
 	return &AppConnectionBaseResource{
 		App:               infisical.AppConnectionAppGCP,
 		AppConnectionName: "GCP",
 		ResourceTypeName:  "_app_connection_gcp",
-		AllowedMethods:    []string{"service-account-impersonation"},
+		AllowedMethods:    []string{GcpAppConnectionServiceAccountImpersonationMethod, GcpAppConnectionWorkloadIdentityFederationMethod},
+		MethodCredentials: map[string]AppConnectionMethodCredentials{
+			GcpAppConnectionServiceAccountImpersonationMethod: {
+				Required: []string{"service_account_email"},
+				Unused:   []string{"project_number", "workload_identity_pool_id", "workload_identity_provider_id"},
+			},
+			GcpAppConnectionWorkloadIdentityFederationMethod: {
+				Required: []string{"service_account_email", "project_number", "workload_identity_pool_id", "workload_identity_provider_id"},
+			},
+		},
 		CredentialsAttributes: map[string]schema.Attribute{
 			"service_account_email": schema.StringAttribute{
 				Optional:    true,
-				Description: "The service account email to connect with GCP. The service account ID (the part of the email before '@') must be suffixed with the first two sections of your organization ID e.g. service-account-df92581a-0fe9@my-project.iam.gserviceaccount.com. For more details, refer to the documentation here https://infisical.com/docs/integrations/app-connections/gcp#configure-service-account-for-infisical",
+				Description: "The service account email to connect with GCP. The service account ID (the part of the email before '@') must be suffixed with the first two sections of your organization ID e.g. service-account-df92581a-0fe9@my-project.iam.gserviceaccount.com. For more details, refer to the documentation here https://infisical.com/docs/integrations/app-connections/gcp#configure-service-account-for-infisical. For workload-identity-federation method, the service account the federated identity impersonates.",
 				Sensitive:   true,
 			},
+			"project_number": schema.StringAttribute{
+				Optional:    true,
+				Description: "The number of the Google Cloud project that hosts the workload identity pool. Required for workload-identity-federation method.",
+			},
+			"workload_identity_pool_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the workload identity pool that trusts Infisical. Required for workload-identity-federation method.",
+			},
+			"workload_identity_provider_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the OIDC provider of the workload identity pool that trusts Infisical's tokens. Required for workload-identity-federation method.",
+			},
 		},
 		ReadCredentialsForCreateFromPlan: func(ctx context.Context, plan AppConnectionBaseResourceModel) (map[string]any, diag.Diagnostics) {
 			credentialsConfig := make(map[string]any)
@@ -39,16 +66,14 @@ func NewAppConnectionGcpResource() resource.Resource {
 				return nil, diags
 			}
 
-			if credentials.ServiceAccountEmail.IsNull() || credentials.ServiceAccountEmail.ValueString() == "" {
-				diags.AddError(
-					"Unable to create GCP app connection",
-					"Service account email field must be defined",
-				)
-				return nil, diags
-			}
-
 			credentialsConfig["serviceAccountEmail"] = credentials.ServiceAccountEmail.ValueString()
 
+			if plan.Method.ValueString() == GcpAppConnectionWorkloadIdentityFederationMethod {
+				credentialsConfig["projectNumber"] = credentials.ProjectNumber.ValueString()
+				credentialsConfig["poolId"] = credentials.WorkloadIdentityPoolId.ValueString()
+				credentialsConfig["providerId"] = credentials.WorkloadIdentityProviderId.ValueString()
+			}
+
 			return credentialsConfig, diags
 		},
 		ReadCredentialsForUpdateFromPlan: func(ctx context.Context, plan AppConnectionBaseResourceModel, state AppConnectionBaseResourceModel) (map[string]any, diag.Diagnostics) {
@@ -71,28 +96,45 @@ func NewAppConnectionGcpResource() resource.Resource {
 				serviceAccountEmail = credentialsFromState.ServiceAccountEmail
 			}
 
-			if serviceAccountEmail.IsNull() || serviceAccountEmail.ValueString() == "" {
-				diags.AddError(
-					"Unable to update GCP app connection",
-					"Service account email field must be defined",
-				)
-				return nil, diags
-			}
+			credentialsConfig["serviceAccountEmail"] = serviceAccountEmail.ValueString()
+
+			if plan.Method.ValueString() == GcpAppConnectionWorkloadIdentityFederationMethod {
+				projectNumber := credentialsFromPlan.ProjectNumber
+				if credentialsFromPlan.ProjectNumber.IsUnknown() {
+					projectNumber = credentialsFromState.ProjectNumber
+				}
+
+				poolId := credentialsFromPlan.WorkloadIdentityPoolId
+				if credentialsFromPlan.WorkloadIdentityPoolId.IsUnknown() {
+					poolId = credentialsFromState.WorkloadIdentityPoolId
+				}
+
+				providerId := credentialsFromPlan.WorkloadIdentityProviderId
+				if credentialsFromPlan.WorkloadIdentityProviderId.IsUnknown() {
+					providerId = credentialsFromState.WorkloadIdentityProviderId
+				}
 
-			if !serviceAccountEmail.IsNull() {
-				credentialsConfig["serviceAccountEmail"] = serviceAccountEmail.ValueString()
+				credentialsConfig["projectNumber"] = projectNumber.ValueString()
+				credentialsConfig["poolId"] = poolId.ValueString()
+				credentialsConfig["providerId"] = providerId.ValueString()
 			}
 
 			return credentialsConfig, diags
 		},
 		OverwriteCredentialsFields: func(state *AppConnectionBaseResourceModel) diag.Diagnostics {
 			credentialsConfig := map[string]attr.Value{
-				"service_account_email": types.StringNull(),
+				"service_account_email":         types.StringNull(),
+				"project_number":                types.StringNull(),
+				"workload_identity_pool_id":     types.StringNull(),
+				"workload_identity_provider_id": types.StringNull(),
 			}
 
 			var diags diag.Diagnostics
 			state.Credentials, diags = types.ObjectValue(map[string]attr.Type{
-				"service_account_email": types.StringType,
+				"service_account_email":         types.StringType,
+				"project_number":                types.StringType,
+				"workload_identity_pool_id":     types.StringType,
+				"workload_identity_provider_id": types.StringType,
 			}, credentialsConfig)
 
 			return diags
@@ -15,11 +15,16 @@ import (
 
 type AppConnectionGithubCredentialsModel struct {
 	PersonalAccessToken types.String `tfsdk:"personal_access_token"`
+	AppId               types.String `tfsdk:"app_id"`
+	InstallationId      types.String `tfsdk:"installation_id"`
+	PrivateKeyWO        types.String `tfsdk:"private_key_wo"`
+	PrivateKeyWOVersion types.Int64  `tfsdk:"private_key_wo_version"`
 	InstanceType        types.String `tfsdk:"instance_type"`
 	Host                types.String `tfsdk:"host"`
 }
 
 const AppConnectionGithubAuthMethodPat = "pat"
+const AppConnectionGithubAuthMethodGithubApp = "github-app"
 
 // buildGithubCredentialsForCreate validates plan credentials and returns the API payload for create.
 func buildGithubCredentialsForCreate(ctx context.Context, plan AppConnectionBaseResourceModel) (map[string]any, diag.Diagnostics) {
@@ -31,14 +36,6 @@ func buildGithubCredentialsForCreate(ctx context.Context, plan AppConnectionBase
 		return nil, diags
 	}
 
-	if plan.Method.ValueString() != AppConnectionGithubAuthMethodPat {
-		diags.AddError(
-			"Unable to create GitHub app connection",
-			"Invalid method. Only pat (Personal Access Token) method is supported. Note: GitHub App and OAuth connections must be created through the Infisical UI.",
-		)
-		return nil, diags
-	}
-
 	instanceType := "cloud"
 	if !credentials.InstanceType.IsNull() && credentials.InstanceType.ValueString() != "" {
 		instanceType = credentials.InstanceType.ValueString()
@@ -62,9 +59,17 @@ func buildGithubCredentialsForCreate(ctx context.Context, plan AppConnectionBase
 		credentialsConfig["host"] = credentials.Host.ValueString()
 	}
 
-	credentialsConfig["personalAccessToken"] = credentials.PersonalAccessToken.ValueString()
 	credentialsConfig["instanceType"] = instanceType
 
+	if plan.Method.ValueString() == AppConnectionGithubAuthMethodGithubApp {
+		credentialsConfig["appId"] = credentials.AppId.ValueString()
+		credentialsConfig["installationId"] = credentials.InstallationId.ValueString()
+		credentialsConfig["privateKey"] = credentials.PrivateKeyWO.ValueString()
+		return credentialsConfig, diags
+	}
+
+	credentialsConfig["personalAccessToken"] = credentials.PersonalAccessToken.ValueString()
+
 	return credentialsConfig, diags
 }
 
@@ -84,14 +89,6 @@ func buildGithubCredentialsForUpdate(ctx context.Context, plan AppConnectionBase
 		return nil, diags
 	}
 
-	if plan.Method.ValueString() != AppConnectionGithubAuthMethodPat {
-		diags.AddError(
-			"Unable to update GitHub app connection",
-			"Invalid method. Only pat (Personal Access Token) method is supported. Note: GitHub App and OAuth connections must be created through the Infisical UI.",
-		)
-		return nil, diags
-	}
-
 	instanceType := credentialsFromPlan.InstanceType
 	if credentialsFromPlan.InstanceType.IsUnknown() {
 		instanceType = credentialsFromState.InstanceType
@@ -125,13 +122,29 @@ func buildGithubCredentialsForUpdate(ctx context.Context, plan AppConnectionBase
 
 	credentialsConfig["instanceType"] = instanceTypeStr
 
+	if plan.Method.ValueString() == AppConnectionGithubAuthMethodGithubApp {
+		appId := credentialsFromPlan.AppId
+		if credentialsFromPlan.AppId.IsUnknown() {
+			appId = credentialsFromState.AppId
+		}
+
+		installationId := credentialsFromPlan.InstallationId
+		if credentialsFromPlan.InstallationId.IsUnknown() {
+			installationId = credentialsFromState.InstallationId
+		}
+
+		credentialsConfig["appId"] = appId.ValueString()
+		credentialsConfig["installationId"] = installationId.ValueString()
+		// The private key is write-only, so it is never in state to fall back on.
+		credentialsConfig["privateKey"] = credentialsFromPlan.PrivateKeyWO.ValueString()
+		return credentialsConfig, diags
+	}
+
 	personalAccessToken := credentialsFromPlan.PersonalAccessToken
 	if credentialsFromPlan.PersonalAccessToken.IsUnknown() {
 		personalAccessToken = credentialsFromState.PersonalAccessToken
 	}
-	if !personalAccessToken.IsNull() {
-		credentialsConfig["personalAccessToken"] = personalAccessToken.ValueString()
-	}
+	credentialsConfig["personalAccessToken"] = personalAccessToken.ValueString()
 
 	return credentialsConfig, diags
 }
@@ -141,13 +154,40 @@ func NewAppConnectionGithubResource() resource.Resource {
 		App:               infisical.AppConnectionAppGithub,
 		AppConnectionName: "GitHub",
 		ResourceTypeName:  "_app_connection_github",
-		AllowedMethods:    []string{AppConnectionGithubAuthMethodPat},
+		AllowedMethods:    []string{AppConnectionGithubAuthMethodPat, AppConnectionGithubAuthMethodGithubApp},
+		MethodCredentials: map[string]AppConnectionMethodCredentials{
+			AppConnectionGithubAuthMethodPat: {
+				Required: []string{"personal_access_token"},
+				Unused:   []string{"app_id", "installation_id", "private_key_wo"},
+			},
+			AppConnectionGithubAuthMethodGithubApp: {
+				Required: []string{"app_id", "installation_id", "private_key_wo"},
+				Unused:   []string{"personal_access_token"},
+			},
+		},
 		CredentialsAttributes: map[string]schema.Attribute{
 			"personal_access_token": schema.StringAttribute{
-				Required:    true,
-				Description: "The Personal Access Token used to access GitHub.",
+				Optional:    true,
+				Description: "The Personal Access Token used to access GitHub. Required for the `pat` method.",
 				Sensitive:   true,
 			},
+			"app_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the GitHub App to authenticate as. Required for the `github-app` method.",
+			},
+			"installation_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the installation of the GitHub App on the organization or account to access. Required for the `github-app` method.",
+			},
+			"private_key_wo": schema.StringAttribute{
+				Optional:    true,
+				WriteOnly:   true,
+				Description: "The private key (PEM format) of the GitHub App as a write-only value, so it is never stored in state. Changes to it are reflected in `credentials_hash`. Required for the `github-app` method. Requires Terraform version 1.11.0 or higher.",
+			},
+			"private_key_wo_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Used together with private_key_wo to trigger an update. Increment this value when the private key is rotated.",
+			},
 			"instance_type": schema.StringAttribute{
 				Optional:    true,
 				Description: "The type of GitHub instance. Use 'cloud' for GitHub.com (default) or 'server' for GitHub Enterprise. When 'server', host is required.",
@@ -161,16 +201,24 @@ func NewAppConnectionGithubResource() resource.Resource {
 		ReadCredentialsForUpdateFromPlan: buildGithubCredentialsForUpdate,
 		OverwriteCredentialsFields: func(state *AppConnectionBaseResourceModel) diag.Diagnostics {
 			credentialsConfig := map[string]attr.Value{
-				"personal_access_token": types.StringNull(),
-				"instance_type":         types.StringNull(),
-				"host":                  types.StringNull(),
+				"personal_access_token":  types.StringNull(),
+				"app_id":                 types.StringNull(),
+				"installation_id":        types.StringNull(),
+				"private_key_wo":         types.StringNull(),
+				"private_key_wo_version": types.Int64Null(),
+				"instance_type":          types.StringNull(),
+				"host":                   types.StringNull(),
 			}
 
 			var diags diag.Diagnostics
 			state.Credentials, diags = types.ObjectValue(map[string]attr.Type{
-				"personal_access_token": types.StringType,
-				"instance_type":         types.StringType,
-				"host":                  types.StringType,
+				"personal_access_token":  types.StringType,
+				"app_id":                 types.StringType,
+				"installation_id":        types.StringType,
+				"private_key_wo":         types.StringType,
+				"private_key_wo_version": types.Int64Type,
+				"instance_type":          types.StringType,
+				"host":                   types.StringType,
 			}, credentialsConfig)
 
 			return diags
@@ -0,0 +1,101 @@
+package resource
+
+import (
+	"context"
+	infisical "terraform-provider-infisical/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type AppConnectionHerokuCredentialsModel struct {
+	AuthToken types.String `tfsdk:"auth_token"`
+}
+
+const AppConnectionHerokuAuthMethodAuthToken = "auth-token"
+
+func NewAppConnectionHerokuResource() resource.Resource {
+	return &AppConnectionBaseResource{
+		App:               infisical.AppConnectionAppHeroku,
+		AppConnectionName: "Heroku",
+		ResourceTypeName:  "_app_connection_heroku",
+		AllowedMethods:    []string{AppConnectionHerokuAuthMethodAuthToken},
+		CredentialsAttributes: map[string]schema.Attribute{
+			"auth_token": schema.StringAttribute{
+				Required:    true,
+				Description: "The Heroku API key or authorization token for authentication.",
+				Sensitive:   true,
+			},
+		},
+		ReadCredentialsForCreateFromPlan: func(ctx context.Context, plan AppConnectionBaseResourceModel) (map[string]any, diag.Diagnostics) {
+			credentialsConfig := make(map[string]any)
+
+			var credentials AppConnectionHerokuCredentialsModel
+			diags := plan.Credentials.As(ctx, &credentials, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			if plan.Method.ValueString() != AppConnectionHerokuAuthMethodAuthToken {
+				diags.AddError(
+					"Unable to create Heroku app connection",
+					"Invalid method. Only auth-token method is supported",
+				)
+				return nil, diags
+			}
+
+			credentialsConfig["authToken"] = credentials.AuthToken.ValueString()
+
+			return credentialsConfig, diags
+		},
+		ReadCredentialsForUpdateFromPlan: func(ctx context.Context, plan AppConnectionBaseResourceModel, state AppConnectionBaseResourceModel) (map[string]any, diag.Diagnostics) {
+			credentialsConfig := make(map[string]any)
+
+			var credentialsFromPlan AppConnectionHerokuCredentialsModel
+			diags := plan.Credentials.As(ctx, &credentialsFromPlan, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			var credentialsFromState AppConnectionHerokuCredentialsModel
+			diags = state.Credentials.As(ctx, &credentialsFromState, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			if plan.Method.ValueString() != AppConnectionHerokuAuthMethodAuthToken {
+				diags.AddError(
+					"Unable to update Heroku app connection",
+					"Invalid method. Only auth-token method is supported",
+				)
+				return nil, diags
+			}
+
+			authToken := credentialsFromPlan.AuthToken
+			if credentialsFromPlan.AuthToken.IsUnknown() {
+				authToken = credentialsFromState.AuthToken
+			}
+			if !authToken.IsNull() {
+				credentialsConfig["authToken"] = authToken.ValueString()
+			}
+
+			return credentialsConfig, diags
+		},
+		OverwriteCredentialsFields: func(state *AppConnectionBaseResourceModel) diag.Diagnostics {
+			credentialsConfig := map[string]attr.Value{
+				"auth_token": types.StringNull(),
+			}
+
+			var diags diag.Diagnostics
+			state.Credentials, diags = types.ObjectValue(map[string]attr.Type{
+				"auth_token": types.StringType,
+			}, credentialsConfig)
+
+			return diags
+		},
+	}
+}
@@ -0,0 +1,170 @@
+package resource
+
+import (
+	"context"
+	infisical "terraform-provider-infisical/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type AppConnectionKubernetesCredentialsModel struct {
+	Url                   types.String `tfsdk:"url"`
+	ServiceAccountToken   types.String `tfsdk:"service_account_token"`
+	Kubeconfig            types.String `tfsdk:"kubeconfig"`
+	SslRejectUnauthorized types.Bool   `tfsdk:"ssl_reject_unauthorized"`
+	SslCertificate        types.String `tfsdk:"ssl_certificate"`
+}
+
+const AppConnectionKubernetesAuthMethodServiceAccountToken = "service-account-token"
+const AppConnectionKubernetesAuthMethodKubeconfig = "kubeconfig"
+
+func NewAppConnectionKubernetesResource() resource.Resource {
+	return &AppConnectionBaseResource{
+		App:               infisical.AppConnectionAppKubernetes,
+		AppConnectionName: "Kubernetes",
+		ResourceTypeName:  "_app_connection_kubernetes",
+		SupportsGateway:   true,
+		AllowedMethods:    []string{AppConnectionKubernetesAuthMethodServiceAccountToken, AppConnectionKubernetesAuthMethodKubeconfig},
+		MethodCredentials: map[string]AppConnectionMethodCredentials{
+			AppConnectionKubernetesAuthMethodServiceAccountToken: {
+				Required: []string{"url", "service_account_token"},
+				Unused:   []string{"kubeconfig"},
+			},
+			AppConnectionKubernetesAuthMethodKubeconfig: {
+				Required: []string{"kubeconfig"},
+				Unused:   []string{"url", "service_account_token", "ssl_certificate"},
+			},
+		},
+		CredentialsAttributes: map[string]schema.Attribute{
+			"url": schema.StringAttribute{
+				Optional:    true,
+				Description: "The URL of the Kubernetes API server, e.g. `https://kubernetes.example.com:6443`. Required for the `service-account-token` method.",
+			},
+			"service_account_token": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The token of the service account Infisical authenticates as. Required for the `service-account-token` method.",
+			},
+			"kubeconfig": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The contents of a kubeconfig file; its current context selects the cluster and credentials to use. Required for the `kubeconfig` method.",
+			},
+			"ssl_reject_unauthorized": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether or not to reject untrusted TLS certificates presented by the API server. Set to false only in test environments.",
+				Default:     booldefault.StaticBool(true),
+			},
+			"ssl_certificate": schema.StringAttribute{
+				Optional:    true,
+				Description: "The CA certificate (PEM format) to trust when the API server uses a self-signed certificate. Only applicable to the `service-account-token` method.",
+			},
+		},
+		ReadCredentialsForCreateFromPlan: func(ctx context.Context, plan AppConnectionBaseResourceModel) (map[string]any, diag.Diagnostics) {
+			credentialsConfig := make(map[string]any)
+
+			var credentials AppConnectionKubernetesCredentialsModel
+			diags := plan.Credentials.As(ctx, &credentials, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			credentialsConfig["sslRejectUnauthorized"] = credentials.SslRejectUnauthorized.ValueBool()
+
+			if plan.Method.ValueString() == AppConnectionKubernetesAuthMethodServiceAccountToken {
+				credentialsConfig["url"] = credentials.Url.ValueString()
+				credentialsConfig["serviceAccountToken"] = credentials.ServiceAccountToken.ValueString()
+
+				if !credentials.SslCertificate.IsNull() && credentials.SslCertificate.ValueString() != "" {
+					credentialsConfig["sslCertificate"] = credentials.SslCertificate.ValueString()
+				}
+			} else {
+				credentialsConfig["kubeconfig"] = credentials.Kubeconfig.ValueString()
+			}
+
+			return credentialsConfig, diags
+		},
+		ReadCredentialsForUpdateFromPlan: func(ctx context.Context, plan AppConnectionBaseResourceModel, state AppConnectionBaseResourceModel) (map[string]any, diag.Diagnostics) {
+			credentialsConfig := make(map[string]any)
+
+			var credentialsFromPlan AppConnectionKubernetesCredentialsModel
+			diags := plan.Credentials.As(ctx, &credentialsFromPlan, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			var credentialsFromState AppConnectionKubernetesCredentialsModel
+			diags = state.Credentials.As(ctx, &credentialsFromState, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			sslRejectUnauthorized := credentialsFromPlan.SslRejectUnauthorized
+			if credentialsFromPlan.SslRejectUnauthorized.IsUnknown() {
+				sslRejectUnauthorized = credentialsFromState.SslRejectUnauthorized
+			}
+			if !sslRejectUnauthorized.IsNull() {
+				credentialsConfig["sslRejectUnauthorized"] = sslRejectUnauthorized.ValueBool()
+			}
+
+			if plan.Method.ValueString() == AppConnectionKubernetesAuthMethodServiceAccountToken {
+				url := credentialsFromPlan.Url
+				if credentialsFromPlan.Url.IsUnknown() {
+					url = credentialsFromState.Url
+				}
+
+				serviceAccountToken := credentialsFromPlan.ServiceAccountToken
+				if credentialsFromPlan.ServiceAccountToken.IsUnknown() {
+					serviceAccountToken = credentialsFromState.ServiceAccountToken
+				}
+
+				credentialsConfig["url"] = url.ValueString()
+				credentialsConfig["serviceAccountToken"] = serviceAccountToken.ValueString()
+
+				sslCertificate := credentialsFromPlan.SslCertificate
+				if credentialsFromPlan.SslCertificate.IsUnknown() {
+					sslCertificate = credentialsFromState.SslCertificate
+				}
+				if !sslCertificate.IsNull() && sslCertificate.ValueString() != "" {
+					credentialsConfig["sslCertificate"] = sslCertificate.ValueString()
+				}
+			} else {
+				kubeconfig := credentialsFromPlan.Kubeconfig
+				if credentialsFromPlan.Kubeconfig.IsUnknown() {
+					kubeconfig = credentialsFromState.Kubeconfig
+				}
+
+				credentialsConfig["kubeconfig"] = kubeconfig.ValueString()
+			}
+
+			return credentialsConfig, diags
+		},
+		OverwriteCredentialsFields: func(state *AppConnectionBaseResourceModel) diag.Diagnostics {
+			credentialsConfig := map[string]attr.Value{
+				"url":                     types.StringNull(),
+				"service_account_token":   types.StringNull(),
+				"kubeconfig":              types.StringNull(),
+				"ssl_reject_unauthorized": types.BoolNull(),
+				"ssl_certificate":         types.StringNull(),
+			}
+
+			var diags diag.Diagnostics
+			state.Credentials, diags = types.ObjectValue(map[string]attr.Type{
+				"url":                     types.StringType,
+				"service_account_token":   types.StringType,
+				"kubeconfig":              types.StringType,
+				"ssl_reject_unauthorized": types.BoolType,
+				"ssl_certificate":         types.StringType,
+			}, credentialsConfig)
+
+			return diags
+		},
+	}
+}
@@ -0,0 +1,225 @@
+package resource
+
+import (
+	"context"
+	infisical "terraform-provider-infisical/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type AppConnectionMongoDbCredentialsModel struct {
+	Host                  types.String `tfsdk:"host"`
+	Port                  types.Int32  `tfsdk:"port"`
+	Database              types.String `tfsdk:"database"`
+	Username              types.String `tfsdk:"username"`
+	Password              types.String `tfsdk:"password"`
+	TlsEnabled            types.Bool   `tfsdk:"tls_enabled"`
+	TlsRejectUnauthorized types.Bool   `tfsdk:"tls_reject_unauthorized"`
+	TlsCertificate        types.String `tfsdk:"tls_certificate"`
+}
+
+const AppConnectionMongoDbAuthMethodUsernameAndPassword = "username-and-password"
+
+func NewAppConnectionMongoDbResource() resource.Resource {
+	return &AppConnectionBaseResource{
+		App:               infisical.AppConnectionAppMongoDb,
+		AppConnectionName: "MongoDB",
+		ResourceTypeName:  "_app_connection_mongodb",
+		SupportsGateway:   true,
+		AllowedMethods:    []string{AppConnectionMongoDbAuthMethodUsernameAndPassword},
+		CredentialsAttributes: map[string]schema.Attribute{
+			"host": schema.StringAttribute{
+				Required:    true,
+				Description: "The hostname of the database server.",
+			},
+			"port": schema.Int32Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The port number of the database.",
+				Default:     int32default.StaticInt32(27017),
+			},
+			"database": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the database to authenticate against.",
+			},
+			"username": schema.StringAttribute{
+				Required:    true,
+				Description: "The username to connect to the database with.",
+			},
+			"password": schema.StringAttribute{
+				Required:    true,
+				Description: "The password to connect to the database with.",
+				Sensitive:   true,
+			},
+			"tls_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether or not to use TLS when connecting to the database.",
+				Default:     booldefault.StaticBool(true),
+			},
+			"tls_reject_unauthorized": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether or not to reject unauthorized TLS certificates.",
+				Default:     booldefault.StaticBool(true),
+			},
+			"tls_certificate": schema.StringAttribute{
+				Optional:    true,
+				Description: "The TLS certificate to use for connection.",
+			},
+		},
+		ReadCredentialsForCreateFromPlan: func(ctx context.Context, plan AppConnectionBaseResourceModel) (map[string]any, diag.Diagnostics) {
+			credentialsConfig := make(map[string]any)
+
+			var credentials AppConnectionMongoDbCredentialsModel
+			diags := plan.Credentials.As(ctx, &credentials, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			if plan.Method.ValueString() != AppConnectionMongoDbAuthMethodUsernameAndPassword {
+				diags.AddError(
+					"Unable to create MongoDB app connection",
+					"Invalid method. Only username-and-password method is supported",
+				)
+				return nil, diags
+			}
+
+			credentialsConfig["host"] = credentials.Host.ValueString()
+			credentialsConfig["port"] = credentials.Port.ValueInt32()
+			credentialsConfig["database"] = credentials.Database.ValueString()
+			credentialsConfig["username"] = credentials.Username.ValueString()
+			credentialsConfig["password"] = credentials.Password.ValueString()
+			credentialsConfig["tlsEnabled"] = credentials.TlsEnabled.ValueBool()
+			credentialsConfig["tlsRejectUnauthorized"] = credentials.TlsRejectUnauthorized.ValueBool()
+
+			if !credentials.TlsCertificate.IsNull() {
+				credentialsConfig["tlsCertificate"] = credentials.TlsCertificate.ValueString()
+			}
+
+			return credentialsConfig, diags
+		},
+		ReadCredentialsForUpdateFromPlan: func(ctx context.Context, plan AppConnectionBaseResourceModel, state AppConnectionBaseResourceModel) (map[string]any, diag.Diagnostics) {
+			credentialsConfig := make(map[string]any)
+
+			var credentialsFromPlan AppConnectionMongoDbCredentialsModel
+			diags := plan.Credentials.As(ctx, &credentialsFromPlan, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			var credentialsFromState AppConnectionMongoDbCredentialsModel
+			diags = state.Credentials.As(ctx, &credentialsFromState, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			if plan.Method.ValueString() != AppConnectionMongoDbAuthMethodUsernameAndPassword {
+				diags.AddError(
+					"Unable to update MongoDB app connection",
+					"Invalid method. Only username-and-password method is supported",
+				)
+				return nil, diags
+			}
+
+			host := credentialsFromPlan.Host
+			if credentialsFromPlan.Host.IsUnknown() {
+				host = credentialsFromState.Host
+			}
+			if !host.IsNull() {
+				credentialsConfig["host"] = host.ValueString()
+			}
+
+			port := credentialsFromPlan.Port
+			if credentialsFromPlan.Port.IsUnknown() {
+				port = credentialsFromState.Port
+			}
+			if !port.IsNull() {
+				credentialsConfig["port"] = port.ValueInt32()
+			}
+
+			database := credentialsFromPlan.Database
+			if credentialsFromPlan.Database.IsUnknown() {
+				database = credentialsFromState.Database
+			}
+			if !database.IsNull() {
+				credentialsConfig["database"] = database.ValueString()
+			}
+
+			username := credentialsFromPlan.Username
+			if credentialsFromPlan.Username.IsUnknown() {
+				username = credentialsFromState.Username
+			}
+			if !username.IsNull() {
+				credentialsConfig["username"] = username.ValueString()
+			}
+
+			password := credentialsFromPlan.Password
+			if credentialsFromPlan.Password.IsUnknown() {
+				password = credentialsFromState.Password
+			}
+			if !password.IsNull() {
+				credentialsConfig["password"] = password.ValueString()
+			}
+
+			tlsEnabled := credentialsFromPlan.TlsEnabled
+			if credentialsFromPlan.TlsEnabled.IsUnknown() {
+				tlsEnabled = credentialsFromState.TlsEnabled
+			}
+			if !tlsEnabled.IsNull() {
+				credentialsConfig["tlsEnabled"] = tlsEnabled.ValueBool()
+			}
+
+			tlsRejectUnauthorized := credentialsFromPlan.TlsRejectUnauthorized
+			if credentialsFromPlan.TlsRejectUnauthorized.IsUnknown() {
+				tlsRejectUnauthorized = credentialsFromState.TlsRejectUnauthorized
+			}
+			if !tlsRejectUnauthorized.IsNull() {
+				credentialsConfig["tlsRejectUnauthorized"] = tlsRejectUnauthorized.ValueBool()
+			}
+
+			tlsCertificate := credentialsFromPlan.TlsCertificate
+			if credentialsFromPlan.TlsCertificate.IsUnknown() {
+				tlsCertificate = credentialsFromState.TlsCertificate
+			}
+			if !tlsCertificate.IsNull() {
+				credentialsConfig["tlsCertificate"] = tlsCertificate.ValueString()
+			}
+
+			return credentialsConfig, diags
+		},
+		OverwriteCredentialsFields: func(state *AppConnectionBaseResourceModel) diag.Diagnostics {
+			credentialsConfig := map[string]attr.Value{
+				"host":                    types.StringNull(),
+				"port":                    types.Int32Null(),
+				"database":                types.StringNull(),
+				"username":                types.StringNull(),
+				"password":                types.StringNull(),
+				"tls_enabled":             types.BoolNull(),
+				"tls_reject_unauthorized": types.BoolNull(),
+				"tls_certificate":         types.StringNull(),
+			}
+
+			var diags diag.Diagnostics
+			state.Credentials, diags = types.ObjectValue(map[string]attr.Type{
+				"host":                    types.StringType,
+				"port":                    types.Int32Type,
+				"database":                types.StringType,
+				"username":                types.StringType,
+				"password":                types.StringType,
+				"tls_enabled":             types.BoolType,
+				"tls_reject_unauthorized": types.BoolType,
+				"tls_certificate":         types.StringType,
+			}, credentialsConfig)
+
+			return diags
+		},
+	}
+}
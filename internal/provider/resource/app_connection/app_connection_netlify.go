@@ -0,0 +1,101 @@
+package resource
+
+import (
+	"context"
+	infisical "terraform-provider-infisical/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type AppConnectionNetlifyCredentialsModel struct {
+	AccessToken types.String `tfsdk:"access_token"`
+}
+
+const AppConnectionNetlifyAuthMethodAccessToken = "access-token"
+
+func NewAppConnectionNetlifyResource() resource.Resource {
+	return &AppConnectionBaseResource{
+		App:               infisical.AppConnectionAppNetlify,
+		AppConnectionName: "Netlify",
+		ResourceTypeName:  "_app_connection_netlify",
+		AllowedMethods:    []string{AppConnectionNetlifyAuthMethodAccessToken},
+		CredentialsAttributes: map[string]schema.Attribute{
+			"access_token": schema.StringAttribute{
+				Required:    true,
+				Description: "The Netlify personal access token for authentication.",
+				Sensitive:   true,
+			},
+		},
+		ReadCredentialsForCreateFromPlan: func(ctx context.Context, plan AppConnectionBaseResourceModel) (map[string]any, diag.Diagnostics) {
+			credentialsConfig := make(map[string]any)
+
+			var credentials AppConnectionNetlifyCredentialsModel
+			diags := plan.Credentials.As(ctx, &credentials, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			if plan.Method.ValueString() != AppConnectionNetlifyAuthMethodAccessToken {
+				diags.AddError(
+					"Unable to create Netlify app connection",
+					"Invalid method. Only access-token method is supported",
+				)
+				return nil, diags
+			}
+
+			credentialsConfig["accessToken"] = credentials.AccessToken.ValueString()
+
+			return credentialsConfig, diags
+		},
+		ReadCredentialsForUpdateFromPlan: func(ctx context.Context, plan AppConnectionBaseResourceModel, state AppConnectionBaseResourceModel) (map[string]any, diag.Diagnostics) {
+			credentialsConfig := make(map[string]any)
+
+			var credentialsFromPlan AppConnectionNetlifyCredentialsModel
+			diags := plan.Credentials.As(ctx, &credentialsFromPlan, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			var credentialsFromState AppConnectionNetlifyCredentialsModel
+			diags = state.Credentials.As(ctx, &credentialsFromState, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			if plan.Method.ValueString() != AppConnectionNetlifyAuthMethodAccessToken {
+				diags.AddError(
+					"Unable to update Netlify app connection",
+					"Invalid method. Only access-token method is supported",
+				)
+				return nil, diags
+			}
+
+			accessToken := credentialsFromPlan.AccessToken
+			if credentialsFromPlan.AccessToken.IsUnknown() {
+				accessToken = credentialsFromState.AccessToken
+			}
+			if !accessToken.IsNull() {
+				credentialsConfig["accessToken"] = accessToken.ValueString()
+			}
+
+			return credentialsConfig, diags
+		},
+		OverwriteCredentialsFields: func(state *AppConnectionBaseResourceModel) diag.Diagnostics {
+			credentialsConfig := map[string]attr.Value{
+				"access_token": types.StringNull(),
+			}
+
+			var diags diag.Diagnostics
+			state.Credentials, diags = types.ObjectValue(map[string]attr.Type{
+				"access_token": types.StringType,
+			}, credentialsConfig)
+
+			return diags
+		},
+	}
+}
@@ -0,0 +1,117 @@
+package resource
+
+import (
+	"context"
+	infisical "terraform-provider-infisical/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type AppConnectionOktaCredentialsModel struct {
+	InstanceUrl types.String `tfsdk:"instance_url"`
+	ApiToken    types.String `tfsdk:"api_token"`
+}
+
+const AppConnectionOktaAuthMethodApiToken = "api-token"
+
+func NewAppConnectionOktaResource() resource.Resource {
+	return &AppConnectionBaseResource{
+		App:               infisical.AppConnectionAppOkta,
+		AppConnectionName: "Okta",
+		ResourceTypeName:  "_app_connection_okta",
+		AllowedMethods:    []string{AppConnectionOktaAuthMethodApiToken},
+		CredentialsAttributes: map[string]schema.Attribute{
+			"instance_url": schema.StringAttribute{
+				Required:    true,
+				Description: "The URL of the Okta organization, e.g. `https://example.okta.com`.",
+			},
+			"api_token": schema.StringAttribute{
+				Required:    true,
+				Description: "The Okta API token for authentication.",
+				Sensitive:   true,
+			},
+		},
+		ReadCredentialsForCreateFromPlan: func(ctx context.Context, plan AppConnectionBaseResourceModel) (map[string]any, diag.Diagnostics) {
+			credentialsConfig := make(map[string]any)
+
+			var credentials AppConnectionOktaCredentialsModel
+			diags := plan.Credentials.As(ctx, &credentials, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			if plan.Method.ValueString() != AppConnectionOktaAuthMethodApiToken {
+				diags.AddError(
+					"Unable to create Okta app connection",
+					"Invalid method. Only api-token method is supported",
+				)
+				return nil, diags
+			}
+
+			credentialsConfig["instanceUrl"] = credentials.InstanceUrl.ValueString()
+			credentialsConfig["apiToken"] = credentials.ApiToken.ValueString()
+
+			return credentialsConfig, diags
+		},
+		ReadCredentialsForUpdateFromPlan: func(ctx context.Context, plan AppConnectionBaseResourceModel, state AppConnectionBaseResourceModel) (map[string]any, diag.Diagnostics) {
+			credentialsConfig := make(map[string]any)
+
+			var credentialsFromPlan AppConnectionOktaCredentialsModel
+			diags := plan.Credentials.As(ctx, &credentialsFromPlan, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			var credentialsFromState AppConnectionOktaCredentialsModel
+			diags = state.Credentials.As(ctx, &credentialsFromState, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			if plan.Method.ValueString() != AppConnectionOktaAuthMethodApiToken {
+				diags.AddError(
+					"Unable to update Okta app connection",
+					"Invalid method. Only api-token method is supported",
+				)
+				return nil, diags
+			}
+
+			instanceUrl := credentialsFromPlan.InstanceUrl
+			if credentialsFromPlan.InstanceUrl.IsUnknown() {
+				instanceUrl = credentialsFromState.InstanceUrl
+			}
+			if !instanceUrl.IsNull() {
+				credentialsConfig["instanceUrl"] = instanceUrl.ValueString()
+			}
+
+			apiToken := credentialsFromPlan.ApiToken
+			if credentialsFromPlan.ApiToken.IsUnknown() {
+				apiToken = credentialsFromState.ApiToken
+			}
+			if !apiToken.IsNull() {
+				credentialsConfig["apiToken"] = apiToken.ValueString()
+			}
+
+			return credentialsConfig, diags
+		},
+		OverwriteCredentialsFields: func(state *AppConnectionBaseResourceModel) diag.Diagnostics {
+			credentialsConfig := map[string]attr.Value{
+				"instance_url": types.StringNull(),
+				"api_token":    types.StringNull(),
+			}
+
+			var diags diag.Diagnostics
+			state.Credentials, diags = types.ObjectValue(map[string]attr.Type{
+				"instance_url": types.StringType,
+				"api_token":    types.StringType,
+			}, credentialsConfig)
+
+			return diags
+		},
+	}
+}
@@ -0,0 +1,101 @@
+package resource
+
+import (
+	"context"
+	infisical "terraform-provider-infisical/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type AppConnectionTerraformCloudCredentialsModel struct {
+	ApiToken types.String `tfsdk:"api_token"`
+}
+
+const AppConnectionTerraformCloudAuthMethodApiToken = "api-token"
+
+func NewAppConnectionTerraformCloudResource() resource.Resource {
+	return &AppConnectionBaseResource{
+		App:               infisical.AppConnectionAppTerraformCloud,
+		AppConnectionName: "Terraform Cloud",
+		ResourceTypeName:  "_app_connection_terraform_cloud",
+		AllowedMethods:    []string{AppConnectionTerraformCloudAuthMethodApiToken},
+		CredentialsAttributes: map[string]schema.Attribute{
+			"api_token": schema.StringAttribute{
+				Required:    true,
+				Description: "The Terraform Cloud / HCP Terraform user, team or organization API token used to access the destination.",
+				Sensitive:   true,
+			},
+		},
+		ReadCredentialsForCreateFromPlan: func(ctx context.Context, plan AppConnectionBaseResourceModel) (map[string]any, diag.Diagnostics) {
+			credentialsConfig := make(map[string]any)
+
+			var credentials AppConnectionTerraformCloudCredentialsModel
+			diags := plan.Credentials.As(ctx, &credentials, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			if plan.Method.ValueString() != AppConnectionTerraformCloudAuthMethodApiToken {
+				diags.AddError(
+					"Unable to create Terraform Cloud app connection",
+					"Invalid method. Only api-token method is supported",
+				)
+				return nil, diags
+			}
+
+			credentialsConfig["apiToken"] = credentials.ApiToken.ValueString()
+
+			return credentialsConfig, diags
+		},
+		ReadCredentialsForUpdateFromPlan: func(ctx context.Context, plan AppConnectionBaseResourceModel, state AppConnectionBaseResourceModel) (map[string]any, diag.Diagnostics) {
+			credentialsConfig := make(map[string]any)
+
+			var credentialsFromPlan AppConnectionTerraformCloudCredentialsModel
+			diags := plan.Credentials.As(ctx, &credentialsFromPlan, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			var credentialsFromState AppConnectionTerraformCloudCredentialsModel
+			diags = state.Credentials.As(ctx, &credentialsFromState, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			if plan.Method.ValueString() != AppConnectionTerraformCloudAuthMethodApiToken {
+				diags.AddError(
+					"Unable to update Terraform Cloud app connection",
+					"Invalid method. Only api-token method is supported",
+				)
+				return nil, diags
+			}
+
+			apiToken := credentialsFromPlan.ApiToken
+			if credentialsFromPlan.ApiToken.IsUnknown() {
+				apiToken = credentialsFromState.ApiToken
+			}
+			if !apiToken.IsNull() {
+				credentialsConfig["apiToken"] = apiToken.ValueString()
+			}
+
+			return credentialsConfig, diags
+		},
+		OverwriteCredentialsFields: func(state *AppConnectionBaseResourceModel) diag.Diagnostics {
+			credentialsConfig := map[string]attr.Value{
+				"api_token": types.StringNull(),
+			}
+
+			var diags diag.Diagnostics
+			state.Credentials, diags = types.ObjectValue(map[string]attr.Type{
+				"api_token": types.StringType,
+			}, credentialsConfig)
+
+			return diags
+		},
+	}
+}
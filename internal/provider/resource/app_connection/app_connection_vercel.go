@@ -0,0 +1,101 @@
+package resource
+
+import (
+	"context"
+	infisical "terraform-provider-infisical/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type AppConnectionVercelCredentialsModel struct {
+	ApiToken types.String `tfsdk:"api_token"`
+}
+
+const AppConnectionVercelAuthMethodApiToken = "api-token"
+
+func NewAppConnectionVercelResource() resource.Resource {
+	return &AppConnectionBaseResource{
+		App:               infisical.AppConnectionAppVercel,
+		AppConnectionName: "Vercel",
+		ResourceTypeName:  "_app_connection_vercel",
+		AllowedMethods:    []string{AppConnectionVercelAuthMethodApiToken},
+		CredentialsAttributes: map[string]schema.Attribute{
+			"api_token": schema.StringAttribute{
+				Required:    true,
+				Description: "The Vercel API token for authentication.",
+				Sensitive:   true,
+			},
+		},
+		ReadCredentialsForCreateFromPlan: func(ctx context.Context, plan AppConnectionBaseResourceModel) (map[string]any, diag.Diagnostics) {
+			credentialsConfig := make(map[string]any)
+
+			var credentials AppConnectionVercelCredentialsModel
+			diags := plan.Credentials.As(ctx, &credentials, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			if plan.Method.ValueString() != AppConnectionVercelAuthMethodApiToken {
+				diags.AddError(
+					"Unable to create Vercel app connection",
+					"Invalid method. Only api-token method is supported",
+				)
+				return nil, diags
+			}
+
+			credentialsConfig["apiToken"] = credentials.ApiToken.ValueString()
+
+			return credentialsConfig, diags
+		},
+		ReadCredentialsForUpdateFromPlan: func(ctx context.Context, plan AppConnectionBaseResourceModel, state AppConnectionBaseResourceModel) (map[string]any, diag.Diagnostics) {
+			credentialsConfig := make(map[string]any)
+
+			var credentialsFromPlan AppConnectionVercelCredentialsModel
+			diags := plan.Credentials.As(ctx, &credentialsFromPlan, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			var credentialsFromState AppConnectionVercelCredentialsModel
+			diags = state.Credentials.As(ctx, &credentialsFromState, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			if plan.Method.ValueString() != AppConnectionVercelAuthMethodApiToken {
+				diags.AddError(
+					"Unable to update Vercel app connection",
+					"Invalid method. Only api-token method is supported",
+				)
+				return nil, diags
+			}
+
+			apiToken := credentialsFromPlan.ApiToken
+			if credentialsFromPlan.ApiToken.IsUnknown() {
+				apiToken = credentialsFromState.ApiToken
+			}
+			if !apiToken.IsNull() {
+				credentialsConfig["apiToken"] = apiToken.ValueString()
+			}
+
+			return credentialsConfig, diags
+		},
+		OverwriteCredentialsFields: func(state *AppConnectionBaseResourceModel) diag.Diagnostics {
+			credentialsConfig := map[string]attr.Value{
+				"api_token": types.StringNull(),
+			}
+
+			var diags diag.Diagnostics
+			state.Credentials, diags = types.ObjectValue(map[string]attr.Type{
+				"api_token": types.StringType,
+			}, credentialsConfig)
+
+			return diags
+		},
+	}
+}
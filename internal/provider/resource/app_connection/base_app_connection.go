@@ -4,18 +4,22 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"math/rand/v2"
 	"strings"
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -27,6 +31,7 @@ type AppConnectionBaseResource struct {
 	SupportsGateway                  bool                       // when true, exposes gateway_id and sends it to the API
 	client                           *infisical.Client
 	AllowedMethods                   []string
+	MethodCredentials                map[string]AppConnectionMethodCredentials // when set, checked against the plan for the chosen method
 	CredentialsAttributes            map[string]schema.Attribute
 	ReadCredentialsForCreateFromPlan func(ctx context.Context, plan AppConnectionBaseResourceModel) (map[string]any, diag.Diagnostics)
 	ReadCredentialsForUpdateFromPlan func(ctx context.Context, plan AppConnectionBaseResourceModel, state AppConnectionBaseResourceModel) (map[string]any, diag.Diagnostics)
@@ -36,6 +41,12 @@ type AppConnectionBaseResource struct {
 	IsRetryableError func(err error) bool
 }
 
+// AppConnectionMethodCredentials lists the credentials attributes an authentication method uses.
+type AppConnectionMethodCredentials struct {
+	Required []string // must be set for the method
+	Unused   []string // belong to other methods; rejected so that credentials the method doesn't need are never kept in state
+}
+
 const (
 	appConnectionMaxRetries    = 3
 	appConnectionInitialDelay  = 10 * time.Second
@@ -91,6 +102,21 @@ func jitter(d time.Duration, fraction float64) time.Duration {
 	return d - delta + time.Duration(rand.Int64N(int64(2*delta+1)))
 }
 
+// apiFields maps the fields of the app connection payload to the resource's attributes.
+func (r *AppConnectionBaseResource) apiFields() infisicaltf.APIFields {
+	fields := infisicaltf.APIFields{
+		"name":        path.Root("name"),
+		"method":      path.Root("method"),
+		"description": path.Root("description"),
+		"projectId":   path.Root("project_id"),
+	}.WithObject("credentials", path.Root("credentials"), r.CredentialsAttributes)
+
+	if r.SupportsGateway {
+		fields["gatewayId"] = path.Root("gateway_id")
+	}
+	return fields
+}
+
 type AppConnectionBaseResourceModel struct {
 	ID              types.String `tfsdk:"id"`
 	Name            types.String `tfsdk:"name"`
@@ -99,6 +125,7 @@ type AppConnectionBaseResourceModel struct {
 	ProjectId       types.String `tfsdk:"project_id"`
 	Credentials     types.Object `tfsdk:"credentials"`
 	CredentialsHash types.String `tfsdk:"credentials_hash"`
+	DriftDetection  types.String `tfsdk:"drift_detection"`
 }
 
 // appConnectionGatewayResourceModel is used for plan/state I/O on connections that support
@@ -170,6 +197,80 @@ func (r *AppConnectionBaseResource) gatewayIdForRequest(gatewayId types.String)
 	return &value
 }
 
+// validateMethodCredentials checks the planned credentials against the credentials the method
+// uses. Credentials that are unknown in the plan are taken from the state, if any.
+func (r *AppConnectionBaseResource) validateMethodCredentials(method string, planned types.Object, state types.Object, summary string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	methodCredentials, ok := r.MethodCredentials[method]
+	if !ok {
+		return diags
+	}
+
+	credentials := planned.Attributes()
+	for _, name := range methodCredentials.Required {
+		value := credentials[name]
+		if value != nil && value.IsUnknown() && !state.IsNull() {
+			value = state.Attributes()[name]
+		}
+		if value == nil || infisicaltf.IsAttrValueEmpty(value) {
+			diags.AddAttributeError(
+				path.Root("credentials").AtName(name),
+				summary,
+				fmt.Sprintf("%s is required when method is '%s'", name, method),
+			)
+		}
+	}
+
+	for _, name := range methodCredentials.Unused {
+		if value, ok := credentials[name]; ok && !infisicaltf.IsAttrValueEmpty(value) {
+			diags.AddAttributeError(
+				path.Root("credentials").AtName(name),
+				summary,
+				fmt.Sprintf("%s is not used when method is '%s' and must not be set", name, method),
+			)
+		}
+	}
+
+	return diags
+}
+
+// withWriteOnlyCredentials returns the plan with the write-only credentials filled in from the
+// configuration. Terraform never plans write-only values, so they can only be read from config.
+func (r *AppConnectionBaseResource) withWriteOnlyCredentials(ctx context.Context, plan AppConnectionBaseResourceModel, config tfsdk.Config) (AppConnectionBaseResourceModel, diag.Diagnostics) {
+	var writeOnly []string
+	for name, attribute := range r.CredentialsAttributes {
+		if attribute.IsWriteOnly() {
+			writeOnly = append(writeOnly, name)
+		}
+	}
+	if len(writeOnly) == 0 || plan.Credentials.IsNull() || plan.Credentials.IsUnknown() {
+		return plan, nil
+	}
+
+	var configCredentials types.Object
+	diags := config.GetAttribute(ctx, path.Root("credentials"), &configCredentials)
+	if diags.HasError() || configCredentials.IsNull() || configCredentials.IsUnknown() {
+		return plan, diags
+	}
+
+	attributes := maps.Clone(plan.Credentials.Attributes())
+	for _, name := range writeOnly {
+		if value, ok := configCredentials.Attributes()[name]; ok {
+			attributes[name] = value
+		}
+	}
+
+	credentials, objectDiags := types.ObjectValue(plan.Credentials.AttributeTypes(ctx), attributes)
+	diags.Append(objectDiags...)
+	if diags.HasError() {
+		return plan, diags
+	}
+
+	plan.Credentials = credentials
+	return plan, diags
+}
+
 // Metadata returns the resource type name.
 func (r *AppConnectionBaseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + r.ResourceTypeName
@@ -208,6 +309,11 @@ func (r *AppConnectionBaseResource) Schema(_ context.Context, _ resource.SchemaR
 			Computed:    true,
 			Description: fmt.Sprintf("The hash of the %s App Connection credentials", r.AppConnectionName),
 		},
+		"drift_detection": schema.StringAttribute{
+			Optional:    true,
+			Description: infisicaltf.DriftDetectionDescription("app connection credentials", infisicaltf.DriftDetectionReconcile),
+			Validators:  []validator.String{infisicaltf.DriftDetectionValidator},
+		},
 	}
 
 	if r.SupportsGateway {
@@ -279,6 +385,17 @@ func (r *AppConnectionBaseResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	plan, diags = r.withWriteOnlyCredentials(ctx, plan, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.validateMethodCredentials(plan.Method.ValueString(), plan.Credentials, types.ObjectNull(nil), "Unable to create app connection")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	credentialsMap, diags := r.ReadCredentialsForCreateFromPlan(ctx, plan)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
@@ -298,21 +415,18 @@ func (r *AppConnectionBaseResource) Create(ctx context.Context, req resource.Cre
 		}
 
 		if r.SupportsGateway {
-			appConnection, apiErr = r.client.CreateAppConnectionWithGateway(infisical.CreateAppConnectionWithGateway{
+			appConnection, apiErr = r.client.CreateAppConnectionWithGateway(ctx, infisical.CreateAppConnectionWithGateway{
 				CreateAppConnectionRequest: baseRequest,
 				GatewayId:                  r.gatewayIdForRequest(gatewayId),
 			})
 		} else {
-			appConnection, apiErr = r.client.CreateAppConnection(baseRequest)
+			appConnection, apiErr = r.client.CreateAppConnection(ctx, baseRequest)
 		}
 		return apiErr
 	})
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating app connection",
-			"Couldn't create app connection, unexpected error: "+err.Error(),
-		)
+		infisicaltf.AddAPIError(&resp.Diagnostics, "Error creating app connection", "Couldn't create app connection, unexpected error: ", err, r.apiFields())
 		return
 	}
 
@@ -344,43 +458,52 @@ func (r *AppConnectionBaseResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
-	appConnection, err := r.client.GetAppConnectionById(infisical.GetAppConnectionByIdRequest{
+	appConnection, err := r.client.GetAppConnectionById(ctx, infisical.GetAppConnectionByIdRequest{
 		App: r.App,
 		ID:  state.ID.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
-			return
-		} else {
-			resp.Diagnostics.AddError(
-				"Error reading app connection",
-				"Couldn't read app connection, unexpected error: "+err.Error(),
-			)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
+		resp.Diagnostics.AddError(
+			"Error reading app connection",
+			"Couldn't read app connection, unexpected error: "+err.Error(),
+		)
+		return
 	}
 
 	// Reconcile gateway_id from the API so out-of-band changes are detected as drift.
 	gatewayId = r.reconcileGatewayId(gatewayId, appConnection)
 
 	if state.CredentialsHash.ValueString() != appConnection.CredentialsHash {
-		resp.Diagnostics.AddWarning(
-			"App connection credentials conflict",
-			fmt.Sprintf("The credentials for the %s App Connection with ID %s have been updated outside of Terraform.", r.AppConnectionName, state.ID.ValueString()),
-		)
+		switch infisicaltf.ResolveDriftDetectionMode(state.DriftDetection, infisicaltf.DriftDetectionReconcile) {
+		case infisicaltf.DriftDetectionIgnore:
+			// accept the remote credentials as the new baseline
+			state.CredentialsHash = types.StringValue(appConnection.CredentialsHash)
+		case infisicaltf.DriftDetectionWarn:
+			resp.Diagnostics.AddWarning(
+				"App connection credentials conflict",
+				fmt.Sprintf("The credentials for the %s App Connection with ID %s have been updated outside of Terraform.", r.AppConnectionName, state.ID.ValueString()),
+			)
+		default:
+			resp.Diagnostics.AddWarning(
+				"App connection credentials conflict",
+				fmt.Sprintf("The credentials for the %s App Connection with ID %s have been updated outside of Terraform.", r.AppConnectionName, state.ID.ValueString()),
+			)
 
-		// force TF update
-		diags = r.OverwriteCredentialsFields(&state)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
+			// force TF update
+			diags = r.OverwriteCredentialsFields(&state)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			diags = resp.State.Set(ctx, r.stateValue(state, gatewayId))
+			resp.Diagnostics.Append(diags...)
 			return
 		}
-
-		diags = resp.State.Set(ctx, r.stateValue(state, gatewayId))
-		resp.Diagnostics.Append(diags...)
-		return
 	}
 
 	if !(state.Description.IsNull() && appConnection.Description == "") {
@@ -438,6 +561,17 @@ func (r *AppConnectionBaseResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	plan, diags = r.withWriteOnlyCredentials(ctx, plan, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.validateMethodCredentials(plan.Method.ValueString(), plan.Credentials, state.Credentials, "Unable to update app connection")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	credentialsMap, diags := r.ReadCredentialsForUpdateFromPlan(ctx, plan, state)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
@@ -458,21 +592,18 @@ func (r *AppConnectionBaseResource) Update(ctx context.Context, req resource.Upd
 		}
 
 		if r.SupportsGateway {
-			appConnection, apiErr = r.client.UpdateAppConnectionWithGateway(infisical.UpdateAppConnectionWithGateway{
+			appConnection, apiErr = r.client.UpdateAppConnectionWithGateway(ctx, infisical.UpdateAppConnectionWithGateway{
 				UpdateAppConnectionRequest: baseRequest,
 				GatewayId:                  r.gatewayIdForRequest(gatewayId),
 			})
 		} else {
-			appConnection, apiErr = r.client.UpdateAppConnection(baseRequest)
+			appConnection, apiErr = r.client.UpdateAppConnection(ctx, baseRequest)
 		}
 		return apiErr
 	})
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating app connection",
-			"Couldn't update app connection, unexpected error: "+err.Error(),
-		)
+		infisicaltf.AddAPIError(&resp.Diagnostics, "Error updating app connection", "Couldn't update app connection, unexpected error: ", err, r.apiFields())
 		return
 	}
 
@@ -501,7 +632,7 @@ func (r *AppConnectionBaseResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
-	_, err := r.client.DeleteAppConnection(infisical.DeleteAppConnectionRequest{
+	_, err := r.client.DeleteAppConnection(ctx, infisical.DeleteAppConnectionRequest{
 		App: r.App,
 		ID:  state.ID.ValueString(),
 	})
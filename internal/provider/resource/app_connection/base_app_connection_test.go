@@ -0,0 +1,251 @@
+package resource
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
+	"terraform-provider-infisical/internal/provider/resource/resourcetest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func azureCredentials(t *testing.T, tenantId, clientId, clientSecret types.String) types.Object {
+	t.Helper()
+
+	credentials, diags := types.ObjectValue(map[string]attr.Type{
+		"tenant_id":     types.StringType,
+		"client_id":     types.StringType,
+		"client_secret": types.StringType,
+	}, map[string]attr.Value{
+		"tenant_id":     tenantId,
+		"client_id":     clientId,
+		"client_secret": clientSecret,
+	})
+	if diags.HasError() {
+		t.Fatalf("building credentials: %v", diags)
+	}
+	return credentials
+}
+
+func TestValidateMethodCredentials(t *testing.T) {
+	r, ok := NewAppConnectionAzureKeyVaultResource().(*AppConnectionBaseResource)
+	if !ok {
+		t.Fatal("the Azure Key Vault app connection is not an AppConnectionBaseResource")
+	}
+
+	value := types.StringValue
+	null := types.StringNull()
+	unknown := types.StringUnknown()
+
+	cases := map[string]struct {
+		method     string
+		planned    types.Object
+		state      types.Object
+		wantErrors []string
+	}{
+		"client secret": {
+			method:  AzureKeyVaultAppConnectionClientSecretsMethod,
+			planned: azureCredentials(t, value("tenant"), value("client"), value("secret")),
+		},
+		"client secret missing": {
+			method:     AzureKeyVaultAppConnectionClientSecretsMethod,
+			planned:    azureCredentials(t, value("tenant"), value("client"), null),
+			wantErrors: []string{"client_secret"},
+		},
+		"client secret unknown, taken from state": {
+			method:  AzureKeyVaultAppConnectionClientSecretsMethod,
+			planned: azureCredentials(t, value("tenant"), value("client"), unknown),
+			state:   azureCredentials(t, value("tenant"), value("client"), value("secret")),
+		},
+		"federated credential": {
+			method:  AzureKeyVaultAppConnectionFederatedCredentialMethod,
+			planned: azureCredentials(t, value("tenant"), value("client"), null),
+		},
+		"federated credential with a client secret": {
+			method:     AzureKeyVaultAppConnectionFederatedCredentialMethod,
+			planned:    azureCredentials(t, value("tenant"), value("client"), value("secret")),
+			wantErrors: []string{"client_secret"},
+		},
+		"federated credential missing everything": {
+			method:     AzureKeyVaultAppConnectionFederatedCredentialMethod,
+			planned:    azureCredentials(t, null, value(""), null),
+			wantErrors: []string{"tenant_id", "client_id"},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			state := c.state
+			if state.IsNull() {
+				state = types.ObjectNull(nil)
+			}
+
+			diags := r.validateMethodCredentials(c.method, c.planned, state, "Unable to create app connection")
+			if diags.ErrorsCount() != len(c.wantErrors) {
+				t.Fatalf("got %d errors, want %d: %v", diags.ErrorsCount(), len(c.wantErrors), diags)
+			}
+			for _, name := range c.wantErrors {
+				found := false
+				for _, d := range diags.Errors() {
+					if withPath, ok := d.(diag.DiagnosticWithPath); ok && withPath.Path().Equal(path.Root("credentials").AtName(name)) {
+						found = true
+					}
+				}
+				if !found {
+					t.Errorf("no error reported against credentials.%s: %v", name, diags)
+				}
+			}
+		})
+	}
+}
+
+func TestReadCredentialsDrift(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"appConnection":{"id":"connection-id","name":"mysql","method":"username-and-password","credentialsHash":"remote"}}`))
+	}))
+	defer server.Close()
+
+	cases := map[string]struct {
+		appliedHash    string
+		driftDetection any
+		wantWarning    bool
+		wantHash       string
+		wantHost       types.String
+	}{
+		"unchanged credentials": {
+			appliedHash: "remote",
+			wantHash:    "remote",
+			wantHost:    types.StringValue("db"),
+		},
+		"reconcile by default": {
+			appliedHash: "applied",
+			wantWarning: true,
+			wantHash:    "applied",
+			wantHost:    types.StringNull(),
+		},
+		"warn": {
+			appliedHash:    "applied",
+			driftDetection: infisicaltf.DriftDetectionWarn,
+			wantWarning:    true,
+			wantHash:       "applied",
+			wantHost:       types.StringValue("db"),
+		},
+		"ignore": {
+			appliedHash:    "applied",
+			driftDetection: infisicaltf.DriftDetectionIgnore,
+			wantHash:       "remote",
+			wantHost:       types.StringValue("db"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := resourcetest.Configure(t, NewAppConnectionMySqlResource(), server)
+			state := resourcetest.State(t, r, map[string]any{
+				"id":     "connection-id",
+				"name":   "mysql",
+				"method": "username-and-password",
+				"credentials": map[string]any{
+					"host":     "db",
+					"port":     int64(3306),
+					"database": "app",
+					"username": "terraform",
+				},
+				"credentials_hash": c.appliedHash,
+				"drift_detection":  c.driftDetection,
+			})
+
+			resp := resource.ReadResponse{State: state}
+			r.Read(context.Background(), resource.ReadRequest{State: state}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var hash, host types.String
+			resp.Diagnostics.Append(resp.State.GetAttribute(context.Background(), path.Root("credentials_hash"), &hash)...)
+			resp.Diagnostics.Append(resp.State.GetAttribute(context.Background(), path.Root("credentials").AtName("host"), &host)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("reading the state: %v", resp.Diagnostics)
+			}
+
+			if got := resp.Diagnostics.WarningsCount() > 0; got != c.wantWarning {
+				t.Errorf("warned = %t, want %t: %v", got, c.wantWarning, resp.Diagnostics)
+			}
+			if hash.ValueString() != c.wantHash {
+				t.Errorf("got credentials_hash %q, want %q", hash.ValueString(), c.wantHash)
+			}
+			if !host.Equal(c.wantHost) {
+				t.Errorf("got credentials.host %s, want %s", host, c.wantHost)
+			}
+		})
+	}
+}
+
+func TestMethodCredentialsOfGithubAndKubernetes(t *testing.T) {
+	cases := map[string]struct {
+		newResource func() resource.Resource
+		method      string
+		credentials map[string]any
+		wantErrors  []string
+	}{
+		"github pat": {
+			newResource: NewAppConnectionGithubResource,
+			method:      AppConnectionGithubAuthMethodPat,
+			credentials: map[string]any{"personal_access_token": "ghp_token"},
+		},
+		"github pat with an app id": {
+			newResource: NewAppConnectionGithubResource,
+			method:      AppConnectionGithubAuthMethodPat,
+			credentials: map[string]any{"personal_access_token": "ghp_token", "app_id": "1"},
+			wantErrors:  []string{"app_id"},
+		},
+		"github app missing its private key": {
+			newResource: NewAppConnectionGithubResource,
+			method:      AppConnectionGithubAuthMethodGithubApp,
+			credentials: map[string]any{"app_id": "1", "installation_id": "2"},
+			wantErrors:  []string{"private_key_wo"},
+		},
+		"kubernetes service account token missing its URL": {
+			newResource: NewAppConnectionKubernetesResource,
+			method:      AppConnectionKubernetesAuthMethodServiceAccountToken,
+			credentials: map[string]any{"service_account_token": "token"},
+			wantErrors:  []string{"url"},
+		},
+		"kubeconfig with a CA certificate": {
+			newResource: NewAppConnectionKubernetesResource,
+			method:      AppConnectionKubernetesAuthMethodKubeconfig,
+			credentials: map[string]any{"kubeconfig": "apiVersion: v1", "ssl_certificate": "-----BEGIN CERTIFICATE-----"},
+			wantErrors:  []string{"ssl_certificate"},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := c.newResource()
+			state := resourcetest.State(t, r, map[string]any{"credentials": c.credentials})
+
+			var planned types.Object
+			if diags := state.GetAttribute(context.Background(), path.Root("credentials"), &planned); diags.HasError() {
+				t.Fatalf("reading the credentials: %v", diags)
+			}
+
+			diags := r.(*AppConnectionBaseResource).validateMethodCredentials(c.method, planned, types.ObjectNull(nil), "Unable to create app connection")
+			if diags.ErrorsCount() != len(c.wantErrors) {
+				t.Fatalf("got %d errors, want %d: %v", diags.ErrorsCount(), len(c.wantErrors), diags)
+			}
+			for i, name := range c.wantErrors {
+				withPath, ok := diags.Errors()[i].(diag.DiagnosticWithPath)
+				if !ok || !withPath.Path().Equal(path.Root("credentials").AtName(name)) {
+					t.Errorf("no error reported against credentials.%s: %v", name, diags)
+				}
+			}
+		})
+	}
+}
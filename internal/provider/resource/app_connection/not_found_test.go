@@ -0,0 +1,10 @@
+package resource
+
+import (
+	"terraform-provider-infisical/internal/provider/resource/resourcetest"
+	"testing"
+)
+
+func TestReadRemovesAppConnectionsDeletedOutsideOfTerraform(t *testing.T) {
+	resourcetest.AssertReadRemovesWhenNotFound(t, NewAppConnectionMySqlResource, map[string]any{"id": "app-connection-id"})
+}
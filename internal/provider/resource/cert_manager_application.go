@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -99,7 +100,7 @@ func (r *certManagerApplicationResource) Create(ctx context.Context, req resourc
 		createRequest.Description = &description
 	}
 
-	application, err := r.client.CreatePkiApplication(createRequest)
+	application, err := r.client.CreatePkiApplication(ctx, createRequest)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating Certificate Manager application",
@@ -134,12 +135,11 @@ func (r *certManagerApplicationResource) Read(ctx context.Context, req resource.
 		return
 	}
 
-	application, err := r.client.GetPkiApplication(infisical.GetPkiApplicationRequest{
+	application, err := r.client.GetPkiApplication(ctx, infisical.GetPkiApplicationRequest{
 		ApplicationId: state.Id.ValueString(),
 	})
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
 		resp.Diagnostics.AddError(
@@ -184,7 +184,7 @@ func (r *certManagerApplicationResource) Update(ctx context.Context, req resourc
 		updateRequest.Description = &description
 	}
 
-	application, err := r.client.UpdatePkiApplication(updateRequest)
+	application, err := r.client.UpdatePkiApplication(ctx, updateRequest)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating Certificate Manager application",
@@ -219,7 +219,7 @@ func (r *certManagerApplicationResource) Delete(ctx context.Context, req resourc
 		return
 	}
 
-	_, err := r.client.DeletePkiApplication(infisical.DeletePkiApplicationRequest{
+	_, err := r.client.DeletePkiApplication(ctx, infisical.DeletePkiApplicationRequest{
 		ApplicationId: state.Id.ValueString(),
 	})
 	if err != nil {
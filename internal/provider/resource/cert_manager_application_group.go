@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -87,8 +88,8 @@ func (r *certManagerApplicationGroupResource) Configure(_ context.Context, req r
 	r.client = client
 }
 
-func (r *certManagerApplicationGroupResource) findMembershipByGroupId(applicationId, groupId string) (*infisical.PkiApplicationMember, error) {
-	members, err := r.client.ListPkiApplicationGroupMembers(infisical.ListPkiApplicationGroupMembersRequest{
+func (r *certManagerApplicationGroupResource) findMembershipByGroupId(ctx context.Context, applicationId, groupId string) (*infisical.PkiApplicationMember, error) {
+	members, err := r.client.ListPkiApplicationGroupMembers(ctx, infisical.ListPkiApplicationGroupMembersRequest{
 		ApplicationId: applicationId,
 	})
 	if err != nil {
@@ -120,7 +121,7 @@ func (r *certManagerApplicationGroupResource) Create(ctx context.Context, req re
 		return
 	}
 
-	added, err := r.client.AddPkiApplicationGroupMember(infisical.AddPkiApplicationGroupMemberRequest{
+	added, err := r.client.AddPkiApplicationGroupMember(ctx, infisical.AddPkiApplicationGroupMemberRequest{
 		ApplicationId: plan.ApplicationId.ValueString(),
 		GroupId:       plan.GroupId.ValueString(),
 		Role:          plan.Role.ValueString(),
@@ -157,10 +158,9 @@ func (r *certManagerApplicationGroupResource) Read(ctx context.Context, req reso
 		return
 	}
 
-	member, err := r.findMembershipByGroupId(state.ApplicationId.ValueString(), state.GroupId.ValueString())
+	member, err := r.findMembershipByGroupId(ctx, state.ApplicationId.ValueString(), state.GroupId.ValueString())
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
 		resp.Diagnostics.AddError(
@@ -202,7 +202,7 @@ func (r *certManagerApplicationGroupResource) Update(ctx context.Context, req re
 		return
 	}
 
-	updateResp, err := r.client.UpdatePkiApplicationGroupMemberRole(infisical.UpdatePkiApplicationGroupMemberRoleRequest{
+	updateResp, err := r.client.UpdatePkiApplicationGroupMemberRole(ctx, infisical.UpdatePkiApplicationGroupMemberRoleRequest{
 		ApplicationId: plan.ApplicationId.ValueString(),
 		GroupId:       plan.GroupId.ValueString(),
 		Role:          plan.Role.ValueString(),
@@ -237,7 +237,7 @@ func (r *certManagerApplicationGroupResource) Delete(ctx context.Context, req re
 		return
 	}
 
-	_, err := r.client.RemovePkiApplicationGroupMember(infisical.RemovePkiApplicationGroupMemberRequest{
+	_, err := r.client.RemovePkiApplicationGroupMember(ctx, infisical.RemovePkiApplicationGroupMemberRequest{
 		ApplicationId: state.ApplicationId.ValueString(),
 		GroupId:       state.GroupId.ValueString(),
 	})
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -87,8 +88,8 @@ func (r *certManagerApplicationIdentityResource) Configure(_ context.Context, re
 	r.client = client
 }
 
-func (r *certManagerApplicationIdentityResource) findMembershipByIdentityId(applicationId, identityId string) (*infisical.PkiApplicationMember, error) {
-	members, err := r.client.ListPkiApplicationIdentityMembers(infisical.ListPkiApplicationIdentityMembersRequest{
+func (r *certManagerApplicationIdentityResource) findMembershipByIdentityId(ctx context.Context, applicationId, identityId string) (*infisical.PkiApplicationMember, error) {
+	members, err := r.client.ListPkiApplicationIdentityMembers(ctx, infisical.ListPkiApplicationIdentityMembersRequest{
 		ApplicationId: applicationId,
 	})
 	if err != nil {
@@ -120,7 +121,7 @@ func (r *certManagerApplicationIdentityResource) Create(ctx context.Context, req
 		return
 	}
 
-	added, err := r.client.AddPkiApplicationIdentityMember(infisical.AddPkiApplicationIdentityMemberRequest{
+	added, err := r.client.AddPkiApplicationIdentityMember(ctx, infisical.AddPkiApplicationIdentityMemberRequest{
 		ApplicationId: plan.ApplicationId.ValueString(),
 		IdentityId:    plan.IdentityId.ValueString(),
 		Role:          plan.Role.ValueString(),
@@ -157,10 +158,9 @@ func (r *certManagerApplicationIdentityResource) Read(ctx context.Context, req r
 		return
 	}
 
-	member, err := r.findMembershipByIdentityId(state.ApplicationId.ValueString(), state.IdentityId.ValueString())
+	member, err := r.findMembershipByIdentityId(ctx, state.ApplicationId.ValueString(), state.IdentityId.ValueString())
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
 		resp.Diagnostics.AddError(
@@ -202,7 +202,7 @@ func (r *certManagerApplicationIdentityResource) Update(ctx context.Context, req
 		return
 	}
 
-	updateResp, err := r.client.UpdatePkiApplicationIdentityMemberRole(infisical.UpdatePkiApplicationIdentityMemberRoleRequest{
+	updateResp, err := r.client.UpdatePkiApplicationIdentityMemberRole(ctx, infisical.UpdatePkiApplicationIdentityMemberRoleRequest{
 		ApplicationId: plan.ApplicationId.ValueString(),
 		IdentityId:    plan.IdentityId.ValueString(),
 		Role:          plan.Role.ValueString(),
@@ -237,7 +237,7 @@ func (r *certManagerApplicationIdentityResource) Delete(ctx context.Context, req
 		return
 	}
 
-	_, err := r.client.RemovePkiApplicationIdentityMember(infisical.RemovePkiApplicationIdentityMemberRequest{
+	_, err := r.client.RemovePkiApplicationIdentityMember(ctx, infisical.RemovePkiApplicationIdentityMemberRequest{
 		ApplicationId: state.ApplicationId.ValueString(),
 		IdentityId:    state.IdentityId.ValueString(),
 	})
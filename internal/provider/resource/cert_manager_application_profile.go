@@ -2,6 +2,7 @@ package resource
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	infisical "terraform-provider-infisical/internal/client"
@@ -248,7 +249,7 @@ func (r *certManagerApplicationProfileResource) Configure(_ context.Context, req
 	r.client = client
 }
 
-func (r *certManagerApplicationProfileResource) applyEnrollment(
+func (r *certManagerApplicationProfileResource) applyEnrollment(ctx context.Context,
 	prior, plan *certManagerApplicationProfileResourceModel,
 	diags interface{ AddError(string, string) },
 ) {
@@ -257,7 +258,7 @@ func (r *certManagerApplicationProfileResource) applyEnrollment(
 
 	switch {
 	case plan.ApiConfig == nil && prior != nil && prior.ApiConfig != nil:
-		if _, err := r.client.ClearPkiApplicationApiEnrollment(infisical.ClearPkiApplicationApiEnrollmentRequest{
+		if _, err := r.client.ClearPkiApplicationApiEnrollment(ctx, infisical.ClearPkiApplicationApiEnrollmentRequest{
 			ApplicationId: appId, ProfileId: profileId,
 		}); err != nil {
 			diags.AddError(
@@ -275,7 +276,7 @@ func (r *certManagerApplicationProfileResource) applyEnrollment(
 			v := int(plan.ApiConfig.RenewBeforeDays.ValueInt64())
 			setReq.RenewBeforeDays = &v
 		}
-		if _, err := r.client.SetPkiApplicationApiEnrollment(setReq); err != nil {
+		if _, err := r.client.SetPkiApplicationApiEnrollment(ctx, setReq); err != nil {
 			diags.AddError(
 				"Error configuring API enrollment",
 				"Couldn't configure API enrollment in Infisical, unexpected error: "+err.Error(),
@@ -286,7 +287,7 @@ func (r *certManagerApplicationProfileResource) applyEnrollment(
 
 	switch {
 	case plan.EstConfig == nil && prior != nil && prior.EstConfig != nil:
-		if _, err := r.client.ClearPkiApplicationEstEnrollment(infisical.ClearPkiApplicationEstEnrollmentRequest{
+		if _, err := r.client.ClearPkiApplicationEstEnrollment(ctx, infisical.ClearPkiApplicationEstEnrollmentRequest{
 			ApplicationId: appId, ProfileId: profileId,
 		}); err != nil {
 			diags.AddError(
@@ -308,7 +309,7 @@ func (r *certManagerApplicationProfileResource) applyEnrollment(
 			v := plan.EstConfig.CaChain.ValueString()
 			setReq.CaChain = &v
 		}
-		if _, err := r.client.SetPkiApplicationEstEnrollment(setReq); err != nil {
+		if _, err := r.client.SetPkiApplicationEstEnrollment(ctx, setReq); err != nil {
 			diags.AddError(
 				"Error configuring EST enrollment",
 				"Couldn't configure EST enrollment in Infisical, unexpected error: "+err.Error(),
@@ -319,7 +320,7 @@ func (r *certManagerApplicationProfileResource) applyEnrollment(
 
 	switch {
 	case plan.AcmeConfig == nil && prior != nil && prior.AcmeConfig != nil:
-		if _, err := r.client.ClearPkiApplicationAcmeEnrollment(infisical.ClearPkiApplicationAcmeEnrollmentRequest{
+		if _, err := r.client.ClearPkiApplicationAcmeEnrollment(ctx, infisical.ClearPkiApplicationAcmeEnrollmentRequest{
 			ApplicationId: appId, ProfileId: profileId,
 		}); err != nil {
 			diags.AddError(
@@ -340,7 +341,7 @@ func (r *certManagerApplicationProfileResource) applyEnrollment(
 			v := plan.AcmeConfig.SkipEabBinding.ValueBool()
 			setReq.SkipEabBinding = &v
 		}
-		if _, err := r.client.SetPkiApplicationAcmeEnrollment(setReq); err != nil {
+		if _, err := r.client.SetPkiApplicationAcmeEnrollment(ctx, setReq); err != nil {
 			diags.AddError(
 				"Error configuring ACME enrollment",
 				"Couldn't configure ACME enrollment in Infisical, unexpected error: "+err.Error(),
@@ -351,7 +352,7 @@ func (r *certManagerApplicationProfileResource) applyEnrollment(
 
 	switch {
 	case plan.ScepConfig == nil && prior != nil && prior.ScepConfig != nil:
-		if _, err := r.client.ClearPkiApplicationScepEnrollment(infisical.ClearPkiApplicationScepEnrollmentRequest{
+		if _, err := r.client.ClearPkiApplicationScepEnrollment(ctx, infisical.ClearPkiApplicationScepEnrollmentRequest{
 			ApplicationId: appId, ProfileId: profileId,
 		}); err != nil {
 			diags.AddError(
@@ -384,7 +385,7 @@ func (r *certManagerApplicationProfileResource) applyEnrollment(
 			v := int(plan.ScepConfig.DynamicChallengeMaxPending.ValueInt64())
 			setReq.DynamicChallengeMaxPending = &v
 		}
-		if _, err := r.client.SetPkiApplicationScepEnrollment(setReq); err != nil {
+		if _, err := r.client.SetPkiApplicationScepEnrollment(ctx, setReq); err != nil {
 			diags.AddError(
 				"Error configuring SCEP enrollment",
 				"Couldn't configure SCEP enrollment in Infisical, unexpected error: "+err.Error(),
@@ -394,13 +395,13 @@ func (r *certManagerApplicationProfileResource) applyEnrollment(
 	}
 }
 
-func (r *certManagerApplicationProfileResource) refresh(model *certManagerApplicationProfileResourceModel) (bool, error) {
-	enrollment, err := r.client.GetPkiApplicationEnrollment(infisical.GetPkiApplicationEnrollmentRequest{
+func (r *certManagerApplicationProfileResource) refresh(ctx context.Context, model *certManagerApplicationProfileResourceModel) (bool, error) {
+	enrollment, err := r.client.GetPkiApplicationEnrollment(ctx, infisical.GetPkiApplicationEnrollmentRequest{
 		ApplicationId: model.ApplicationId.ValueString(),
 		ProfileId:     model.ProfileId.ValueString(),
 	})
 	if err != nil {
-		if err == infisical.ErrNotFound {
+		if errors.Is(err, infisical.ErrNotFound) {
 			return false, nil
 		}
 		return false, err
@@ -446,7 +447,7 @@ func (r *certManagerApplicationProfileResource) refresh(model *certManagerApplic
 		needsReveal := model.AcmeConfig.EabKid.IsNull() || model.AcmeConfig.EabKid.IsUnknown() ||
 			model.AcmeConfig.EabSecret.IsNull() || model.AcmeConfig.EabSecret.IsUnknown()
 		if needsReveal {
-			reveal, err := r.client.RevealPkiApplicationAcmeEabSecret(infisical.RevealPkiApplicationAcmeEabSecretRequest{
+			reveal, err := r.client.RevealPkiApplicationAcmeEabSecret(ctx, infisical.RevealPkiApplicationAcmeEabSecretRequest{
 				ApplicationId: model.ApplicationId.ValueString(),
 				ProfileId:     model.ProfileId.ValueString(),
 			})
@@ -510,7 +511,7 @@ func (r *certManagerApplicationProfileResource) Create(ctx context.Context, req
 		return
 	}
 
-	if _, err := r.client.AttachPkiApplicationProfiles(infisical.AttachPkiApplicationProfilesRequest{
+	if _, err := r.client.AttachPkiApplicationProfiles(ctx, infisical.AttachPkiApplicationProfilesRequest{
 		ApplicationId: plan.ApplicationId.ValueString(),
 		ProfileIds:    []string{plan.ProfileId.ValueString()},
 	}); err != nil {
@@ -521,12 +522,12 @@ func (r *certManagerApplicationProfileResource) Create(ctx context.Context, req
 		return
 	}
 
-	r.applyEnrollment(nil, &plan, &resp.Diagnostics)
+	r.applyEnrollment(ctx, nil, &plan, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	exists, err := r.refresh(&plan)
+	exists, err := r.refresh(ctx, &plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading Certificate Manager application profile after create",
@@ -561,7 +562,7 @@ func (r *certManagerApplicationProfileResource) Read(ctx context.Context, req re
 		return
 	}
 
-	exists, err := r.refresh(&state)
+	exists, err := r.refresh(ctx, &state)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading Certificate Manager application profile",
@@ -594,12 +595,12 @@ func (r *certManagerApplicationProfileResource) Update(ctx context.Context, req
 		return
 	}
 
-	r.applyEnrollment(&prior, &plan, &resp.Diagnostics)
+	r.applyEnrollment(ctx, &prior, &plan, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	exists, err := r.refresh(&plan)
+	exists, err := r.refresh(ctx, &plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading Certificate Manager application profile after update",
@@ -631,7 +632,7 @@ func (r *certManagerApplicationProfileResource) Delete(ctx context.Context, req
 		return
 	}
 
-	if _, err := r.client.DetachPkiApplicationProfile(infisical.DetachPkiApplicationProfileRequest{
+	if _, err := r.client.DetachPkiApplicationProfile(ctx, infisical.DetachPkiApplicationProfileRequest{
 		ApplicationId: state.ApplicationId.ValueString(),
 		ProfileId:     state.ProfileId.ValueString(),
 	}); err != nil {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -95,8 +96,8 @@ func (r *certManagerApplicationUserResource) Configure(_ context.Context, req re
 	r.client = client
 }
 
-func (r *certManagerApplicationUserResource) findMembershipByEmail(applicationId, email string) (*infisical.PkiApplicationMember, error) {
-	members, err := r.client.ListPkiApplicationUserMembers(infisical.ListPkiApplicationUserMembersRequest{
+func (r *certManagerApplicationUserResource) findMembershipByEmail(ctx context.Context, applicationId, email string) (*infisical.PkiApplicationMember, error) {
+	members, err := r.client.ListPkiApplicationUserMembers(ctx, infisical.ListPkiApplicationUserMembersRequest{
 		ApplicationId: applicationId,
 	})
 	if err != nil {
@@ -119,8 +120,8 @@ func (r *certManagerApplicationUserResource) findMembershipByEmail(applicationId
 	return nil, nil
 }
 
-func (r *certManagerApplicationUserResource) findMembershipByUserId(applicationId, userId string) (*infisical.PkiApplicationMember, error) {
-	members, err := r.client.ListPkiApplicationUserMembers(infisical.ListPkiApplicationUserMembersRequest{
+func (r *certManagerApplicationUserResource) findMembershipByUserId(ctx context.Context, applicationId, userId string) (*infisical.PkiApplicationMember, error) {
+	members, err := r.client.ListPkiApplicationUserMembers(ctx, infisical.ListPkiApplicationUserMembersRequest{
 		ApplicationId: applicationId,
 	})
 	if err != nil {
@@ -152,7 +153,7 @@ func (r *certManagerApplicationUserResource) Create(ctx context.Context, req res
 		return
 	}
 
-	addResponse, err := r.client.AddPkiApplicationUserMembers(infisical.AddPkiApplicationUserMembersRequest{
+	addResponse, err := r.client.AddPkiApplicationUserMembers(ctx, infisical.AddPkiApplicationUserMembersRequest{
 		ApplicationId: plan.ApplicationId.ValueString(),
 		Emails:        []string{plan.Email.ValueString()},
 		Role:          plan.Role.ValueString(),
@@ -201,7 +202,7 @@ func (r *certManagerApplicationUserResource) Create(ctx context.Context, req res
 		}
 	}
 	if member == nil {
-		found, err := r.findMembershipByEmail(plan.ApplicationId.ValueString(), plan.Email.ValueString())
+		found, err := r.findMembershipByEmail(ctx, plan.ApplicationId.ValueString(), plan.Email.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error reading user membership after create",
@@ -249,14 +250,13 @@ func (r *certManagerApplicationUserResource) Read(ctx context.Context, req resou
 	var err error
 
 	if !state.UserId.IsNull() && state.UserId.ValueString() != "" {
-		member, err = r.findMembershipByUserId(state.ApplicationId.ValueString(), state.UserId.ValueString())
+		member, err = r.findMembershipByUserId(ctx, state.ApplicationId.ValueString(), state.UserId.ValueString())
 	} else {
-		member, err = r.findMembershipByEmail(state.ApplicationId.ValueString(), state.Email.ValueString())
+		member, err = r.findMembershipByEmail(ctx, state.ApplicationId.ValueString(), state.Email.ValueString())
 	}
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
 		resp.Diagnostics.AddError(
@@ -311,7 +311,7 @@ func (r *certManagerApplicationUserResource) Update(ctx context.Context, req res
 		return
 	}
 
-	updateResp, err := r.client.UpdatePkiApplicationUserMemberRole(infisical.UpdatePkiApplicationUserMemberRoleRequest{
+	updateResp, err := r.client.UpdatePkiApplicationUserMemberRole(ctx, infisical.UpdatePkiApplicationUserMemberRoleRequest{
 		ApplicationId: plan.ApplicationId.ValueString(),
 		UserId:        state.UserId.ValueString(),
 		Role:          plan.Role.ValueString(),
@@ -351,7 +351,7 @@ func (r *certManagerApplicationUserResource) Delete(ctx context.Context, req res
 		return
 	}
 
-	_, err := r.client.RemovePkiApplicationUserMember(infisical.RemovePkiApplicationUserMemberRequest{
+	_, err := r.client.RemovePkiApplicationUserMember(ctx, infisical.RemovePkiApplicationUserMemberRequest{
 		ApplicationId: state.ApplicationId.ValueString(),
 		UserId:        state.UserId.ValueString(),
 	})
@@ -2,9 +2,11 @@ package resource
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
@@ -41,6 +43,8 @@ type certManagerCACertificateResourceModel struct {
 	Certificate      types.String `tfsdk:"certificate"`
 	CertificateChain types.String `tfsdk:"certificate_chain"`
 	SerialNumber     types.String `tfsdk:"serial_number"`
+
+	Timeouts *infisicaltf.Timeouts `tfsdk:"timeouts"`
 }
 
 func (r *certManagerCACertificateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -113,6 +117,9 @@ func (r *certManagerCACertificateResource) Schema(_ context.Context, _ resource.
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": infisicaltf.TimeoutsBlock(),
+		},
 	}
 }
 
@@ -149,6 +156,9 @@ func (r *certManagerCACertificateResource) Create(ctx context.Context, req resou
 		return
 	}
 
+	ctx, cancel := plan.Timeouts.CreateContext(ctx, infisicaltf.DefaultTimeout)
+	defer cancel()
+
 	notBefore, err := time.Parse(time.RFC3339, plan.NotBefore.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid not_before format", "not_before must be in RFC3339 format (e.g., '2024-01-01T00:00:00Z')")
@@ -166,7 +176,7 @@ func (r *certManagerCACertificateResource) Create(ctx context.Context, req resou
 		return
 	}
 
-	ca, err := r.client.GetInternalCA(infisical.GetCARequest{
+	ca, err := r.client.GetInternalCA(ctx, infisical.GetCARequest{
 		CAId: plan.CaId.ValueString(),
 	})
 	if err != nil {
@@ -204,7 +214,7 @@ func (r *certManagerCACertificateResource) Create(ctx context.Context, req resou
 		generateRequest.ParentCaId = plan.ParentCaId.ValueString()
 	}
 
-	certificate, err := r.client.GenerateCACertificate(generateRequest)
+	certificate, err := r.client.GenerateCACertificate(ctx, generateRequest)
 	if err != nil {
 		resp.Diagnostics.AddError("Error generating CA certificate", err.Error())
 		return
@@ -234,13 +244,15 @@ func (r *certManagerCACertificateResource) Read(ctx context.Context, req resourc
 		return
 	}
 
-	certificate, err := r.client.GetSpecificCACertificate(infisical.GetSpecificCACertificateRequest{
+	ctx, cancel := state.Timeouts.ReadContext(ctx, infisicaltf.DefaultTimeout)
+	defer cancel()
+
+	certificate, err := r.client.GetSpecificCACertificate(ctx, infisical.GetSpecificCACertificateRequest{
 		CaId:   state.CaId.ValueString(),
 		CertId: state.Id.ValueString(),
 	})
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
 		resp.Diagnostics.AddError("Error reading CA certificate", err.Error())
@@ -289,13 +301,16 @@ func (r *certManagerCACertificateResource) Delete(ctx context.Context, req resou
 		return
 	}
 
-	_, err := r.client.GetSpecificCACertificate(infisical.GetSpecificCACertificateRequest{
+	ctx, cancel := state.Timeouts.DeleteContext(ctx, infisicaltf.DefaultTimeout)
+	defer cancel()
+
+	_, err := r.client.GetSpecificCACertificate(ctx, infisical.GetSpecificCACertificateRequest{
 		CaId:   state.CaId.ValueString(),
 		CertId: state.Id.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
+		if errors.Is(err, infisical.ErrNotFound) {
 			return
 		}
 		resp.Diagnostics.AddError("Error checking CA certificate", err.Error())
@@ -328,12 +343,12 @@ func (r *certManagerCACertificateResource) ImportState(ctx context.Context, req
 	caId := parts[0]
 	certId := parts[1]
 
-	certificate, err := r.client.GetSpecificCACertificate(infisical.GetSpecificCACertificateRequest{
+	certificate, err := r.client.GetSpecificCACertificate(ctx, infisical.GetSpecificCACertificateRequest{
 		CaId:   caId,
 		CertId: certId,
 	})
 	if err != nil {
-		if err == infisical.ErrNotFound {
+		if errors.Is(err, infisical.ErrNotFound) {
 			resp.Diagnostics.AddError("CA certificate not found", "The CA certificate does not exist or has been deleted")
 			return
 		}
@@ -2,10 +2,12 @@ package resource
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"strings"
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
@@ -115,6 +117,8 @@ type certManagerCertificateResourceModel struct {
 	Certificate          types.String `tfsdk:"certificate"`
 	PrivateKey           types.String `tfsdk:"private_key"`
 	CertificateChain     types.String `tfsdk:"certificate_chain"`
+
+	Timeouts *infisicaltf.Timeouts `tfsdk:"timeouts"`
 }
 
 func (r *certManagerCertificateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -283,7 +287,7 @@ func (r *certManagerCertificateResource) Schema(_ context.Context, _ resource.Sc
 				},
 			},
 			"timeout_seconds": schema.Int64Attribute{
-				Description: "Maximum time to wait for certificate issuance in seconds. Defaults to 3600 (1 hour)",
+				Description: "Maximum time to wait for certificate issuance in seconds. Defaults to 3600 (1 hour). Unless `timeouts.create` is set, the create operation is allowed this long plus 20 minutes.",
 				Optional:    true,
 				Computed:    true,
 				Default:     int64default.StaticInt64(3600),
@@ -344,6 +348,9 @@ func (r *certManagerCertificateResource) Schema(_ context.Context, _ resource.Sc
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": infisicaltf.TimeoutsBlock(),
+		},
 	}
 }
 
@@ -396,6 +403,10 @@ func (r *certManagerCertificateResource) Create(ctx context.Context, req resourc
 		timeoutSeconds = plan.TimeoutSeconds.ValueInt64()
 	}
 
+	// timeout_seconds only bounds the issuance polling, so by default leave room for the request itself on top of it
+	ctx, cancel := plan.Timeouts.CreateContext(ctx, time.Duration(timeoutSeconds)*time.Second+infisicaltf.DefaultTimeout)
+	defer cancel()
+
 	certRequest := infisical.RequestCertificateRequest{
 		ProfileId:     plan.ProfileId.ValueString(),
 		ApplicationId: plan.ApplicationId.ValueString(),
@@ -508,7 +519,7 @@ func (r *certManagerCertificateResource) Create(ctx context.Context, req resourc
 		certRequest.Attributes = &attributes
 	}
 
-	requestResponse, err := r.client.RequestCertificate(certRequest)
+	requestResponse, err := r.client.RequestCertificate(ctx, certRequest)
 	if err != nil {
 		resp.Diagnostics.AddError("Error requesting certificate", err.Error())
 		return
@@ -565,7 +576,7 @@ func (r *certManagerCertificateResource) populatePlanFromImmediateResponse(ctx c
 }
 
 func (r *certManagerCertificateResource) populateCertificateDetails(ctx context.Context, plan *certManagerCertificateResourceModel, certificateId string) {
-	certDetails, err := r.client.GetCertificate(infisical.GetCertificateRequest{
+	certDetails, err := r.client.GetCertificate(ctx, infisical.GetCertificateRequest{
 		CertificateId: certificateId,
 	})
 	if err != nil {
@@ -736,6 +747,10 @@ func (r *certManagerCertificateResource) pollCertificateRequest(ctx context.Cont
 	timeout := time.Duration(timeoutSeconds) * time.Second
 	startTime := time.Now()
 
+	// cancels a status request still in flight when the timeout elapses, instead of waiting on it
+	pollCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	minInterval := 2 * time.Second
 	maxInterval := 30 * time.Second
 	currentInterval := minInterval
@@ -746,7 +761,7 @@ func (r *certManagerCertificateResource) pollCertificateRequest(ctx context.Cont
 			return
 		}
 
-		if time.Since(startTime) > timeout {
+		if pollCtx.Err() != nil || time.Since(startTime) > timeout {
 			resp.Diagnostics.AddError(
 				"Certificate issuance timeout",
 				fmt.Sprintf("Certificate issuance did not complete within %d seconds. Request ID: %s", timeoutSeconds, certificateRequestId),
@@ -754,15 +769,17 @@ func (r *certManagerCertificateResource) pollCertificateRequest(ctx context.Cont
 			return
 		}
 
-		statusResponse, err := r.client.GetCertificateRequestStatus(infisical.GetCertificateRequestStatusRequest{
+		statusResponse, err := r.client.GetCertificateRequestStatus(pollCtx, infisical.GetCertificateRequestStatusRequest{
 			RequestId: certificateRequestId,
 		})
 		if err != nil {
-			if err == infisical.ErrNotFound {
+			if pollCtx.Err() != nil {
+				continue
+			}
+			if errors.Is(err, infisical.ErrNotFound) {
 				select {
-				case <-ctx.Done():
-					resp.Diagnostics.AddError("Operation cancelled", ctx.Err().Error())
-					return
+				case <-pollCtx.Done():
+					continue
 				case <-time.After(currentInterval):
 					currentInterval = r.nextBackoffInterval(currentInterval, maxInterval)
 					continue
@@ -795,9 +812,8 @@ func (r *certManagerCertificateResource) pollCertificateRequest(ctx context.Cont
 
 		case "pending":
 			select {
-			case <-ctx.Done():
-				resp.Diagnostics.AddError("Operation cancelled", ctx.Err().Error())
-				return
+			case <-pollCtx.Done():
+				continue
 			case <-time.After(currentInterval):
 				currentInterval = r.nextBackoffInterval(currentInterval, maxInterval)
 				continue
@@ -810,9 +826,8 @@ func (r *certManagerCertificateResource) pollCertificateRequest(ctx context.Cont
 				return
 			}
 			select {
-			case <-ctx.Done():
-				resp.Diagnostics.AddError("Operation cancelled", ctx.Err().Error())
-				return
+			case <-pollCtx.Done():
+				continue
 			case <-time.After(currentInterval):
 				currentInterval = r.nextBackoffInterval(currentInterval, maxInterval)
 				continue
@@ -883,12 +898,14 @@ func (r *certManagerCertificateResource) Read(ctx context.Context, req resource.
 		return
 	}
 
-	certResponse, err := r.client.GetCertificate(infisical.GetCertificateRequest{
+	ctx, cancel := state.Timeouts.ReadContext(ctx, infisicaltf.DefaultTimeout)
+	defer cancel()
+
+	certResponse, err := r.client.GetCertificate(ctx, infisical.GetCertificateRequest{
 		CertificateId: state.Id.ValueString(),
 	})
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
 
@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"strings"
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
@@ -629,7 +630,7 @@ func (r *certManagerCertificatePolicyResource) Create(ctx context.Context, req r
 		return
 	}
 
-	policy, err := r.client.CreateCertificatePolicy(createPolicyRequest)
+	policy, err := r.client.CreateCertificatePolicy(ctx, createPolicyRequest)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating certificate policy", err.Error())
 		return
@@ -665,12 +666,11 @@ func (r *certManagerCertificatePolicyResource) Read(ctx context.Context, req res
 
 	var state certManagerCertificatePolicyResourceModel
 
-	policy, err := r.client.GetCertificatePolicy(infisical.GetCertificatePolicyRequest{
+	policy, err := r.client.GetCertificatePolicy(ctx, infisical.GetCertificatePolicyRequest{
 		PolicyId: currentState.Id.ValueString(),
 	})
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
 
@@ -1014,7 +1014,7 @@ func (r *certManagerCertificatePolicyResource) Update(ctx context.Context, req r
 		return
 	}
 
-	updatedPolicy, err := r.client.UpdateCertificatePolicy(updatePolicyRequest)
+	updatedPolicy, err := r.client.UpdateCertificatePolicy(ctx, updatePolicyRequest)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating certificate policy", err.Error())
 		return
@@ -1051,7 +1051,7 @@ func (r *certManagerCertificatePolicyResource) Delete(ctx context.Context, req r
 		return
 	}
 
-	_, err := r.client.DeleteCertificatePolicy(infisical.DeleteCertificatePolicyRequest{
+	_, err := r.client.DeleteCertificatePolicy(ctx, infisical.DeleteCertificatePolicyRequest{
 		PolicyId: state.Id.ValueString(),
 	})
 	if err != nil {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -301,7 +302,7 @@ func (r *certManagerCertificateProfileResource) Create(ctx context.Context, req
 	}
 	createProfileRequest.Defaults = defaults
 
-	profile, err := r.client.CreateCertificateProfile(createProfileRequest)
+	profile, err := r.client.CreateCertificateProfile(ctx, createProfileRequest)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating certificate profile", err.Error())
 		return
@@ -385,13 +386,12 @@ func (r *certManagerCertificateProfileResource) Read(ctx context.Context, req re
 
 	var state certManagerCertificateProfileResourceModel
 
-	profile, err := r.client.GetCertificateProfile(infisical.GetCertificateProfileRequest{
+	profile, err := r.client.GetCertificateProfile(ctx, infisical.GetCertificateProfileRequest{
 		ProfileId:      currentState.Id.ValueString(),
 		IncludeConfigs: false,
 	})
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
 
@@ -450,7 +450,7 @@ func (r *certManagerCertificateProfileResource) Update(ctx context.Context, req
 	}
 	updateProfileRequest.Defaults = defaults
 
-	_, err := r.client.UpdateCertificateProfile(updateProfileRequest)
+	_, err := r.client.UpdateCertificateProfile(ctx, updateProfileRequest)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating certificate profile", err.Error())
 		return
@@ -475,7 +475,7 @@ func (r *certManagerCertificateProfileResource) Delete(ctx context.Context, req
 		return
 	}
 
-	_, err := r.client.DeleteCertificateProfile(infisical.DeleteCertificateProfileRequest{
+	_, err := r.client.DeleteCertificateProfile(ctx, infisical.DeleteCertificateProfileRequest{
 		ProfileId: state.Id.ValueString(),
 	})
 	if err != nil {
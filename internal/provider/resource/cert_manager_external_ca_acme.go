@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -170,7 +171,7 @@ func (r *certManagerExternalCAACMEResource) Create(ctx context.Context, req reso
 		configuration.EABHmacKey = plan.EABHmacKey.ValueString()
 	}
 
-	newCA, err := r.client.CreateACMECA(infisical.CreateACMECARequest{
+	newCA, err := r.client.CreateACMECA(ctx, infisical.CreateACMECARequest{
 		Name:          plan.Name.ValueString(),
 		Status:        status,
 		Configuration: configuration,
@@ -220,13 +221,12 @@ func (r *certManagerExternalCAACMEResource) Read(ctx context.Context, req resour
 		return
 	}
 
-	ca, err := r.client.GetACMECA(infisical.GetCARequest{
+	ca, err := r.client.GetACMECA(ctx, infisical.GetCARequest{
 		CAId: state.Id.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
 
@@ -314,7 +314,7 @@ func (r *certManagerExternalCAACMEResource) Update(ctx context.Context, req reso
 		configuration.EABHmacKey = plan.EABHmacKey.ValueString()
 	}
 
-	_, err := r.client.UpdateACMECA(infisical.UpdateACMECARequest{
+	_, err := r.client.UpdateACMECA(ctx, infisical.UpdateACMECARequest{
 		CAId:          plan.Id.ValueString(),
 		Name:          plan.Name.ValueString(),
 		Status:        plan.Status.ValueString(),
@@ -352,7 +352,7 @@ func (r *certManagerExternalCAACMEResource) Delete(ctx context.Context, req reso
 		return
 	}
 
-	_, err := r.client.DeleteACMECA(infisical.DeleteCARequest{
+	_, err := r.client.DeleteACMECA(ctx, infisical.DeleteCARequest{
 		CAId: state.Id.ValueString(),
 	})
 
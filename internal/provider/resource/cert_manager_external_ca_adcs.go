@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -111,7 +112,7 @@ func (r *certManagerExternalCAADCSResource) Create(ctx context.Context, req reso
 		status = plan.Status.ValueString()
 	}
 
-	newCA, err := r.client.CreateADCSCA(infisical.CreateADCSCARequest{
+	newCA, err := r.client.CreateADCSCA(ctx, infisical.CreateADCSCARequest{
 		Name:   plan.Name.ValueString(),
 		Status: status,
 		Configuration: infisical.CertificateAuthorityConfiguration{
@@ -158,13 +159,12 @@ func (r *certManagerExternalCAADCSResource) Read(ctx context.Context, req resour
 		return
 	}
 
-	ca, err := r.client.GetADCSCA(infisical.GetCARequest{
+	ca, err := r.client.GetADCSCA(ctx, infisical.GetCARequest{
 		CAId: state.Id.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
 
@@ -212,7 +212,7 @@ func (r *certManagerExternalCAADCSResource) Update(ctx context.Context, req reso
 		return
 	}
 
-	updatedCA, err := r.client.UpdateADCSCA(infisical.UpdateADCSCARequest{
+	updatedCA, err := r.client.UpdateADCSCA(ctx, infisical.UpdateADCSCARequest{
 		CAId:   plan.Id.ValueString(),
 		Name:   plan.Name.ValueString(),
 		Status: plan.Status.ValueString(),
@@ -260,7 +260,7 @@ func (r *certManagerExternalCAADCSResource) Delete(ctx context.Context, req reso
 		return
 	}
 
-	_, err := r.client.DeleteADCSCA(infisical.DeleteCARequest{
+	_, err := r.client.DeleteADCSCA(ctx, infisical.DeleteCARequest{
 		CAId: state.Id.ValueString(),
 	})
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -93,7 +94,7 @@ func (r *certManagerGroupResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
-	added, err := r.client.AddCertManagerGroup(infisical.AddCertManagerGroupRequest{
+	added, err := r.client.AddCertManagerGroup(ctx, infisical.AddCertManagerGroupRequest{
 		GroupId: plan.GroupId.ValueString(),
 		Roles:   []infisical.CertManagerMembershipRoleUpdate{{Role: plan.Role.ValueString()}},
 	})
@@ -127,12 +128,11 @@ func (r *certManagerGroupResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	refreshed, err := r.client.GetCertManagerGroup(infisical.GetCertManagerGroupRequest{
+	refreshed, err := r.client.GetCertManagerGroup(ctx, infisical.GetCertManagerGroupRequest{
 		GroupId: state.GroupId.ValueString(),
 	})
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
 		resp.Diagnostics.AddError(
@@ -163,7 +163,7 @@ func (r *certManagerGroupResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
-	_, err := r.client.UpdateCertManagerGroup(infisical.UpdateCertManagerGroupRequest{
+	_, err := r.client.UpdateCertManagerGroup(ctx, infisical.UpdateCertManagerGroupRequest{
 		GroupId: plan.GroupId.ValueString(),
 		Roles:   []infisical.CertManagerMembershipRoleUpdate{{Role: plan.Role.ValueString()}},
 	})
@@ -175,7 +175,7 @@ func (r *certManagerGroupResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
-	refreshed, err := r.client.GetCertManagerGroup(infisical.GetCertManagerGroupRequest{
+	refreshed, err := r.client.GetCertManagerGroup(ctx, infisical.GetCertManagerGroupRequest{
 		GroupId: plan.GroupId.ValueString(),
 	})
 	if err != nil {
@@ -207,7 +207,7 @@ func (r *certManagerGroupResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
-	_, err := r.client.RemoveCertManagerGroup(infisical.RemoveCertManagerGroupRequest{
+	_, err := r.client.RemoveCertManagerGroup(ctx, infisical.RemoveCertManagerGroupRequest{
 		GroupId: state.GroupId.ValueString(),
 	})
 	if err != nil {
@@ -228,7 +228,7 @@ func (r *certManagerGroupResource) ImportState(ctx context.Context, req resource
 		return
 	}
 
-	refreshed, err := r.client.GetCertManagerGroup(infisical.GetCertManagerGroupRequest{
+	refreshed, err := r.client.GetCertManagerGroup(ctx, infisical.GetCertManagerGroupRequest{
 		GroupId: req.ID,
 	})
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -93,7 +94,7 @@ func (r *certManagerIdentityResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
-	added, err := r.client.AddCertManagerIdentity(infisical.AddCertManagerIdentityRequest{
+	added, err := r.client.AddCertManagerIdentity(ctx, infisical.AddCertManagerIdentityRequest{
 		IdentityId: plan.IdentityId.ValueString(),
 		Roles:      []infisical.CertManagerMembershipRoleUpdate{{Role: plan.Role.ValueString()}},
 	})
@@ -107,7 +108,7 @@ func (r *certManagerIdentityResource) Create(ctx context.Context, req resource.C
 
 	plan.IdentityId = types.StringValue(added.IdentityMembership.IdentityId)
 
-	refreshed, err := r.client.GetCertManagerIdentity(infisical.GetCertManagerIdentityRequest{
+	refreshed, err := r.client.GetCertManagerIdentity(ctx, infisical.GetCertManagerIdentityRequest{
 		IdentityId: plan.IdentityId.ValueString(),
 	})
 	if err != nil {
@@ -139,12 +140,11 @@ func (r *certManagerIdentityResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
-	refreshed, err := r.client.GetCertManagerIdentity(infisical.GetCertManagerIdentityRequest{
+	refreshed, err := r.client.GetCertManagerIdentity(ctx, infisical.GetCertManagerIdentityRequest{
 		IdentityId: state.IdentityId.ValueString(),
 	})
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
 		resp.Diagnostics.AddError(
@@ -175,7 +175,7 @@ func (r *certManagerIdentityResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
-	_, err := r.client.UpdateCertManagerIdentity(infisical.UpdateCertManagerIdentityRequest{
+	_, err := r.client.UpdateCertManagerIdentity(ctx, infisical.UpdateCertManagerIdentityRequest{
 		IdentityId: plan.IdentityId.ValueString(),
 		Roles:      []infisical.CertManagerMembershipRoleUpdate{{Role: plan.Role.ValueString()}},
 	})
@@ -187,7 +187,7 @@ func (r *certManagerIdentityResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
-	refreshed, err := r.client.GetCertManagerIdentity(infisical.GetCertManagerIdentityRequest{
+	refreshed, err := r.client.GetCertManagerIdentity(ctx, infisical.GetCertManagerIdentityRequest{
 		IdentityId: plan.IdentityId.ValueString(),
 	})
 	if err != nil {
@@ -219,7 +219,7 @@ func (r *certManagerIdentityResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
-	_, err := r.client.RemoveCertManagerIdentity(infisical.RemoveCertManagerIdentityRequest{
+	_, err := r.client.RemoveCertManagerIdentity(ctx, infisical.RemoveCertManagerIdentityRequest{
 		IdentityId: state.IdentityId.ValueString(),
 	})
 	if err != nil {
@@ -240,7 +240,7 @@ func (r *certManagerIdentityResource) ImportState(ctx context.Context, req resou
 		return
 	}
 
-	refreshed, err := r.client.GetCertManagerIdentity(infisical.GetCertManagerIdentityRequest{
+	refreshed, err := r.client.GetCertManagerIdentity(ctx, infisical.GetCertManagerIdentityRequest{
 		IdentityId: req.ID,
 	})
 	if err != nil {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -208,7 +209,7 @@ func (r *certManagerInternalCAResource) Create(ctx context.Context, req resource
 		return
 	}
 
-	newCA, err := r.client.CreateInternalCA(infisical.CreateInternalCARequest{
+	newCA, err := r.client.CreateInternalCA(ctx, infisical.CreateInternalCARequest{
 		Name:   plan.Name.ValueString(),
 		Status: plan.Status.ValueString(),
 		Configuration: infisical.CertificateAuthorityConfiguration{
@@ -261,13 +262,12 @@ func (r *certManagerInternalCAResource) Read(ctx context.Context, req resource.R
 		return
 	}
 
-	ca, err := r.client.GetInternalCA(infisical.GetCARequest{
+	ca, err := r.client.GetInternalCA(ctx, infisical.GetCARequest{
 		CAId: state.Id.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
 
@@ -359,7 +359,7 @@ func (r *certManagerInternalCAResource) Update(ctx context.Context, req resource
 		return
 	}
 
-	_, err := r.client.UpdateInternalCA(infisical.UpdateInternalCARequest{
+	_, err := r.client.UpdateInternalCA(ctx, infisical.UpdateInternalCARequest{
 		CAId:   plan.Id.ValueString(),
 		Name:   plan.Name.ValueString(),
 		Status: plan.Status.ValueString(),
@@ -406,7 +406,7 @@ func (r *certManagerInternalCAResource) Delete(ctx context.Context, req resource
 		return
 	}
 
-	_, err := r.client.DeleteInternalCA(infisical.DeleteCARequest{
+	_, err := r.client.DeleteInternalCA(ctx, infisical.DeleteCARequest{
 		CAId: state.Id.ValueString(),
 	})
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -86,8 +87,8 @@ func (r *certManagerUserResource) Configure(_ context.Context, req resource.Conf
 	r.client = client
 }
 
-func (r *certManagerUserResource) findUserByEmail(email string) (*infisical.CertManagerUserMembership, error) {
-	users, err := r.client.ListCertManagerUsers()
+func (r *certManagerUserResource) findUserByEmail(ctx context.Context, email string) (*infisical.CertManagerUserMembership, error) {
+	users, err := r.client.ListCertManagerUsers(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -117,7 +118,7 @@ func (r *certManagerUserResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	_, err := r.client.InviteCertManagerUsers(infisical.InviteCertManagerUsersRequest{
+	_, err := r.client.InviteCertManagerUsers(ctx, infisical.InviteCertManagerUsersRequest{
 		Emails: []string{plan.Email.ValueString()},
 	})
 	if err != nil {
@@ -128,7 +129,7 @@ func (r *certManagerUserResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	member, err := r.findUserByEmail(plan.Email.ValueString())
+	member, err := r.findUserByEmail(ctx, plan.Email.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error finding user after invite",
@@ -144,7 +145,7 @@ func (r *certManagerUserResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	_, err = r.client.UpdateCertManagerUser(infisical.UpdateCertManagerUserRequest{
+	_, err = r.client.UpdateCertManagerUser(ctx, infisical.UpdateCertManagerUserRequest{
 		UserId: member.UserID,
 		Roles:  []infisical.CertManagerMembershipRoleUpdate{{Role: plan.Role.ValueString()}},
 	})
@@ -156,7 +157,7 @@ func (r *certManagerUserResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	refreshed, err := r.client.GetCertManagerUser(infisical.GetCertManagerUserRequest{
+	refreshed, err := r.client.GetCertManagerUser(ctx, infisical.GetCertManagerUserRequest{
 		UserId: member.UserID,
 	})
 	if err != nil {
@@ -190,10 +191,9 @@ func (r *certManagerUserResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	if state.UserId.IsNull() || state.UserId.ValueString() == "" {
-		member, err := r.findUserByEmail(state.Email.ValueString())
+		member, err := r.findUserByEmail(ctx, state.Email.ValueString())
 		if err != nil {
-			if err == infisical.ErrNotFound {
-				resp.State.RemoveResource(ctx)
+			if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 				return
 			}
 			resp.Diagnostics.AddError(
@@ -209,12 +209,11 @@ func (r *certManagerUserResource) Read(ctx context.Context, req resource.ReadReq
 		state.UserId = types.StringValue(member.UserID)
 	}
 
-	refreshed, err := r.client.GetCertManagerUser(infisical.GetCertManagerUserRequest{
+	refreshed, err := r.client.GetCertManagerUser(ctx, infisical.GetCertManagerUserRequest{
 		UserId: state.UserId.ValueString(),
 	})
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
 		resp.Diagnostics.AddError(
@@ -255,7 +254,7 @@ func (r *certManagerUserResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	_, err := r.client.UpdateCertManagerUser(infisical.UpdateCertManagerUserRequest{
+	_, err := r.client.UpdateCertManagerUser(ctx, infisical.UpdateCertManagerUserRequest{
 		UserId: state.UserId.ValueString(),
 		Roles:  []infisical.CertManagerMembershipRoleUpdate{{Role: plan.Role.ValueString()}},
 	})
@@ -267,7 +266,7 @@ func (r *certManagerUserResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	refreshed, err := r.client.GetCertManagerUser(infisical.GetCertManagerUserRequest{
+	refreshed, err := r.client.GetCertManagerUser(ctx, infisical.GetCertManagerUserRequest{
 		UserId: state.UserId.ValueString(),
 	})
 	if err != nil {
@@ -300,7 +299,7 @@ func (r *certManagerUserResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
-	_, err := r.client.RemoveCertManagerUser(infisical.RemoveCertManagerUserRequest{
+	_, err := r.client.RemoveCertManagerUser(ctx, infisical.RemoveCertManagerUserRequest{
 		UserId: state.UserId.ValueString(),
 	})
 	if err != nil {
@@ -321,7 +320,7 @@ func (r *certManagerUserResource) ImportState(ctx context.Context, req resource.
 		return
 	}
 
-	member, err := r.findUserByEmail(req.ID)
+	member, err := r.findUserByEmail(ctx, req.ID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error importing Certificate Manager user",
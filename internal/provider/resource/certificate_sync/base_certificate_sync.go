@@ -5,6 +5,7 @@ import (
 	"fmt"
 	infisical "terraform-provider-infisical/internal/client"
 	customtypes "terraform-provider-infisical/internal/pkg/customtypes"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -86,6 +87,8 @@ type CertificateSyncBaseResourceModel struct {
 	AutoSyncEnabled   types.Bool                     `tfsdk:"auto_sync_enabled"`
 	SyncOptions       types.Object                   `tfsdk:"sync_options"`
 	DestinationConfig types.Object                   `tfsdk:"destination_config"`
+
+	Timeouts *infisicaltf.Timeouts `tfsdk:"timeouts"`
 }
 
 func (r *CertificateSyncBaseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -148,6 +151,9 @@ func (r *CertificateSyncBaseResource) Schema(_ context.Context, _ resource.Schem
 				Attributes:  r.DestinationConfigAttributes,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": infisicaltf.TimeoutsBlock(),
+		},
 	}
 }
 
@@ -183,6 +189,9 @@ func (r *CertificateSyncBaseResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	ctx, cancel := plan.Timeouts.CreateContext(ctx, infisicaltf.DefaultTimeout)
+	defer cancel()
+
 	syncOptions, diags := r.ReadSyncOptionsFromPlan(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -195,7 +204,7 @@ func (r *CertificateSyncBaseResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
-	certificateSync, err := r.client.CreateCertificateSync(infisical.CreateCertificateSyncRequest{
+	certificateSync, err := r.client.CreateCertificateSync(ctx, infisical.CreateCertificateSyncRequest{
 		App:               r.App,
 		Name:              plan.Name.ValueString(),
 		Description:       plan.Description.ValueString(),
@@ -233,12 +242,14 @@ func (r *CertificateSyncBaseResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
-	certificateSync, err := r.client.GetCertificateSyncById(infisical.GetCertificateSyncByIdRequest{
+	ctx, cancel := state.Timeouts.ReadContext(ctx, infisicaltf.DefaultTimeout)
+	defer cancel()
+
+	certificateSync, err := r.client.GetCertificateSyncById(ctx, infisical.GetCertificateSyncByIdRequest{
 		ID: state.ID.ValueString(),
 	})
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
 		resp.Diagnostics.AddError(
@@ -289,6 +300,9 @@ func (r *CertificateSyncBaseResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	ctx, cancel := plan.Timeouts.UpdateContext(ctx, infisicaltf.DefaultTimeout)
+	defer cancel()
+
 	var state CertificateSyncBaseResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -307,7 +321,7 @@ func (r *CertificateSyncBaseResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
-	_, err := r.client.UpdateCertificateSync(infisical.UpdateCertificateSyncRequest{
+	_, err := r.client.UpdateCertificateSync(ctx, infisical.UpdateCertificateSyncRequest{
 		App:               r.App,
 		ID:                state.ID.ValueString(),
 		Name:              plan.Name.ValueString(),
@@ -345,7 +359,10 @@ func (r *CertificateSyncBaseResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
-	_, err := r.client.DeleteCertificateSync(infisical.DeleteCertificateSyncRequest{
+	ctx, cancel := state.Timeouts.DeleteContext(ctx, infisicaltf.DefaultTimeout)
+	defer cancel()
+
+	_, err := r.client.DeleteCertificateSync(ctx, infisical.DeleteCertificateSyncRequest{
 		App: r.App,
 		ID:  state.ID.ValueString(),
 	})
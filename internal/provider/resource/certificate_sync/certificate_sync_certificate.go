@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -15,9 +16,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-// certificateSyncCertificatesPageSize is the max page size accepted by the list certificates endpoint.
-const certificateSyncCertificatesPageSize = 100
-
 // renewalGuidance is shared by the Read warning and the Create error so both explain the same
 // thing: Infisical attaches the renewed certificate to the sync and drops the superseded one, and
 // it refuses to sync certificates that have been renewed, revoked, or expired. The provider does
@@ -116,33 +114,23 @@ func (r *CertificateSyncCertificateResource) Configure(_ context.Context, req re
 	r.client = client
 }
 
-// findAssociation pages through the sync's certificates and returns the association ID for the
-// given certificate ID, or an empty string when the certificate is not associated.
-func (r *CertificateSyncCertificateResource) findAssociation(certificateSyncID, certificateID string) (string, error) {
-	offset := 0
-	for {
-		page, err := r.client.ListCertificateSyncCertificates(infisical.ListCertificateSyncCertificatesRequest{
-			CertificateSyncID: certificateSyncID,
-			Offset:            offset,
-			Limit:             certificateSyncCertificatesPageSize,
-		})
-		if err != nil {
-			return "", err
-		}
-
-		for _, cert := range page.Certificates {
-			if cert.CertificateID == certificateID {
-				return cert.ID, nil
-			}
-		}
+// findAssociation lists the sync's certificates and returns the association ID for the given
+// certificate ID, or an empty string when the certificate is not associated.
+func (r *CertificateSyncCertificateResource) findAssociation(ctx context.Context, certificateSyncID, certificateID string) (string, error) {
+	certificates, err := r.client.ListCertificateSyncCertificates(ctx, infisical.ListCertificateSyncCertificatesRequest{
+		CertificateSyncID: certificateSyncID,
+	}, infisical.ListOptions{})
+	if err != nil {
+		return "", err
+	}
 
-		// Advance by how many items came back rather than by the limit we asked for: if a page
-		// returns fewer items than requested, advancing by the limit would skip the difference.
-		offset += len(page.Certificates)
-		if len(page.Certificates) == 0 || offset >= page.TotalCount {
-			return "", nil
+	for _, cert := range certificates.Items {
+		if cert.CertificateID == certificateID {
+			return cert.ID, nil
 		}
 	}
+
+	return "", nil
 }
 
 func (r *CertificateSyncCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -160,7 +148,7 @@ func (r *CertificateSyncCertificateResource) Create(ctx context.Context, req res
 		return
 	}
 
-	added, err := r.client.AddCertificateSyncCertificates(infisical.AddCertificateSyncCertificatesRequest{
+	added, err := r.client.AddCertificateSyncCertificates(ctx, infisical.AddCertificateSyncCertificatesRequest{
 		CertificateSyncID: plan.CertificateSyncID.ValueString(),
 		CertificateIDs:    []string{plan.CertificateID.ValueString()},
 	})
@@ -185,7 +173,7 @@ func (r *CertificateSyncCertificateResource) Create(ctx context.Context, req res
 		}
 	}
 	if associationID == "" {
-		associationID, err = r.findAssociation(plan.CertificateSyncID.ValueString(), plan.CertificateID.ValueString())
+		associationID, err = r.findAssociation(ctx, plan.CertificateSyncID.ValueString(), plan.CertificateID.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error resolving certificate association",
@@ -222,12 +210,11 @@ func (r *CertificateSyncCertificateResource) Read(ctx context.Context, req resou
 		return
 	}
 
-	associationID, err := r.findAssociation(state.CertificateSyncID.ValueString(), state.CertificateID.ValueString())
+	associationID, err := r.findAssociation(ctx, state.CertificateSyncID.ValueString(), state.CertificateID.ValueString())
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			// The whole sync is gone, which is ordinary deletion rather than something the user
-			// needs to act on, so drop the association without the warning issued further down.
-			resp.State.RemoveResource(ctx)
+		// The whole sync is gone, which is ordinary deletion rather than something the user
+		// needs to act on, so drop the association without the warning issued further down.
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
 		resp.Diagnostics.AddError(
@@ -279,7 +266,7 @@ func (r *CertificateSyncCertificateResource) Delete(ctx context.Context, req res
 		return
 	}
 
-	err := r.client.RemoveCertificateSyncCertificates(infisical.RemoveCertificateSyncCertificatesRequest{
+	err := r.client.RemoveCertificateSyncCertificates(ctx, infisical.RemoveCertificateSyncCertificatesRequest{
 		CertificateSyncID: state.CertificateSyncID.ValueString(),
 		CertificateIDs:    []string{state.CertificateID.ValueString()},
 	})
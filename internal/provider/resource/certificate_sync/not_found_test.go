@@ -0,0 +1,30 @@
+package resource
+
+import (
+	"terraform-provider-infisical/internal/provider/resource/resourcetest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestReadRemovesCertificateSyncsDeletedOutsideOfTerraform(t *testing.T) {
+	cases := map[string]struct {
+		newResource func() resource.Resource
+		attributes  map[string]any
+	}{
+		"certificate sync": {
+			newResource: NewCertificateSyncAwsCertificateManagerResource,
+			attributes:  map[string]any{"id": "certificate-sync-id"},
+		},
+		"certificate sync certificate": {
+			newResource: NewCertificateSyncCertificateResource,
+			attributes:  map[string]any{"id": "association-id", "certificate_sync_id": "certificate-sync-id", "certificate_id": "certificate-id"},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			resourcetest.AssertReadRemovesWhenNotFound(t, c.newResource, c.attributes)
+		})
+	}
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -40,6 +42,30 @@ type DynamicSecretBaseResourceModel struct {
 	Configuration    types.Object `tfsdk:"configuration"`
 	UsernameTemplate types.String `tfsdk:"username_template"`
 	Metadata         []MetaEntry  `tfsdk:"metadata"`
+
+	Timeouts *infisicaltf.Timeouts `tfsdk:"timeouts"`
+}
+
+// apiFields maps the fields of the create and update payloads, the latter nesting the changes
+// under data, to the resource's attributes.
+func (r *DynamicSecretBaseResource) apiFields() infisicaltf.APIFields {
+	return infisicaltf.APIFields{
+		"name":                  path.Root("name"),
+		"projectSlug":           path.Root("project_slug"),
+		"environmentSlug":       path.Root("environment_slug"),
+		"path":                  path.Root("path"),
+		"defaultTTL":            path.Root("default_ttl"),
+		"maxTTL":                path.Root("max_ttl"),
+		"usernameTemplate":      path.Root("username_template"),
+		"metadata":              path.Root("metadata"),
+		"data.newName":          path.Root("name"),
+		"data.defaultTTL":       path.Root("default_ttl"),
+		"data.maxTTL":           path.Root("max_ttl"),
+		"data.usernameTemplate": path.Root("username_template"),
+		"data.metadata":         path.Root("metadata"),
+	}.
+		WithObject("provider.inputs", path.Root("configuration"), r.ConfigurationAttributes).
+		WithObject("data.inputs", path.Root("configuration"), r.ConfigurationAttributes)
 }
 
 // Metadata returns the resource type name.
@@ -109,6 +135,9 @@ func (r *DynamicSecretBaseResource) Schema(_ context.Context, _ resource.SchemaR
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": infisicaltf.TimeoutsBlock(),
+		},
 	}
 }
 
@@ -150,6 +179,9 @@ func (r *DynamicSecretBaseResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	ctx, cancel := plan.Timeouts.CreateContext(ctx, infisicaltf.DefaultTimeout)
+	defer cancel()
+
 	var config DynamicSecretBaseResourceModel
 	diags = req.Config.Get(ctx, &config)
 	resp.Diagnostics.Append(diags...)
@@ -173,7 +205,7 @@ func (r *DynamicSecretBaseResource) Create(ctx context.Context, req resource.Cre
 		}
 	}
 
-	dynamicSecret, err := r.client.CreateDynamicSecret(infisical.CreateDynamicSecretRequest{
+	dynamicSecret, err := r.client.CreateDynamicSecret(ctx, infisical.CreateDynamicSecretRequest{
 		Provider: infisical.DynamicSecretProviderObject{
 			Provider: r.Provider,
 			Inputs:   configurationMap,
@@ -189,10 +221,7 @@ func (r *DynamicSecretBaseResource) Create(ctx context.Context, req resource.Cre
 	})
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating dynamic secret",
-			"Couldn't create dynamic secret, unexpected error: "+err.Error(),
-		)
+		infisicaltf.AddAPIError(&resp.Diagnostics, "Error creating dynamic secret", "Couldn't create dynamic secret, unexpected error: ", err, r.apiFields())
 		return
 	}
 
@@ -229,7 +258,10 @@ func (r *DynamicSecretBaseResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
-	dynamicSecret, err := r.client.GetDynamicSecretByName(infisical.GetDynamicSecretByNameRequest{
+	ctx, cancel := state.Timeouts.ReadContext(ctx, infisicaltf.DefaultTimeout)
+	defer cancel()
+
+	dynamicSecret, err := r.client.GetDynamicSecretByName(ctx, infisical.GetDynamicSecretByNameRequest{
 		ProjectSlug:     state.ProjectSlug.ValueString(),
 		EnvironmentSlug: state.EnvironmentSlug.ValueString(),
 		Path:            state.Path.ValueString(),
@@ -237,16 +269,14 @@ func (r *DynamicSecretBaseResource) Read(ctx context.Context, req resource.ReadR
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
-			return
-		} else {
-			resp.Diagnostics.AddError(
-				"Error reading dynamic secret",
-				"Couldn't read dynamic secret, unexpected error: "+err.Error(),
-			)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
+		resp.Diagnostics.AddError(
+			"Error reading dynamic secret",
+			"Couldn't read dynamic secret, unexpected error: "+err.Error(),
+		)
+		return
 	}
 
 	state.Name = types.StringValue(dynamicSecret.Name)
@@ -306,6 +336,9 @@ func (r *DynamicSecretBaseResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	ctx, cancel := plan.Timeouts.UpdateContext(ctx, infisicaltf.DefaultTimeout)
+	defer cancel()
+
 	var state DynamicSecretBaseResourceModel
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -341,7 +374,7 @@ func (r *DynamicSecretBaseResource) Update(ctx context.Context, req resource.Upd
 		newName = plan.Name.ValueString()
 	}
 
-	dynamicSecret, err := r.client.UpdateDynamicSecret(infisical.UpdateDynamicSecretRequest{
+	dynamicSecret, err := r.client.UpdateDynamicSecret(ctx, infisical.UpdateDynamicSecretRequest{
 		Name:            state.Name.ValueString(),
 		ProjectSlug:     state.ProjectSlug.ValueString(),
 		EnvironmentSlug: state.EnvironmentSlug.ValueString(),
@@ -357,10 +390,7 @@ func (r *DynamicSecretBaseResource) Update(ctx context.Context, req resource.Upd
 	})
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating dynamic secret",
-			"Couldn't update dynamic secret, unexpected error: "+err.Error(),
-		)
+		infisicaltf.AddAPIError(&resp.Diagnostics, "Error updating dynamic secret", "Couldn't update dynamic secret, unexpected error: ", err, r.apiFields())
 		return
 	}
 
@@ -393,7 +423,10 @@ func (r *DynamicSecretBaseResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
-	_, err := r.client.DeleteDynamicSecret(infisical.DeleteDynamicSecretRequest{
+	ctx, cancel := state.Timeouts.DeleteContext(ctx, infisicaltf.DefaultTimeout)
+	defer cancel()
+
+	_, err := r.client.DeleteDynamicSecret(ctx, infisical.DeleteDynamicSecretRequest{
 		Name:            state.Name.ValueString(),
 		ProjectSlug:     state.ProjectSlug.ValueString(),
 		EnvironmentSlug: state.EnvironmentSlug.ValueString(),
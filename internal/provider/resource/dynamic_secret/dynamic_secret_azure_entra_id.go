@@ -0,0 +1,76 @@
+package resource
+
+import (
+	"context"
+	infisical "terraform-provider-infisical/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type DynamicSecretAzureEntraIdConfigurationModel struct {
+	ConnectionId types.String `tfsdk:"connection_id"`
+	UserId       types.String `tfsdk:"user_id"`
+}
+
+func NewDynamicSecretAzureEntraIdResource() resource.Resource {
+	return &DynamicSecretBaseResource{
+		Provider:          infisical.DynamicSecretProviderAzureEntraId,
+		ResourceTypeName:  "_dynamic_secret_azure_entra_id",
+		DynamicSecretName: "Azure Entra ID",
+		ConfigurationAttributes: map[string]schema.Attribute{
+			"connection_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Azure Client Secrets app connection Infisical uses to manage the user's credentials. The application of the connection must be allowed to update user passwords.",
+			},
+			"user_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The object ID of the Entra ID user Infisical issues passwords for.",
+			},
+		},
+
+		ReadConfigurationFromPlan: func(ctx context.Context, plan DynamicSecretBaseResourceModel, config DynamicSecretBaseResourceModel) (map[string]any, diag.Diagnostics) {
+			configurationMap := make(map[string]any)
+			var configuration DynamicSecretAzureEntraIdConfigurationModel
+
+			diags := plan.Configuration.As(ctx, &configuration, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			configurationMap["connectionId"] = configuration.ConnectionId.ValueString()
+			configurationMap["userId"] = configuration.UserId.ValueString()
+
+			return configurationMap, diags
+		},
+
+		ReadConfigurationFromApi: func(ctx context.Context, dynamicSecret infisical.DynamicSecret, configState types.Object) (types.Object, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			inputs := dynamicSecretInputs{inputs: dynamicSecret.Inputs, existing: configState, diags: &diags}
+
+			configuration := map[string]attr.Value{
+				"connection_id": inputs.requiredString("connectionId"),
+				"user_id":       inputs.requiredString("userId"),
+			}
+			if diags.HasError() {
+				return types.ObjectNull(map[string]attr.Type{}), diags
+			}
+
+			attributeTypes := map[string]attr.Type{
+				"connection_id": types.StringType,
+				"user_id":       types.StringType,
+			}
+
+			obj, objDiags := types.ObjectValue(attributeTypes, configuration)
+			if objDiags.HasError() {
+				diags.Append(objDiags...)
+				return types.ObjectNull(map[string]attr.Type{}), diags
+			}
+			return obj, diags
+		},
+	}
+}
@@ -0,0 +1,139 @@
+package resource
+
+import (
+	"context"
+	"maps"
+	infisical "terraform-provider-infisical/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type DynamicSecretElasticSearchConfigurationModel struct {
+	Host              types.String `tfsdk:"host"`
+	Port              types.Int64  `tfsdk:"port"`
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+	Roles             types.List   `tfsdk:"roles"`
+	Ca                types.String `tfsdk:"ca"`
+	GatewayId         types.String `tfsdk:"gateway_id"`
+}
+
+func NewDynamicSecretElasticSearchResource() resource.Resource {
+	configurationAttributes := map[string]schema.Attribute{
+		"host": schema.StringAttribute{
+			Required:    true,
+			Description: "The host of the Elasticsearch cluster, including the protocol, e.g. `https://elasticsearch.example.com`.",
+		},
+		"port": schema.Int64Attribute{
+			Required:    true,
+			Description: "The port of the Elasticsearch cluster.",
+		},
+		"username": schema.StringAttribute{
+			Required:    true,
+			Description: "The username to use to connect to the cluster. The user must be allowed to manage security.",
+		},
+		"roles": schema.ListAttribute{
+			Required:    true,
+			ElementType: types.StringType,
+			Description: "The roles to assign to the user of a lease. Roles can be built-in or custom.",
+		},
+		"ca": schema.StringAttribute{
+			Optional:    true,
+			Description: "The CA certificate to use to connect to the cluster over TLS.",
+		},
+		"gateway_id": schema.StringAttribute{
+			Optional:    true,
+			Description: "The Gateway ID to use to connect to the Elasticsearch cluster.",
+		},
+	}
+	maps.Copy(configurationAttributes, dynamicSecretPasswordAttributes("the Elasticsearch cluster"))
+
+	return &DynamicSecretBaseResource{
+		Provider:                infisical.DynamicSecretProviderElasticSearch,
+		ResourceTypeName:        "_dynamic_secret_elasticsearch",
+		DynamicSecretName:       "Elasticsearch",
+		ConfigurationAttributes: configurationAttributes,
+
+		ReadConfigurationFromPlan: func(ctx context.Context, plan DynamicSecretBaseResourceModel, config DynamicSecretBaseResourceModel) (map[string]any, diag.Diagnostics) {
+			configurationMap := make(map[string]any)
+			var configuration DynamicSecretElasticSearchConfigurationModel
+
+			diags := plan.Configuration.As(ctx, &configuration, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			configurationMap["host"] = configuration.Host.ValueString()
+			configurationMap["port"] = configuration.Port.ValueInt64()
+			configurationMap["auth"] = map[string]any{
+				"type":     "user",
+				"username": configuration.Username.ValueString(),
+				"password": dynamicSecretPassword(configuration.Password, config),
+			}
+			configurationMap["ca"] = configuration.Ca.ValueString()
+			configurationMap["gatewayId"] = configuration.GatewayId.ValueString()
+
+			var roles []string
+			diags.Append(configuration.Roles.ElementsAs(ctx, &roles, false)...)
+			if diags.HasError() {
+				return nil, diags
+			}
+			configurationMap["roles"] = roles
+
+			return configurationMap, diags
+		},
+
+		ReadConfigurationFromApi: func(ctx context.Context, dynamicSecret infisical.DynamicSecret, configState types.Object) (types.Object, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			inputs := dynamicSecretInputs{inputs: dynamicSecret.Inputs, existing: configState, diags: &diags}
+
+			// The credentials are nested under auth, next to its type
+			auth, ok := dynamicSecret.Inputs["auth"].(map[string]any)
+			if !ok {
+				diags.AddError(
+					"Invalid auth type",
+					"Expected 'auth' to be an object but got something else",
+				)
+				return types.ObjectNull(map[string]attr.Type{}), diags
+			}
+			authInputs := dynamicSecretInputs{inputs: auth, existing: configState, diags: &diags}
+
+			configuration := map[string]attr.Value{
+				"host":       inputs.requiredString("host"),
+				"port":       inputs.requiredInt64("port"),
+				"username":   authInputs.requiredString("username"),
+				"roles":      inputs.stringList("roles"),
+				"ca":         inputs.optionalString("ca"),
+				"gateway_id": inputs.optionalString("gatewayId"),
+			}
+			maps.Copy(configuration, authInputs.password())
+			if diags.HasError() {
+				return types.ObjectNull(map[string]attr.Type{}), diags
+			}
+
+			attributeTypes := map[string]attr.Type{
+				"host":       types.StringType,
+				"port":       types.Int64Type,
+				"username":   types.StringType,
+				"roles":      types.ListType{ElemType: types.StringType},
+				"ca":         types.StringType,
+				"gateway_id": types.StringType,
+			}
+			maps.Copy(attributeTypes, dynamicSecretPasswordAttributeTypes)
+
+			obj, objDiags := types.ObjectValue(attributeTypes, configuration)
+			if objDiags.HasError() {
+				diags.Append(objDiags...)
+				return types.ObjectNull(map[string]attr.Type{}), diags
+			}
+			return obj, diags
+		},
+	}
+}
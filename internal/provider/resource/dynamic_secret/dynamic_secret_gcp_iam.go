@@ -0,0 +1,76 @@
+package resource
+
+import (
+	"context"
+	infisical "terraform-provider-infisical/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type DynamicSecretGcpIamConfigurationModel struct {
+	ConnectionId        types.String `tfsdk:"connection_id"`
+	ServiceAccountEmail types.String `tfsdk:"service_account_email"`
+}
+
+func NewDynamicSecretGcpIamResource() resource.Resource {
+	return &DynamicSecretBaseResource{
+		Provider:          infisical.DynamicSecretProviderGcpIam,
+		ResourceTypeName:  "_dynamic_secret_gcp_iam",
+		DynamicSecretName: "GCP IAM",
+		ConfigurationAttributes: map[string]schema.Attribute{
+			"connection_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the GCP app connection Infisical uses to impersonate the service account. The service account of the connection must have the Service Account Token Creator role on it.",
+			},
+			"service_account_email": schema.StringAttribute{
+				Required:    true,
+				Description: "The email of the service account leases issue access tokens for.",
+			},
+		},
+
+		ReadConfigurationFromPlan: func(ctx context.Context, plan DynamicSecretBaseResourceModel, config DynamicSecretBaseResourceModel) (map[string]any, diag.Diagnostics) {
+			configurationMap := make(map[string]any)
+			var configuration DynamicSecretGcpIamConfigurationModel
+
+			diags := plan.Configuration.As(ctx, &configuration, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			configurationMap["connectionId"] = configuration.ConnectionId.ValueString()
+			configurationMap["serviceAccountEmail"] = configuration.ServiceAccountEmail.ValueString()
+
+			return configurationMap, diags
+		},
+
+		ReadConfigurationFromApi: func(ctx context.Context, dynamicSecret infisical.DynamicSecret, configState types.Object) (types.Object, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			inputs := dynamicSecretInputs{inputs: dynamicSecret.Inputs, existing: configState, diags: &diags}
+
+			configuration := map[string]attr.Value{
+				"connection_id":         inputs.requiredString("connectionId"),
+				"service_account_email": inputs.requiredString("serviceAccountEmail"),
+			}
+			if diags.HasError() {
+				return types.ObjectNull(map[string]attr.Type{}), diags
+			}
+
+			attributeTypes := map[string]attr.Type{
+				"connection_id":         types.StringType,
+				"service_account_email": types.StringType,
+			}
+
+			obj, objDiags := types.ObjectValue(attributeTypes, configuration)
+			if objDiags.HasError() {
+				diags.Append(objDiags...)
+				return types.ObjectNull(map[string]attr.Type{}), diags
+			}
+			return obj, diags
+		},
+	}
+}
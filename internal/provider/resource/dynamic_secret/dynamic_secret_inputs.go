@@ -0,0 +1,212 @@
+package resource
+
+import (
+	"fmt"
+	pkg "terraform-provider-infisical/internal/pkg/modifiers"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// dynamicSecretPasswordAttributes returns the password, password_wo and password_wo_version
+// attributes of a configuration that takes the password of the user Infisical connects as.
+func dynamicSecretPasswordAttributes(target string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"password": schema.StringAttribute{
+			Optional:    true,
+			Description: fmt.Sprintf("The password to use to connect to %s. This value is stored in the Terraform state; use password_wo to keep it out of state. Exactly one of password or password_wo must be set.", target),
+			Sensitive:   true,
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(
+					path.MatchRelative().AtParent().AtName("password_wo"),
+				),
+			},
+		},
+		"password_wo": schema.StringAttribute{
+			Optional:    true,
+			WriteOnly:   true,
+			Description: fmt.Sprintf("The password to use to connect to %s (write-only). This value is never stored in the Terraform state and can accept ephemeral values. Because it is not stored, changes to it are not detected; increment password_wo_version to push a new value. Requires Terraform 1.11+.", target),
+			Sensitive:   true,
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(
+					path.MatchRelative().AtParent().AtName("password_wo_version"),
+				),
+			},
+		},
+		"password_wo_version": schema.Int64Attribute{
+			Optional:    true,
+			Description: "The version of the password_wo value. Increment this to trigger an update of the password.",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+				int64validator.AlsoRequires(
+					path.MatchRelative().AtParent().AtName("password_wo"),
+				),
+			},
+		},
+	}
+}
+
+// dynamicSecretPassword returns the password to send to the API. Write-only values are stripped
+// from the plan, so password_wo is read from config.
+func dynamicSecretPassword(password types.String, config DynamicSecretBaseResourceModel) string {
+	if passwordWO, ok := config.Configuration.Attributes()["password_wo"].(types.String); ok && !passwordWO.IsNull() {
+		return passwordWO.ValueString()
+	}
+	return password.ValueString()
+}
+
+// dynamicSecretInputs reads the inputs of a dynamic secret returned by the API into attribute
+// values, reporting inputs of an unexpected type in diags. existing is the configuration in
+// state, used to keep what the API can't return as it was configured.
+type dynamicSecretInputs struct {
+	inputs   map[string]any
+	existing types.Object
+	diags    *diag.Diagnostics
+}
+
+func (in dynamicSecretInputs) invalid(key string, expected string) {
+	in.diags.AddError(
+		fmt.Sprintf("Invalid %s type", key),
+		fmt.Sprintf("Expected '%s' to be %s but got something else", key, expected),
+	)
+}
+
+// requiredString reads a string input the API always returns.
+func (in dynamicSecretInputs) requiredString(key string) types.String {
+	value, ok := in.inputs[key].(string)
+	if !ok {
+		in.invalid(key, "a string")
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// optionalString reads a string input, which is null when missing or empty.
+func (in dynamicSecretInputs) optionalString(key string) types.String {
+	value, ok := in.inputs[key].(string)
+	if !ok || value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// statement reads a statement input, keeping the configured formatting of the attribute when
+// the API returns the same statement with different surrounding whitespace.
+func (in dynamicSecretInputs) statement(key string, attribute string) types.String {
+	value, _ := in.inputs[key].(string)
+
+	configured, _ := in.existing.Attributes()[attribute].(types.String)
+	value = infisicaltf.PreserveStringIfTrimmedEqual(value, configured)
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// requiredInt64 reads a number input the API always returns.
+func (in dynamicSecretInputs) requiredInt64(key string) types.Int64 {
+	value, ok := in.inputs[key].(float64)
+	if !ok {
+		in.invalid(key, "a number")
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(value))
+}
+
+// stringList reads a list of strings input, which is null when missing or empty.
+func (in dynamicSecretInputs) stringList(key string) types.List {
+	raw, ok := in.inputs[key]
+	if !ok || raw == nil {
+		return types.ListNull(types.StringType)
+	}
+
+	values, ok := raw.([]any)
+	if !ok {
+		in.invalid(key, "an array")
+		return types.ListNull(types.StringType)
+	}
+	if len(values) == 0 {
+		return types.ListNull(types.StringType)
+	}
+
+	elements := make([]attr.Value, 0, len(values))
+	for _, value := range values {
+		stringValue, ok := value.(string)
+		if !ok {
+			in.invalid(key, "an array of strings")
+			return types.ListNull(types.StringType)
+		}
+		elements = append(elements, types.StringValue(stringValue))
+	}
+
+	list, diags := types.ListValue(types.StringType, elements)
+	in.diags.Append(diags...)
+	return list
+}
+
+// password returns the password, password_wo and password_wo_version attribute values. When
+// password_wo is used, password is null in plan and state and must stay null so the password the
+// API returns is never written to the state.
+func (in dynamicSecretInputs) password() map[string]attr.Value {
+	configured := in.existing.Attributes()
+
+	password := types.StringNull()
+	if existing, ok := configured["password"].(types.String); ok && !existing.IsNull() {
+		password = in.requiredString("password")
+	}
+
+	version, ok := configured["password_wo_version"].(types.Int64)
+	if !ok {
+		version = types.Int64Null()
+	}
+
+	return map[string]attr.Value{
+		"password":            password,
+		"password_wo":         types.StringNull(),
+		"password_wo_version": version,
+	}
+}
+
+// dynamicSecretPasswordAttributeTypes are the types of the attributes returned by
+// dynamicSecretPasswordAttributes.
+var dynamicSecretPasswordAttributeTypes = map[string]attr.Type{
+	"password":            types.StringType,
+	"password_wo":         types.StringType,
+	"password_wo_version": types.Int64Type,
+}
+
+// dynamicSecretStatementAttribute returns the attribute of a statement Infisical runs against the
+// target, whose formatting is kept as configured when the API returns it trimmed.
+func dynamicSecretStatementAttribute(required bool, description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Required:    required,
+		Optional:    !required,
+		Description: description,
+		PlanModifiers: []planmodifier.String{
+			pkg.TrimEqualityModifier{},
+		},
+	}
+}
+
+// optionalInt64 reads a number input, which is null when missing.
+func (in dynamicSecretInputs) optionalInt64(key string) types.Int64 {
+	raw, ok := in.inputs[key]
+	if !ok || raw == nil {
+		return types.Int64Null()
+	}
+
+	value, ok := raw.(float64)
+	if !ok {
+		in.invalid(key, "a number")
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(value))
+}
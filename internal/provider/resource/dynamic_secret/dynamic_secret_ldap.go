@@ -0,0 +1,84 @@
+package resource
+
+import (
+	"context"
+	infisical "terraform-provider-infisical/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type DynamicSecretLdapConfigurationModel struct {
+	ConnectionId   types.String `tfsdk:"connection_id"`
+	CreationLdif   types.String `tfsdk:"creation_ldif"`
+	RevocationLdif types.String `tfsdk:"revocation_ldif"`
+	RollbackLdif   types.String `tfsdk:"rollback_ldif"`
+}
+
+func NewDynamicSecretLdapResource() resource.Resource {
+	return &DynamicSecretBaseResource{
+		Provider:          infisical.DynamicSecretProviderLdap,
+		ResourceTypeName:  "_dynamic_secret_ldap",
+		DynamicSecretName: "LDAP",
+		ConfigurationAttributes: map[string]schema.Attribute{
+			"connection_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the LDAP app connection Infisical uses to manage the users of leases. The bind user of the connection must be allowed to create and delete users.",
+			},
+			"creation_ldif":   dynamicSecretStatementAttribute(true, "The LDIF used to create the user of a lease. {{Username}}, {{Password}} and {{EncodedPassword}} are replaced with the values of the lease."),
+			"revocation_ldif": dynamicSecretStatementAttribute(true, "The LDIF used to delete the user of a lease."),
+			"rollback_ldif":   dynamicSecretStatementAttribute(false, "The LDIF run when creating the user of a lease fails partway, to undo the changes already made."),
+		},
+
+		ReadConfigurationFromPlan: func(ctx context.Context, plan DynamicSecretBaseResourceModel, config DynamicSecretBaseResourceModel) (map[string]any, diag.Diagnostics) {
+			configurationMap := make(map[string]any)
+			var configuration DynamicSecretLdapConfigurationModel
+
+			diags := plan.Configuration.As(ctx, &configuration, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			configurationMap["credentialType"] = "dynamic"
+			configurationMap["connectionId"] = configuration.ConnectionId.ValueString()
+			configurationMap["creationLdif"] = configuration.CreationLdif.ValueString()
+			configurationMap["revocationLdif"] = configuration.RevocationLdif.ValueString()
+			configurationMap["rollbackLdif"] = configuration.RollbackLdif.ValueString()
+
+			return configurationMap, diags
+		},
+
+		ReadConfigurationFromApi: func(ctx context.Context, dynamicSecret infisical.DynamicSecret, configState types.Object) (types.Object, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			inputs := dynamicSecretInputs{inputs: dynamicSecret.Inputs, existing: configState, diags: &diags}
+
+			configuration := map[string]attr.Value{
+				"connection_id":   inputs.requiredString("connectionId"),
+				"creation_ldif":   inputs.statement("creationLdif", "creation_ldif"),
+				"revocation_ldif": inputs.statement("revocationLdif", "revocation_ldif"),
+				"rollback_ldif":   inputs.statement("rollbackLdif", "rollback_ldif"),
+			}
+			if diags.HasError() {
+				return types.ObjectNull(map[string]attr.Type{}), diags
+			}
+
+			attributeTypes := map[string]attr.Type{
+				"connection_id":   types.StringType,
+				"creation_ldif":   types.StringType,
+				"revocation_ldif": types.StringType,
+				"rollback_ldif":   types.StringType,
+			}
+
+			obj, objDiags := types.ObjectValue(attributeTypes, configuration)
+			if objDiags.HasError() {
+				diags.Append(objDiags...)
+				return types.ObjectNull(map[string]attr.Type{}), diags
+			}
+			return obj, diags
+		},
+	}
+}
@@ -0,0 +1,204 @@
+package resource
+
+import (
+	"context"
+	"maps"
+	infisical "terraform-provider-infisical/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type DynamicSecretRabbitMqConfigurationModel struct {
+	Host              types.String `tfsdk:"host"`
+	Port              types.Int64  `tfsdk:"port"`
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+	Tags              types.List   `tfsdk:"tags"`
+	Ca                types.String `tfsdk:"ca"`
+	GatewayId         types.String `tfsdk:"gateway_id"`
+	VirtualHost       types.Object `tfsdk:"virtual_host"`
+}
+
+type DynamicSecretRabbitMqVirtualHostModel struct {
+	Name      types.String `tfsdk:"name"`
+	Read      types.String `tfsdk:"read"`
+	Write     types.String `tfsdk:"write"`
+	Configure types.String `tfsdk:"configure"`
+}
+
+func NewDynamicSecretRabbitMqResource() resource.Resource {
+	configurationAttributes := map[string]schema.Attribute{
+		"host": schema.StringAttribute{
+			Required:    true,
+			Description: "The host of the RabbitMQ management API, including the protocol, e.g. `https://rabbitmq.example.com`.",
+		},
+		"port": schema.Int64Attribute{
+			Required:    true,
+			Description: "The port of the RabbitMQ management API.",
+		},
+		"username": schema.StringAttribute{
+			Required:    true,
+			Description: "The username to use to connect to the management API. The user must have the administrator tag.",
+		},
+		"tags": schema.ListAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "The tags to assign to the user of a lease, such as `management` or `monitoring`.",
+		},
+		"ca": schema.StringAttribute{
+			Optional:    true,
+			Description: "The CA certificate to use to connect to the management API over TLS.",
+		},
+		"gateway_id": schema.StringAttribute{
+			Optional:    true,
+			Description: "The Gateway ID to use to connect to the RabbitMQ server.",
+		},
+		"virtual_host": schema.SingleNestedAttribute{
+			Required:    true,
+			Description: "The virtual host the user of a lease is given access to.",
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Required:    true,
+					Description: "The name of the virtual host, e.g. `/`.",
+				},
+				"read": schema.StringAttribute{
+					Required:    true,
+					Description: "The regular expression of the resources the user can read from, e.g. `.*`.",
+				},
+				"write": schema.StringAttribute{
+					Required:    true,
+					Description: "The regular expression of the resources the user can write to, e.g. `.*`.",
+				},
+				"configure": schema.StringAttribute{
+					Required:    true,
+					Description: "The regular expression of the resources the user can configure, e.g. `.*`.",
+				},
+			},
+		},
+	}
+	maps.Copy(configurationAttributes, dynamicSecretPasswordAttributes("the management API"))
+
+	return &DynamicSecretBaseResource{
+		Provider:                infisical.DynamicSecretProviderRabbitMq,
+		ResourceTypeName:        "_dynamic_secret_rabbitmq",
+		DynamicSecretName:       "RabbitMQ",
+		ConfigurationAttributes: configurationAttributes,
+
+		ReadConfigurationFromPlan: func(ctx context.Context, plan DynamicSecretBaseResourceModel, config DynamicSecretBaseResourceModel) (map[string]any, diag.Diagnostics) {
+			configurationMap := make(map[string]any)
+			var configuration DynamicSecretRabbitMqConfigurationModel
+
+			diags := plan.Configuration.As(ctx, &configuration, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			configurationMap["host"] = configuration.Host.ValueString()
+			configurationMap["port"] = configuration.Port.ValueInt64()
+			configurationMap["username"] = configuration.Username.ValueString()
+			configurationMap["password"] = dynamicSecretPassword(configuration.Password, config)
+			configurationMap["ca"] = configuration.Ca.ValueString()
+			configurationMap["gatewayId"] = configuration.GatewayId.ValueString()
+
+			tags := []string{}
+			diags.Append(configuration.Tags.ElementsAs(ctx, &tags, false)...)
+			if diags.HasError() {
+				return nil, diags
+			}
+			configurationMap["tags"] = tags
+
+			var virtualHost DynamicSecretRabbitMqVirtualHostModel
+			diags.Append(configuration.VirtualHost.As(ctx, &virtualHost, basetypes.ObjectAsOptions{})...)
+			if diags.HasError() {
+				return nil, diags
+			}
+			configurationMap["virtualHost"] = map[string]any{
+				"name": virtualHost.Name.ValueString(),
+				"permissions": map[string]any{
+					"read":      virtualHost.Read.ValueString(),
+					"write":     virtualHost.Write.ValueString(),
+					"configure": virtualHost.Configure.ValueString(),
+				},
+			}
+
+			return configurationMap, diags
+		},
+
+		ReadConfigurationFromApi: func(ctx context.Context, dynamicSecret infisical.DynamicSecret, configState types.Object) (types.Object, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			inputs := dynamicSecretInputs{inputs: dynamicSecret.Inputs, existing: configState, diags: &diags}
+
+			configuration := map[string]attr.Value{
+				"host":       inputs.requiredString("host"),
+				"port":       inputs.requiredInt64("port"),
+				"username":   inputs.requiredString("username"),
+				"tags":       inputs.stringList("tags"),
+				"ca":         inputs.optionalString("ca"),
+				"gateway_id": inputs.optionalString("gatewayId"),
+			}
+			maps.Copy(configuration, inputs.password())
+
+			virtualHostInputs, ok := dynamicSecret.Inputs["virtualHost"].(map[string]any)
+			if !ok {
+				diags.AddError(
+					"Invalid virtualHost type",
+					"Expected 'virtualHost' to be an object but got something else",
+				)
+				return types.ObjectNull(map[string]attr.Type{}), diags
+			}
+			permissionsInputs, ok := virtualHostInputs["permissions"].(map[string]any)
+			if !ok {
+				diags.AddError(
+					"Invalid permissions type",
+					"Expected 'virtualHost.permissions' to be an object but got something else",
+				)
+				return types.ObjectNull(map[string]attr.Type{}), diags
+			}
+			permissions := dynamicSecretInputs{inputs: permissionsInputs, diags: &diags}
+
+			virtualHost, virtualHostDiags := types.ObjectValue(dynamicSecretRabbitMqVirtualHostAttributeTypes, map[string]attr.Value{
+				"name":      dynamicSecretInputs{inputs: virtualHostInputs, diags: &diags}.requiredString("name"),
+				"read":      permissions.requiredString("read"),
+				"write":     permissions.requiredString("write"),
+				"configure": permissions.requiredString("configure"),
+			})
+			diags.Append(virtualHostDiags...)
+			configuration["virtual_host"] = virtualHost
+			if diags.HasError() {
+				return types.ObjectNull(map[string]attr.Type{}), diags
+			}
+
+			attributeTypes := map[string]attr.Type{
+				"host":         types.StringType,
+				"port":         types.Int64Type,
+				"username":     types.StringType,
+				"tags":         types.ListType{ElemType: types.StringType},
+				"ca":           types.StringType,
+				"gateway_id":   types.StringType,
+				"virtual_host": types.ObjectType{AttrTypes: dynamicSecretRabbitMqVirtualHostAttributeTypes},
+			}
+			maps.Copy(attributeTypes, dynamicSecretPasswordAttributeTypes)
+
+			obj, objDiags := types.ObjectValue(attributeTypes, configuration)
+			if objDiags.HasError() {
+				diags.Append(objDiags...)
+				return types.ObjectNull(map[string]attr.Type{}), diags
+			}
+			return obj, diags
+		},
+	}
+}
+
+var dynamicSecretRabbitMqVirtualHostAttributeTypes = map[string]attr.Type{
+	"name":      types.StringType,
+	"read":      types.StringType,
+	"write":     types.StringType,
+	"configure": types.StringType,
+}
@@ -0,0 +1,125 @@
+package resource
+
+import (
+	"context"
+	"maps"
+	infisical "terraform-provider-infisical/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type DynamicSecretRedisConfigurationModel struct {
+	Host                types.String `tfsdk:"host"`
+	Port                types.Int64  `tfsdk:"port"`
+	Username            types.String `tfsdk:"username"`
+	Password            types.String `tfsdk:"password"`
+	PasswordWO          types.String `tfsdk:"password_wo"`
+	PasswordWOVersion   types.Int64  `tfsdk:"password_wo_version"`
+	CreationStatement   types.String `tfsdk:"creation_statement"`
+	RevocationStatement types.String `tfsdk:"revocation_statement"`
+	RenewStatement      types.String `tfsdk:"renew_statement"`
+	Ca                  types.String `tfsdk:"ca"`
+	GatewayId           types.String `tfsdk:"gateway_id"`
+}
+
+func NewDynamicSecretRedisResource() resource.Resource {
+	configurationAttributes := map[string]schema.Attribute{
+		"host": schema.StringAttribute{
+			Required:    true,
+			Description: "The host of the Redis server.",
+		},
+		"port": schema.Int64Attribute{
+			Required:    true,
+			Description: "The port of the Redis server.",
+		},
+		"username": schema.StringAttribute{
+			Required:    true,
+			Description: "The username to use to connect to the Redis server. The user must be allowed to manage ACL users.",
+		},
+		"creation_statement":   dynamicSecretStatementAttribute(true, "The ACL statement used to create the user of a lease. {{username}}, {{password}} and {{expiration}} are replaced with the values of the lease."),
+		"revocation_statement": dynamicSecretStatementAttribute(true, "The ACL statement used to delete the user of a lease."),
+		"renew_statement":      dynamicSecretStatementAttribute(false, "The statement used to extend the user of a lease when it is renewed."),
+		"ca": schema.StringAttribute{
+			Optional:    true,
+			Description: "The CA certificate to use to connect to the Redis server over TLS.",
+		},
+		"gateway_id": schema.StringAttribute{
+			Optional:    true,
+			Description: "The Gateway ID to use to connect to the Redis server.",
+		},
+	}
+	maps.Copy(configurationAttributes, dynamicSecretPasswordAttributes("the Redis server"))
+
+	return &DynamicSecretBaseResource{
+		Provider:                infisical.DynamicSecretProviderRedis,
+		ResourceTypeName:        "_dynamic_secret_redis",
+		DynamicSecretName:       "Redis",
+		ConfigurationAttributes: configurationAttributes,
+
+		ReadConfigurationFromPlan: func(ctx context.Context, plan DynamicSecretBaseResourceModel, config DynamicSecretBaseResourceModel) (map[string]any, diag.Diagnostics) {
+			configurationMap := make(map[string]any)
+			var configuration DynamicSecretRedisConfigurationModel
+
+			diags := plan.Configuration.As(ctx, &configuration, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			configurationMap["host"] = configuration.Host.ValueString()
+			configurationMap["port"] = configuration.Port.ValueInt64()
+			configurationMap["username"] = configuration.Username.ValueString()
+			configurationMap["password"] = dynamicSecretPassword(configuration.Password, config)
+			configurationMap["creationStatement"] = configuration.CreationStatement.ValueString()
+			configurationMap["revocationStatement"] = configuration.RevocationStatement.ValueString()
+			configurationMap["renewStatement"] = configuration.RenewStatement.ValueString()
+			configurationMap["ca"] = configuration.Ca.ValueString()
+			configurationMap["gatewayId"] = configuration.GatewayId.ValueString()
+
+			return configurationMap, diags
+		},
+
+		ReadConfigurationFromApi: func(ctx context.Context, dynamicSecret infisical.DynamicSecret, configState types.Object) (types.Object, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			inputs := dynamicSecretInputs{inputs: dynamicSecret.Inputs, existing: configState, diags: &diags}
+
+			configuration := map[string]attr.Value{
+				"host":                 inputs.requiredString("host"),
+				"port":                 inputs.requiredInt64("port"),
+				"username":             inputs.requiredString("username"),
+				"creation_statement":   inputs.statement("creationStatement", "creation_statement"),
+				"revocation_statement": inputs.statement("revocationStatement", "revocation_statement"),
+				"renew_statement":      inputs.statement("renewStatement", "renew_statement"),
+				"ca":                   inputs.optionalString("ca"),
+				"gateway_id":           inputs.optionalString("gatewayId"),
+			}
+			maps.Copy(configuration, inputs.password())
+			if diags.HasError() {
+				return types.ObjectNull(map[string]attr.Type{}), diags
+			}
+
+			attributeTypes := map[string]attr.Type{
+				"host":                 types.StringType,
+				"port":                 types.Int64Type,
+				"username":             types.StringType,
+				"creation_statement":   types.StringType,
+				"revocation_statement": types.StringType,
+				"renew_statement":      types.StringType,
+				"ca":                   types.StringType,
+				"gateway_id":           types.StringType,
+			}
+			maps.Copy(attributeTypes, dynamicSecretPasswordAttributeTypes)
+
+			obj, objDiags := types.ObjectValue(attributeTypes, configuration)
+			if objDiags.HasError() {
+				diags.Append(objDiags...)
+				return types.ObjectNull(map[string]attr.Type{}), diags
+			}
+			return obj, diags
+		},
+	}
+}
@@ -0,0 +1,117 @@
+package resource
+
+import (
+	"context"
+	"maps"
+	infisical "terraform-provider-infisical/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type DynamicSecretSnowflakeConfigurationModel struct {
+	OrgId               types.String `tfsdk:"org_id"`
+	AccountId           types.String `tfsdk:"account_id"`
+	Username            types.String `tfsdk:"username"`
+	Password            types.String `tfsdk:"password"`
+	PasswordWO          types.String `tfsdk:"password_wo"`
+	PasswordWOVersion   types.Int64  `tfsdk:"password_wo_version"`
+	CreationStatement   types.String `tfsdk:"creation_statement"`
+	RevocationStatement types.String `tfsdk:"revocation_statement"`
+	RenewStatement      types.String `tfsdk:"renew_statement"`
+	GatewayId           types.String `tfsdk:"gateway_id"`
+}
+
+func NewDynamicSecretSnowflakeResource() resource.Resource {
+	configurationAttributes := map[string]schema.Attribute{
+		"org_id": schema.StringAttribute{
+			Required:    true,
+			Description: "The identifier of the Snowflake organization.",
+		},
+		"account_id": schema.StringAttribute{
+			Required:    true,
+			Description: "The identifier of the Snowflake account within the organization.",
+		},
+		"username": schema.StringAttribute{
+			Required:    true,
+			Description: "The username to use to connect to Snowflake. The user must be allowed to create and drop users.",
+		},
+		"creation_statement":   dynamicSecretStatementAttribute(true, "The SQL statement used to create the user of a lease. {{username}}, {{password}} and {{expiration}} are replaced with the values of the lease."),
+		"revocation_statement": dynamicSecretStatementAttribute(true, "The SQL statement used to drop the user of a lease."),
+		"renew_statement":      dynamicSecretStatementAttribute(false, "The SQL statement used to extend the user of a lease when it is renewed."),
+		"gateway_id": schema.StringAttribute{
+			Optional:    true,
+			Description: "The Gateway ID to use to connect to Snowflake.",
+		},
+	}
+	maps.Copy(configurationAttributes, dynamicSecretPasswordAttributes("Snowflake"))
+
+	return &DynamicSecretBaseResource{
+		Provider:                infisical.DynamicSecretProviderSnowflake,
+		ResourceTypeName:        "_dynamic_secret_snowflake",
+		DynamicSecretName:       "Snowflake",
+		ConfigurationAttributes: configurationAttributes,
+
+		ReadConfigurationFromPlan: func(ctx context.Context, plan DynamicSecretBaseResourceModel, config DynamicSecretBaseResourceModel) (map[string]any, diag.Diagnostics) {
+			configurationMap := make(map[string]any)
+			var configuration DynamicSecretSnowflakeConfigurationModel
+
+			diags := plan.Configuration.As(ctx, &configuration, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			configurationMap["orgId"] = configuration.OrgId.ValueString()
+			configurationMap["accountId"] = configuration.AccountId.ValueString()
+			configurationMap["username"] = configuration.Username.ValueString()
+			configurationMap["password"] = dynamicSecretPassword(configuration.Password, config)
+			configurationMap["creationStatement"] = configuration.CreationStatement.ValueString()
+			configurationMap["revocationStatement"] = configuration.RevocationStatement.ValueString()
+			configurationMap["renewStatement"] = configuration.RenewStatement.ValueString()
+			configurationMap["gatewayId"] = configuration.GatewayId.ValueString()
+
+			return configurationMap, diags
+		},
+
+		ReadConfigurationFromApi: func(ctx context.Context, dynamicSecret infisical.DynamicSecret, configState types.Object) (types.Object, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			inputs := dynamicSecretInputs{inputs: dynamicSecret.Inputs, existing: configState, diags: &diags}
+
+			configuration := map[string]attr.Value{
+				"org_id":               inputs.requiredString("orgId"),
+				"account_id":           inputs.requiredString("accountId"),
+				"username":             inputs.requiredString("username"),
+				"creation_statement":   inputs.statement("creationStatement", "creation_statement"),
+				"revocation_statement": inputs.statement("revocationStatement", "revocation_statement"),
+				"renew_statement":      inputs.statement("renewStatement", "renew_statement"),
+				"gateway_id":           inputs.optionalString("gatewayId"),
+			}
+			maps.Copy(configuration, inputs.password())
+			if diags.HasError() {
+				return types.ObjectNull(map[string]attr.Type{}), diags
+			}
+
+			attributeTypes := map[string]attr.Type{
+				"org_id":               types.StringType,
+				"account_id":           types.StringType,
+				"username":             types.StringType,
+				"creation_statement":   types.StringType,
+				"revocation_statement": types.StringType,
+				"renew_statement":      types.StringType,
+				"gateway_id":           types.StringType,
+			}
+			maps.Copy(attributeTypes, dynamicSecretPasswordAttributeTypes)
+
+			obj, objDiags := types.ObjectValue(attributeTypes, configuration)
+			if objDiags.HasError() {
+				diags.Append(objDiags...)
+				return types.ObjectNull(map[string]attr.Type{}), diags
+			}
+			return obj, diags
+		},
+	}
+}
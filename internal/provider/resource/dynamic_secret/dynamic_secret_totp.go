@@ -0,0 +1,167 @@
+package resource
+
+import (
+	"context"
+	infisical "terraform-provider-infisical/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type DynamicSecretTotpConfigurationModel struct {
+	Url       types.String `tfsdk:"url"`
+	SecretKey types.String `tfsdk:"secret_key"`
+	Period    types.Int64  `tfsdk:"period"`
+	Algorithm types.String `tfsdk:"algorithm"`
+	Digits    types.Int64  `tfsdk:"digits"`
+}
+
+const (
+	DynamicSecretTotpConfigTypeUrl    = "url"
+	DynamicSecretTotpConfigTypeManual = "manual"
+)
+
+func NewDynamicSecretTotpResource() resource.Resource {
+	conflictsWithUrl := path.MatchRelative().AtParent().AtName("url")
+
+	return &DynamicSecretBaseResource{
+		Provider:          infisical.DynamicSecretProviderTotp,
+		ResourceTypeName:  "_dynamic_secret_totp",
+		DynamicSecretName: "TOTP",
+		ConfigurationAttributes: map[string]schema.Attribute{
+			"url": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The otpauth:// URL of the TOTP seed, as encoded in enrollment QR codes. Exactly one of url or secret_key must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRelative().AtParent().AtName("secret_key"),
+					),
+				},
+			},
+			"secret_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The base32-encoded secret key of the TOTP seed.",
+			},
+			"period": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The number of seconds a code is valid for. Only applicable with secret_key; defaults to 30.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.ConflictsWith(conflictsWithUrl),
+				},
+			},
+			"algorithm": schema.StringAttribute{
+				Optional:    true,
+				Description: "The hash algorithm used to generate codes. Supported values are `sha1`, `sha256` and `sha512`. Only applicable with secret_key; defaults to `sha1`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("sha1", "sha256", "sha512"),
+					stringvalidator.ConflictsWith(conflictsWithUrl),
+				},
+			},
+			"digits": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The number of digits of a code. Only applicable with secret_key; defaults to 6.",
+				Validators: []validator.Int64{
+					int64validator.Between(6, 8),
+					int64validator.ConflictsWith(conflictsWithUrl),
+				},
+			},
+		},
+
+		ReadConfigurationFromPlan: func(ctx context.Context, plan DynamicSecretBaseResourceModel, config DynamicSecretBaseResourceModel) (map[string]any, diag.Diagnostics) {
+			configurationMap := make(map[string]any)
+			var configuration DynamicSecretTotpConfigurationModel
+
+			diags := plan.Configuration.As(ctx, &configuration, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			if !configuration.Url.IsNull() {
+				configurationMap["configType"] = DynamicSecretTotpConfigTypeUrl
+				configurationMap["url"] = configuration.Url.ValueString()
+				return configurationMap, diags
+			}
+
+			configurationMap["configType"] = DynamicSecretTotpConfigTypeManual
+			configurationMap["secretKey"] = configuration.SecretKey.ValueString()
+			if !configuration.Period.IsNull() {
+				configurationMap["period"] = configuration.Period.ValueInt64()
+			}
+			if !configuration.Algorithm.IsNull() {
+				configurationMap["algorithm"] = configuration.Algorithm.ValueString()
+			}
+			if !configuration.Digits.IsNull() {
+				configurationMap["digits"] = configuration.Digits.ValueInt64()
+			}
+
+			return configurationMap, diags
+		},
+
+		ReadConfigurationFromApi: func(ctx context.Context, dynamicSecret infisical.DynamicSecret, configState types.Object) (types.Object, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			inputs := dynamicSecretInputs{inputs: dynamicSecret.Inputs, existing: configState, diags: &diags}
+
+			configuration := map[string]attr.Value{
+				"url":        types.StringNull(),
+				"secret_key": types.StringNull(),
+				"period":     types.Int64Null(),
+				"algorithm":  types.StringNull(),
+				"digits":     types.Int64Null(),
+			}
+
+			switch configType := inputs.requiredString("configType"); configType.ValueString() {
+			case DynamicSecretTotpConfigTypeUrl:
+				configuration["url"] = inputs.requiredString("url")
+			case DynamicSecretTotpConfigTypeManual:
+				configuration["secret_key"] = inputs.requiredString("secretKey")
+
+				// The API fills in defaults for the settings left unset, which must stay null
+				configured := configState.Attributes()
+				for attribute, value := range map[string]attr.Value{
+					"period":    inputs.optionalInt64("period"),
+					"algorithm": inputs.optionalString("algorithm"),
+					"digits":    inputs.optionalInt64("digits"),
+				} {
+					if existing, ok := configured[attribute]; ok && existing.IsNull() {
+						continue
+					}
+					configuration[attribute] = value
+				}
+			default:
+				if !configType.IsNull() {
+					diags.AddError(
+						"Invalid configType value",
+						"Expected 'configType' to be 'url' or 'manual' but got '"+configType.ValueString()+"'",
+					)
+				}
+			}
+			if diags.HasError() {
+				return types.ObjectNull(map[string]attr.Type{}), diags
+			}
+
+			obj, objDiags := types.ObjectValue(map[string]attr.Type{
+				"url":        types.StringType,
+				"secret_key": types.StringType,
+				"period":     types.Int64Type,
+				"algorithm":  types.StringType,
+				"digits":     types.Int64Type,
+			}, configuration)
+			if objDiags.HasError() {
+				diags.Append(objDiags...)
+				return types.ObjectNull(map[string]attr.Type{}), diags
+			}
+			return obj, diags
+		},
+	}
+}
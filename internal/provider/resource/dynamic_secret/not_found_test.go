@@ -0,0 +1,15 @@
+package resource
+
+import (
+	"terraform-provider-infisical/internal/provider/resource/resourcetest"
+	"testing"
+)
+
+func TestReadRemovesDynamicSecretsDeletedOutsideOfTerraform(t *testing.T) {
+	resourcetest.AssertReadRemovesWhenNotFound(t, NewDynamicSecretSqlDatabaseResource, map[string]any{
+		"project_slug":     "my-project",
+		"environment_slug": "dev",
+		"path":             "/",
+		"name":             "postgres",
+	})
+}
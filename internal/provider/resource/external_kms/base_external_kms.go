@@ -4,12 +4,14 @@ import (
 	"context"
 	"fmt"
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -31,6 +33,7 @@ type ExternalKmsBaseResourceModel struct {
 	Description     types.String `tfsdk:"description"`
 	Configuration   types.Object `tfsdk:"configuration"`
 	CredentialsHash types.String `tfsdk:"credentials_hash"`
+	DriftDetection  types.String `tfsdk:"drift_detection"`
 }
 
 // Metadata returns the resource type name.
@@ -64,6 +67,11 @@ func (r *ExternalKmsBaseResource) Schema(_ context.Context, _ resource.SchemaReq
 				Computed:    true,
 				Description: fmt.Sprintf("The hash of the %s External KMS credentials", r.ExternalKmsProviderName),
 			},
+			"drift_detection": schema.StringAttribute{
+				Optional:    true,
+				Description: infisicaltf.DriftDetectionDescription("external KMS credentials", infisicaltf.DriftDetectionReconcile),
+				Validators:  []validator.String{infisicaltf.DriftDetectionValidator},
+			},
 		},
 	}
 }
@@ -112,7 +120,7 @@ func (r *ExternalKmsBaseResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	kms, err := r.client.CreateExternalKms(infisical.CreateExternalKmsRequest{
+	kms, err := r.client.CreateExternalKms(ctx, infisical.CreateExternalKmsRequest{
 		Provider:      r.Provider,
 		Name:          plan.Name.ValueString(),
 		Description:   plan.Description.ValueString(),
@@ -155,40 +163,49 @@ func (r *ExternalKmsBaseResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	kms, err := r.client.GetExternalKmsById(infisical.GetExternalKmsByIdRequest{
+	kms, err := r.client.GetExternalKmsById(ctx, infisical.GetExternalKmsByIdRequest{
 		Provider: r.Provider,
 		ID:       state.ID.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
-			return
-		} else {
-			resp.Diagnostics.AddError(
-				"Error reading external KMS",
-				"Couldn't read external KMS, unexpected error: "+err.Error(),
-			)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
+		resp.Diagnostics.AddError(
+			"Error reading external KMS",
+			"Couldn't read external KMS, unexpected error: "+err.Error(),
+		)
+		return
 	}
 
 	if state.CredentialsHash.ValueString() != kms.ExternalKms.CredentialsHash {
-		resp.Diagnostics.AddWarning(
-			"External KMS credentials conflict",
-			fmt.Sprintf("The credentials for the %s External KMS with ID %s have been updated outside of Terraform.", r.ExternalKmsProviderName, state.ID.ValueString()),
-		)
+		switch infisicaltf.ResolveDriftDetectionMode(state.DriftDetection, infisicaltf.DriftDetectionReconcile) {
+		case infisicaltf.DriftDetectionIgnore:
+			// accept the remote credentials as the new baseline
+			state.CredentialsHash = types.StringValue(kms.ExternalKms.CredentialsHash)
+		case infisicaltf.DriftDetectionWarn:
+			resp.Diagnostics.AddWarning(
+				"External KMS credentials conflict",
+				fmt.Sprintf("The credentials for the %s External KMS with ID %s have been updated outside of Terraform.", r.ExternalKmsProviderName, state.ID.ValueString()),
+			)
+		default:
+			resp.Diagnostics.AddWarning(
+				"External KMS credentials conflict",
+				fmt.Sprintf("The credentials for the %s External KMS with ID %s have been updated outside of Terraform.", r.ExternalKmsProviderName, state.ID.ValueString()),
+			)
 
-		// force TF update
-		diags = r.OverwriteConfigurationFields(&state)
-		resp.Diagnostics.Append(diags...)
-		if diags.HasError() {
+			// force TF update
+			diags = r.OverwriteConfigurationFields(&state)
+			resp.Diagnostics.Append(diags...)
+			if diags.HasError() {
+				return
+			}
+
+			diags = resp.State.Set(ctx, state)
+			resp.Diagnostics.Append(diags...)
 			return
 		}
-
-		diags = resp.State.Set(ctx, state)
-		resp.Diagnostics.Append(diags...)
-		return
 	}
 
 	if !(state.Description.IsNull() && kms.Description == "") {
@@ -231,7 +248,7 @@ func (r *ExternalKmsBaseResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	externalKms, err := r.client.UpdateExternalKms(infisical.UpdateExternalKmsRequest{
+	externalKms, err := r.client.UpdateExternalKms(ctx, infisical.UpdateExternalKmsRequest{
 		ID:            state.ID.ValueString(),
 		Provider:      r.Provider,
 		Name:          plan.Name.ValueString(),
@@ -272,7 +289,7 @@ func (r *ExternalKmsBaseResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
-	_, err := r.client.DeleteExternalKms(infisical.DeleteExternalKmsRequest{
+	_, err := r.client.DeleteExternalKms(ctx, infisical.DeleteExternalKmsRequest{
 		Provider: r.Provider,
 		ID:       state.ID.ValueString(),
 	})
@@ -0,0 +1,96 @@
+package resource
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
+	"terraform-provider-infisical/internal/provider/resource/resourcetest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestReadCredentialsDrift(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"kms-id","name":"aws","externalKms":{"id":"external-kms-id","credentialsHash":"remote"}}`))
+	}))
+	defer server.Close()
+
+	cases := map[string]struct {
+		appliedHash    string
+		driftDetection any
+		wantWarning    bool
+		wantHash       string
+		wantKeyId      types.String
+	}{
+		"unchanged credentials": {
+			appliedHash: "remote",
+			wantHash:    "remote",
+			wantKeyId:   types.StringValue("AKIA"),
+		},
+		"reconcile by default": {
+			appliedHash: "applied",
+			wantWarning: true,
+			wantHash:    "applied",
+			wantKeyId:   types.StringNull(),
+		},
+		"warn": {
+			appliedHash:    "applied",
+			driftDetection: infisicaltf.DriftDetectionWarn,
+			wantWarning:    true,
+			wantHash:       "applied",
+			wantKeyId:      types.StringValue("AKIA"),
+		},
+		"ignore": {
+			appliedHash:    "applied",
+			driftDetection: infisicaltf.DriftDetectionIgnore,
+			wantHash:       "remote",
+			wantKeyId:      types.StringValue("AKIA"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := resourcetest.Configure(t, NewExternalKmsAwsResource(), server)
+			state := resourcetest.State(t, r, map[string]any{
+				"id":   "kms-id",
+				"name": "aws",
+				"configuration": map[string]any{
+					"type":           "access-key",
+					"aws_region":     "us-east-1",
+					"aws_kms_key_id": "key-id",
+					"credential":     map[string]any{"access_key_id": "AKIA"},
+				},
+				"credentials_hash": c.appliedHash,
+				"drift_detection":  c.driftDetection,
+			})
+
+			resp := resource.ReadResponse{State: state}
+			r.Read(context.Background(), resource.ReadRequest{State: state}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var hash, keyId types.String
+			resp.Diagnostics.Append(resp.State.GetAttribute(context.Background(), path.Root("credentials_hash"), &hash)...)
+			resp.Diagnostics.Append(resp.State.GetAttribute(context.Background(), path.Root("configuration").AtName("credential").AtName("access_key_id"), &keyId)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("reading the state: %v", resp.Diagnostics)
+			}
+
+			if got := resp.Diagnostics.WarningsCount() > 0; got != c.wantWarning {
+				t.Errorf("warned = %t, want %t: %v", got, c.wantWarning, resp.Diagnostics)
+			}
+			if hash.ValueString() != c.wantHash {
+				t.Errorf("got credentials_hash %q, want %q", hash.ValueString(), c.wantHash)
+			}
+			if !keyId.Equal(c.wantKeyId) {
+				t.Errorf("got configuration.credential.access_key_id %s, want %s", keyId, c.wantKeyId)
+			}
+		})
+	}
+}
@@ -0,0 +1,10 @@
+package resource
+
+import (
+	"terraform-provider-infisical/internal/provider/resource/resourcetest"
+	"testing"
+)
+
+func TestReadRemovesExternalKmsDeletedOutsideOfTerraform(t *testing.T) {
+	resourcetest.AssertReadRemovesWhenNotFound(t, NewExternalKmsAwsResource, map[string]any{"id": "external-kms-id"})
+}
@@ -2,8 +2,10 @@ package resource
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -108,7 +110,7 @@ func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest,
 		Role: plan.Role.ValueString(),
 	}
 
-	groupResponse, err := r.client.CreateGroup(request)
+	groupResponse, err := r.client.CreateGroup(ctx, request)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating group",
@@ -138,10 +140,9 @@ func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	groupResponse, err := r.client.GetGroupById(infisical.GetGroupByIdRequest{ID: state.ID.ValueString()})
+	groupResponse, err := r.client.GetGroupById(ctx, infisical.GetGroupByIdRequest{ID: state.ID.ValueString()})
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
 		resp.Diagnostics.AddError(
@@ -186,7 +187,7 @@ func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		Role: plan.Role.ValueString(),
 	}
 
-	_, err := r.client.UpdateGroup(request)
+	_, err := r.client.UpdateGroup(ctx, request)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating group",
@@ -215,7 +216,7 @@ func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	_, err := r.client.DeleteGroup(infisical.DeleteGroupRequest{ID: state.ID.ValueString()})
+	_, err := r.client.DeleteGroup(ctx, infisical.DeleteGroupRequest{ID: state.ID.ValueString()})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting group",
@@ -234,9 +235,9 @@ func (r *GroupResource) ImportState(ctx context.Context, req resource.ImportStat
 		return
 	}
 
-	groupResponse, err := r.client.GetGroupById(infisical.GetGroupByIdRequest{ID: req.ID})
+	groupResponse, err := r.client.GetGroupById(ctx, infisical.GetGroupByIdRequest{ID: req.ID})
 	if err != nil {
-		if err == infisical.ErrNotFound {
+		if errors.Is(err, infisical.ErrNotFound) {
 			resp.Diagnostics.AddError(
 				"Error importing group",
 				fmt.Sprintf("No group found with ID: %s", req.ID),
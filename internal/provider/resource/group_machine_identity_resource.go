@@ -2,9 +2,11 @@ package resource
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -87,7 +89,7 @@ func (r *GroupMachineIdentityResource) Create(ctx context.Context, req resource.
 		return
 	}
 
-	_, err := r.client.AddGroupMachineIdentity(infisical.AddGroupMachineIdentityRequest{
+	_, err := r.client.AddGroupMachineIdentity(ctx, infisical.AddGroupMachineIdentityRequest{
 		GroupID:    plan.GroupID.ValueString(),
 		IdentityID: plan.IdentityID.ValueString(),
 	})
@@ -124,12 +126,11 @@ func (r *GroupMachineIdentityResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
-	listResp, err := r.client.ListGroupMachineIdentities(infisical.ListGroupMachineIdentitiesRequest{
+	listResp, err := r.client.ListGroupMachineIdentities(ctx, infisical.ListGroupMachineIdentitiesRequest{
 		GroupID: state.GroupID.ValueString(),
 	})
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
 		resp.Diagnostics.AddError(
@@ -172,11 +173,11 @@ func (r *GroupMachineIdentityResource) Delete(ctx context.Context, req resource.
 
 	// Check membership before attempting removal so that a 403 from the delete
 	// endpoint unambiguously signals a permission error rather than "not a member".
-	listResp, err := r.client.ListGroupMachineIdentities(infisical.ListGroupMachineIdentitiesRequest{
+	listResp, err := r.client.ListGroupMachineIdentities(ctx, infisical.ListGroupMachineIdentitiesRequest{
 		GroupID: state.GroupID.ValueString(),
 	})
 	if err != nil {
-		if err == infisical.ErrNotFound {
+		if errors.Is(err, infisical.ErrNotFound) {
 			// Group no longer exists; desired state already achieved.
 			return
 		}
@@ -199,7 +200,7 @@ func (r *GroupMachineIdentityResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
-	_, err = r.client.RemoveGroupMachineIdentity(infisical.RemoveGroupMachineIdentityRequest{
+	_, err = r.client.RemoveGroupMachineIdentity(ctx, infisical.RemoveGroupMachineIdentityRequest{
 		GroupID:    state.GroupID.ValueString(),
 		IdentityID: state.IdentityID.ValueString(),
 	})
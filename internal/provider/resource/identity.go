@@ -2,8 +2,10 @@ package resource
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -149,7 +151,7 @@ func (r *IdentityResource) Create(ctx context.Context, req resource.CreateReques
 		}
 	}
 
-	newIdentity, err := r.client.CreateIdentity(infisical.CreateIdentityRequest{
+	newIdentity, err := r.client.CreateIdentity(ctx, infisical.CreateIdentityRequest{
 		OrgID:               plan.OrgID.ValueString(),
 		Name:                plan.Name.ValueString(),
 		HasDeleteProtection: plan.HasDeleteProtection.ValueBool(),
@@ -210,21 +212,19 @@ func (r *IdentityResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	// Get the latest data from the API
-	orgIdentity, err := r.client.GetIdentity(infisical.GetIdentityRequest{
+	orgIdentity, err := r.client.GetIdentity(ctx, infisical.GetIdentityRequest{
 		IdentityID: state.ID.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
-			return
-		} else {
-			resp.Diagnostics.AddError(
-				"Error reading identity",
-				"Couldn't read identity from Infisical, unexpected error: "+err.Error(),
-			)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
+		resp.Diagnostics.AddError(
+			"Error reading identity",
+			"Couldn't read identity from Infisical, unexpected error: "+err.Error(),
+		)
+		return
 	}
 
 	state.Name = types.StringValue(orgIdentity.Identity.Name)
@@ -302,7 +302,7 @@ func (r *IdentityResource) Update(ctx context.Context, req resource.UpdateReques
 		}
 	}
 
-	orgIdentity, err := r.client.UpdateIdentity(infisical.UpdateIdentityRequest{
+	orgIdentity, err := r.client.UpdateIdentity(ctx, infisical.UpdateIdentityRequest{
 		IdentityID:          state.ID.ValueString(),
 		Name:                plan.Name.ValueString(),
 		HasDeleteProtection: plan.HasDeleteProtection.ValueBool(),
@@ -355,7 +355,7 @@ func (r *IdentityResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	_, err := r.client.DeleteIdentity(infisical.DeleteIdentityRequest{
+	_, err := r.client.DeleteIdentity(ctx, infisical.DeleteIdentityRequest{
 		IdentityID: state.ID.ValueString(),
 	})
 
@@ -378,12 +378,12 @@ func (r *IdentityResource) ImportState(ctx context.Context, req resource.ImportS
 		return
 	}
 
-	orgIdentity, err := r.client.GetIdentity(infisical.GetIdentityRequest{
+	orgIdentity, err := r.client.GetIdentity(ctx, infisical.GetIdentityRequest{
 		IdentityID: req.ID,
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
+		if errors.Is(err, infisical.ErrNotFound) {
 			resp.Diagnostics.AddError(
 				"Error importing identity",
 				fmt.Sprintf("No identity found with ID: %s", req.ID),
@@ -2,6 +2,7 @@ package resource
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
 	"strconv"
@@ -203,7 +204,7 @@ func (r *IdentityAwsAuthResource) Create(ctx context.Context, req resource.Creat
 	allowedPrincipalArns := infisicaltf.StringListToGoStringSlice(ctx, resp.Diagnostics, plan.AllowedPrincipalArns)
 	allowedAccoundIds := infisicaltf.StringListToGoStringSlice(ctx, resp.Diagnostics, plan.AllowedAccountIDs)
 
-	newIdentityAwsAuth, err := r.client.CreateIdentityAwsAuth(infisical.CreateIdentityAwsAuthRequest{
+	newIdentityAwsAuth, err := r.client.CreateIdentityAwsAuth(ctx, infisical.CreateIdentityAwsAuthRequest{
 		IdentityID:              plan.IdentityID.ValueString(),
 		AccessTokenTTL:          infisicaltf.Int64PtrIfKnown(plan.AccessTokenTTL),
 		AccessTokenMaxTTL:       infisicaltf.Int64PtrIfKnown(plan.AccessTokenMaxTTL),
@@ -251,21 +252,19 @@ func (r *IdentityAwsAuthResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	// Get the latest data from the API
-	identityAwsAuth, err := r.client.GetIdentityAwsAuth(infisical.GetIdentityAwsAuthRequest{
+	identityAwsAuth, err := r.client.GetIdentityAwsAuth(ctx, infisical.GetIdentityAwsAuthRequest{
 		IdentityID: state.IdentityID.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
-			return
-		} else {
-			resp.Diagnostics.AddError(
-				"Error reading identity aws auth",
-				"Couldn't read identity aws auth from Infisical, unexpected error: "+err.Error(),
-			)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
+		resp.Diagnostics.AddError(
+			"Error reading identity aws auth",
+			"Couldn't read identity aws auth from Infisical, unexpected error: "+err.Error(),
+		)
+		return
 	}
 
 	updateAwsAuthTerraformStateFromApi(ctx, resp.Diagnostics, &state, &identityAwsAuth)
@@ -305,7 +304,7 @@ func (r *IdentityAwsAuthResource) Update(ctx context.Context, req resource.Updat
 	allowedPrincipalArns := infisicaltf.StringListToGoStringSlice(ctx, resp.Diagnostics, plan.AllowedPrincipalArns)
 	allowedAccoundIds := infisicaltf.StringListToGoStringSlice(ctx, resp.Diagnostics, plan.AllowedAccountIDs)
 
-	updatedIdentityAwsAuth, err := r.client.UpdateIdentityAwsAuth(infisical.UpdateIdentityAwsAuthRequest{
+	updatedIdentityAwsAuth, err := r.client.UpdateIdentityAwsAuth(ctx, infisical.UpdateIdentityAwsAuthRequest{
 		IdentityID:              plan.IdentityID.ValueString(),
 		AccessTokenTrustedIPS:   accessTokenTrustedIps,
 		AccessTokenTTL:          infisicaltf.Int64PtrIfKnown(plan.AccessTokenTTL),
@@ -351,7 +350,7 @@ func (r *IdentityAwsAuthResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
-	_, err := r.client.RevokeIdentityAwsAuth(infisical.RevokeIdentityAwsAuthRequest{
+	_, err := r.client.RevokeIdentityAwsAuth(ctx, infisical.RevokeIdentityAwsAuthRequest{
 		IdentityID: state.IdentityID.ValueString(),
 	})
 
@@ -376,11 +375,11 @@ func (r *IdentityAwsAuthResource) ImportState(ctx context.Context, req resource.
 		return
 	}
 
-	identity, err := r.client.GetIdentity(infisical.GetIdentityRequest{
+	identity, err := r.client.GetIdentity(ctx, infisical.GetIdentityRequest{
 		IdentityID: req.ID,
 	})
 	if err != nil {
-		if err == infisical.ErrNotFound {
+		if errors.Is(err, infisical.ErrNotFound) {
 			resp.Diagnostics.AddError(
 				"Identity not found",
 				"The identity with the given ID was not found",
@@ -410,11 +409,11 @@ func (r *IdentityAwsAuthResource) ImportState(ctx context.Context, req resource.
 		return
 	}
 
-	identityAwsAuth, err := r.client.GetIdentityAwsAuth(infisical.GetIdentityAwsAuthRequest{
+	identityAwsAuth, err := r.client.GetIdentityAwsAuth(ctx, infisical.GetIdentityAwsAuthRequest{
 		IdentityID: req.ID,
 	})
 	if err != nil {
-		if err == infisical.ErrNotFound {
+		if errors.Is(err, infisical.ErrNotFound) {
 			resp.Diagnostics.AddError(
 				"Identity aws auth not found",
 				"The identity with the given ID does not have aws auth configured",
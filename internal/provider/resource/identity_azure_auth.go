@@ -2,6 +2,7 @@ package resource
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
 	"strconv"
@@ -196,7 +197,7 @@ func (r *IdentityAzureAuthResource) Create(ctx context.Context, req resource.Cre
 
 	allowedServicePrincipalIds := infisicaltf.StringListToGoStringSlice(ctx, resp.Diagnostics, plan.AllowedServicePrincipalIDs)
 	accessTokenTrustedIps := tfPlanExpandIpFieldAsApiField(ctx, resp.Diagnostics, plan.AccessTokenTrustedIps)
-	newIdentityAzureAuth, err := r.client.CreateIdentityAzureAuth(infisical.CreateIdentityAzureAuthRequest{
+	newIdentityAzureAuth, err := r.client.CreateIdentityAzureAuth(ctx, infisical.CreateIdentityAzureAuthRequest{
 		IdentityID:                 plan.IdentityID.ValueString(),
 		AccessTokenTTL:             plan.AccessTokenTTL.ValueInt64(),
 		AccessTokenMaxTTL:          plan.AccessTokenMaxTTL.ValueInt64(),
@@ -244,21 +245,19 @@ func (r *IdentityAzureAuthResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	// Get the latest data from the API
-	identityAzureAuth, err := r.client.GetIdentityAzureAuth(infisical.GetIdentityAzureAuthRequest{
+	identityAzureAuth, err := r.client.GetIdentityAzureAuth(ctx, infisical.GetIdentityAzureAuthRequest{
 		IdentityID: state.IdentityID.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
-			return
-		} else {
-			resp.Diagnostics.AddError(
-				"Error reading identity azure auth",
-				"Couldn't read identity azure auth from Infisical, unexpected error: "+err.Error(),
-			)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
+		resp.Diagnostics.AddError(
+			"Error reading identity azure auth",
+			"Couldn't read identity azure auth from Infisical, unexpected error: "+err.Error(),
+		)
+		return
 	}
 
 	updateAzureAuthTerraformStateByApi(ctx, resp.Diagnostics, &state, &identityAzureAuth)
@@ -296,7 +295,7 @@ func (r *IdentityAzureAuthResource) Update(ctx context.Context, req resource.Upd
 	allowedServicePrincipalIds := infisicaltf.StringListToGoStringSlice(ctx, resp.Diagnostics, plan.AllowedServicePrincipalIDs)
 
 	accessTokenTrustedIps := tfPlanExpandIpFieldAsApiField(ctx, resp.Diagnostics, plan.AccessTokenTrustedIps)
-	updatedIdentityAzureAuth, err := r.client.UpdateIdentityAzureAuth(infisical.UpdateIdentityAzureAuthRequest{
+	updatedIdentityAzureAuth, err := r.client.UpdateIdentityAzureAuth(ctx, infisical.UpdateIdentityAzureAuthRequest{
 		IdentityID:                 plan.IdentityID.ValueString(),
 		AccessTokenTrustedIPS:      accessTokenTrustedIps,
 		AccessTokenTTL:             plan.AccessTokenTTL.ValueInt64(),
@@ -342,7 +341,7 @@ func (r *IdentityAzureAuthResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
-	_, err := r.client.RevokeIdentityAzureAuth(infisical.RevokeIdentityAzureAuthRequest{
+	_, err := r.client.RevokeIdentityAzureAuth(ctx, infisical.RevokeIdentityAzureAuthRequest{
 		IdentityID: state.IdentityID.ValueString(),
 	})
 
@@ -366,12 +365,12 @@ func (r *IdentityAzureAuthResource) ImportState(ctx context.Context, req resourc
 		return
 	}
 
-	identity, err := r.client.GetIdentity(infisical.GetIdentityRequest{
+	identity, err := r.client.GetIdentity(ctx, infisical.GetIdentityRequest{
 		IdentityID: req.ID,
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
+		if errors.Is(err, infisical.ErrNotFound) {
 			resp.Diagnostics.AddError(
 				"Identity not found",
 				"The identity with the given ID was not found",
@@ -403,7 +402,7 @@ func (r *IdentityAzureAuthResource) ImportState(ctx context.Context, req resourc
 		return
 	}
 
-	identityAzureAuth, err := r.client.GetIdentityAzureAuth(infisical.GetIdentityAzureAuthRequest{
+	identityAzureAuth, err := r.client.GetIdentityAzureAuth(ctx, infisical.GetIdentityAzureAuthRequest{
 		IdentityID: req.ID,
 	})
 
@@ -216,7 +216,7 @@ func (r *IdentityGcpAuthResource) Create(ctx context.Context, req resource.Creat
 	allowedZones := terraform.StringListToGoStringSlice(ctx, resp.Diagnostics, plan.AllowedZones)
 	allowedServiceAccounts := terraform.StringListToGoStringSlice(ctx, resp.Diagnostics, plan.AllowedServiceAccountEmails)
 
-	newIdentityGcpAuth, err := r.client.CreateIdentityGcpAuth(infisical.CreateIdentityGcpAuthRequest{
+	newIdentityGcpAuth, err := r.client.CreateIdentityGcpAuth(ctx, infisical.CreateIdentityGcpAuthRequest{
 		IdentityID:              plan.IdentityID.ValueString(),
 		AccessTokenTTL:          plan.AccessTokenTTL.ValueInt64(),
 		AccessTokenMaxTTL:       plan.AccessTokenMaxTTL.ValueInt64(),
@@ -265,21 +265,19 @@ func (r *IdentityGcpAuthResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	// Get the latest data from the API
-	identityGcpAuth, err := r.client.GetIdentityGcpAuth(infisical.GetIdentityGcpAuthRequest{
+	identityGcpAuth, err := r.client.GetIdentityGcpAuth(ctx, infisical.GetIdentityGcpAuthRequest{
 		IdentityID: state.IdentityID.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
-			return
-		} else {
-			resp.Diagnostics.AddError(
-				"Error reading identity gcp auth",
-				"Couldn't read identity gcp auth from Infisical, unexpected error: "+err.Error(),
-			)
+		if terraform.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
+		resp.Diagnostics.AddError(
+			"Error reading identity gcp auth",
+			"Couldn't read identity gcp auth from Infisical, unexpected error: "+err.Error(),
+		)
+		return
 	}
 
 	updateGcpAuthStateByApi(ctx, resp.Diagnostics, &state, &identityGcpAuth)
@@ -319,7 +317,7 @@ func (r *IdentityGcpAuthResource) Update(ctx context.Context, req resource.Updat
 	allowedProjects := terraform.StringListToGoStringSlice(ctx, resp.Diagnostics, plan.AllowedProjects)
 	allowedZones := terraform.StringListToGoStringSlice(ctx, resp.Diagnostics, plan.AllowedZones)
 	allowedServiceAccounts := terraform.StringListToGoStringSlice(ctx, resp.Diagnostics, plan.AllowedServiceAccountEmails)
-	updatedIdentityGcpAuth, err := r.client.UpdateIdentityGcpAuth(infisical.UpdateIdentityGcpAuthRequest{
+	updatedIdentityGcpAuth, err := r.client.UpdateIdentityGcpAuth(ctx, infisical.UpdateIdentityGcpAuthRequest{
 		IdentityID:              plan.IdentityID.ValueString(),
 		AccessTokenTTL:          plan.AccessTokenTTL.ValueInt64(),
 		AccessTokenMaxTTL:       plan.AccessTokenMaxTTL.ValueInt64(),
@@ -366,7 +364,7 @@ func (r *IdentityGcpAuthResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
-	_, err := r.client.RevokeIdentityGcpAuth(infisical.RevokeIdentityGcpAuthRequest{
+	_, err := r.client.RevokeIdentityGcpAuth(ctx, infisical.RevokeIdentityGcpAuthRequest{
 		IdentityID: state.IdentityID.ValueString(),
 	})
 
@@ -412,7 +412,7 @@ func (r *IdentityJwtAuthResource) Create(ctx context.Context, req resource.Creat
 		}
 	}
 
-	newIdentityJwtAuth, err := r.client.CreateIdentityJwtAuth(infisical.IdentityJwtAuthRequest{
+	newIdentityJwtAuth, err := r.client.CreateIdentityJwtAuth(ctx, infisical.IdentityJwtAuthRequest{
 		IdentityID:              plan.IdentityID.ValueString(),
 		ConfigurationType:       configurationType,
 		JwksUrl:                 plan.JwksUrl.ValueString(),
@@ -462,21 +462,19 @@ func (r *IdentityJwtAuthResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	identityJwtAuth, err := r.client.GetIdentityJwtAuth(infisical.GetIdentityJwtAuthRequest{
+	identityJwtAuth, err := r.client.GetIdentityJwtAuth(ctx, infisical.GetIdentityJwtAuthRequest{
 		IdentityID: state.IdentityID.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
-			return
-		} else {
-			resp.Diagnostics.AddError(
-				"Error reading identity JWT auth",
-				"Couldn't read identity JWT auth from Infisical, unexpected error: "+err.Error(),
-			)
+		if terraform.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
+		resp.Diagnostics.AddError(
+			"Error reading identity JWT auth",
+			"Couldn't read identity JWT auth from Infisical, unexpected error: "+err.Error(),
+		)
+		return
 	}
 
 	state.ID = types.StringValue(identityJwtAuth.ID)
@@ -531,7 +529,7 @@ func (r *IdentityJwtAuthResource) Update(ctx context.Context, req resource.Updat
 		}
 	}
 
-	updatedIdentityJwtAuth, err := r.client.UpdateIdentityJwtAuth(infisical.IdentityJwtAuthRequest{
+	updatedIdentityJwtAuth, err := r.client.UpdateIdentityJwtAuth(ctx, infisical.IdentityJwtAuthRequest{
 		IdentityID:              plan.IdentityID.ValueString(),
 		ConfigurationType:       configurationType,
 		JwksUrl:                 plan.JwksUrl.ValueString(),
@@ -580,7 +578,7 @@ func (r *IdentityJwtAuthResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
-	_, err := r.client.RevokeIdentityJwtAuth(infisical.RevokeIdentityJwtAuthRequest{
+	_, err := r.client.RevokeIdentityJwtAuth(ctx, infisical.RevokeIdentityJwtAuthRequest{
 		IdentityID: state.IdentityID.ValueString(),
 	})
 
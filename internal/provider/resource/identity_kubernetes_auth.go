@@ -332,7 +332,7 @@ func (r *IdentityKubernetesAuthResource) Create(ctx context.Context, req resourc
 	accessTokenTrustedIps := tfPlanExpandIpFieldAsApiField(ctx, resp.Diagnostics, plan.AccessTokenTrustedIps)
 	allowedNamespacpes := infisicaltf.StringListToGoStringSlice(ctx, resp.Diagnostics, plan.AllowedNamespaces)
 	allowedNames := infisicaltf.StringListToGoStringSlice(ctx, resp.Diagnostics, plan.AllowedServiceAccountNames)
-	newIdentityKubernetesAuth, err := r.client.CreateIdentityKubernetesAuth(infisical.CreateIdentityKubernetesAuthRequest{
+	newIdentityKubernetesAuth, err := r.client.CreateIdentityKubernetesAuth(ctx, infisical.CreateIdentityKubernetesAuthRequest{
 		IdentityID:              plan.IdentityID.ValueString(),
 		TokenReviewerMode:       plan.TokenReviewerMode.ValueString(),
 		GatewayID:               gatewayID,
@@ -385,21 +385,19 @@ func (r *IdentityKubernetesAuthResource) Read(ctx context.Context, req resource.
 	}
 
 	// Get the latest data from the API
-	identityKubernetesAuth, err := r.client.GetIdentityKubernetesAuth(infisical.GetIdentityKubernetesAuthRequest{
+	identityKubernetesAuth, err := r.client.GetIdentityKubernetesAuth(ctx, infisical.GetIdentityKubernetesAuthRequest{
 		IdentityID: state.IdentityID.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
-			return
-		} else {
-			resp.Diagnostics.AddError(
-				"Error reading identity kubernetes auth",
-				"Couldn't read identity kubernetes auth from Infisical, unexpected error: "+err.Error(),
-			)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
+		resp.Diagnostics.AddError(
+			"Error reading identity kubernetes auth",
+			"Couldn't read identity kubernetes auth from Infisical, unexpected error: "+err.Error(),
+		)
+		return
 	}
 
 	updateKubernetesAuthStateByApi(ctx, resp.Diagnostics, &state, &identityKubernetesAuth)
@@ -462,7 +460,7 @@ func (r *IdentityKubernetesAuthResource) Update(ctx context.Context, req resourc
 
 	allowedNamespacpes := infisicaltf.StringListToGoStringSlice(ctx, resp.Diagnostics, plan.AllowedNamespaces)
 	allowedNames := infisicaltf.StringListToGoStringSlice(ctx, resp.Diagnostics, plan.AllowedServiceAccountNames)
-	updatedIdentityKubernetesAuth, err := r.client.UpdateIdentityKubernetesAuth(infisical.UpdateIdentityKubernetesAuthRequest{
+	updatedIdentityKubernetesAuth, err := r.client.UpdateIdentityKubernetesAuth(ctx, infisical.UpdateIdentityKubernetesAuthRequest{
 		IdentityID:              plan.IdentityID.ValueString(),
 		AccessTokenTrustedIPS:   accessTokenTrustedIps,
 		TokenReviewerMode:       plan.TokenReviewerMode.ValueString(),
@@ -513,7 +511,7 @@ func (r *IdentityKubernetesAuthResource) Delete(ctx context.Context, req resourc
 		return
 	}
 
-	_, err := r.client.RevokeIdentityKubernetesAuth(infisical.RevokeIdentityKubernetesAuthRequest{
+	_, err := r.client.RevokeIdentityKubernetesAuth(ctx, infisical.RevokeIdentityKubernetesAuthRequest{
 		IdentityID: state.IdentityID.ValueString(),
 	})
 
@@ -307,7 +307,7 @@ func (r *IdentityOidcAuthResource) Create(ctx context.Context, req resource.Crea
 		}
 	}
 
-	newIdentityOidcAuth, err := r.client.CreateIdentityOidcAuth(infisical.CreateIdentityOidcAuthRequest{
+	newIdentityOidcAuth, err := r.client.CreateIdentityOidcAuth(ctx, infisical.CreateIdentityOidcAuthRequest{
 		IdentityID:              plan.IdentityID.ValueString(),
 		AccessTokenTTL:          plan.AccessTokenTTL.ValueInt64(),
 		AccessTokenMaxTTL:       plan.AccessTokenMaxTTL.ValueInt64(),
@@ -359,21 +359,19 @@ func (r *IdentityOidcAuthResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	// Get the latest data from the API
-	identityOidcAuth, err := r.client.GetIdentityOidcAuth(infisical.GetIdentityOidcAuthRequest{
+	identityOidcAuth, err := r.client.GetIdentityOidcAuth(ctx, infisical.GetIdentityOidcAuthRequest{
 		IdentityID: state.IdentityID.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
-			return
-		} else {
-			resp.Diagnostics.AddError(
-				"Error reading identity oidc auth",
-				"Couldn't read identity oidc auth from Infisical, unexpected error: "+err.Error(),
-			)
+		if terraform.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
+		resp.Diagnostics.AddError(
+			"Error reading identity oidc auth",
+			"Couldn't read identity oidc auth from Infisical, unexpected error: "+err.Error(),
+		)
+		return
 	}
 
 	updateOidcAuthStateByApi(ctx, resp.Diagnostics, &state, &identityOidcAuth)
@@ -432,7 +430,7 @@ func (r *IdentityOidcAuthResource) Update(ctx context.Context, req resource.Upda
 		}
 	}
 
-	updatedIdentityOidcAuth, err := r.client.UpdateIdentityOidcAuth(infisical.UpdateIdentityOidcAuthRequest{
+	updatedIdentityOidcAuth, err := r.client.UpdateIdentityOidcAuth(ctx, infisical.UpdateIdentityOidcAuthRequest{
 		IdentityID:              plan.IdentityID.ValueString(),
 		AccessTokenTTL:          plan.AccessTokenTTL.ValueInt64(),
 		AccessTokenMaxTTL:       plan.AccessTokenMaxTTL.ValueInt64(),
@@ -482,7 +480,7 @@ func (r *IdentityOidcAuthResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
-	_, err := r.client.RevokeIdentityOidcAuth(infisical.RevokeIdentityOidcAuthRequest{
+	_, err := r.client.RevokeIdentityOidcAuth(ctx, infisical.RevokeIdentityOidcAuthRequest{
 		IdentityID: state.IdentityID.ValueString(),
 	})
 
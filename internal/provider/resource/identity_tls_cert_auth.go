@@ -2,6 +2,7 @@ package resource
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
 	"strconv"
@@ -241,7 +242,7 @@ func (r *IdentityTlsCertAuthResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
-	newIdentityTlsCertAuth, err := r.client.CreateIdentityTlsCertAuth(infisical.CreateIdentityTlsCertAuthRequest{
+	newIdentityTlsCertAuth, err := r.client.CreateIdentityTlsCertAuth(ctx, infisical.CreateIdentityTlsCertAuthRequest{
 		IdentityID:                   plan.IdentityID.ValueString(),
 		CaCertificate:                plan.CaCertificate.ValueString(),
 		AllowedCommonNames:           allowedCommonNames,
@@ -290,21 +291,19 @@ func (r *IdentityTlsCertAuthResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
-	identityTlsCertAuth, err := r.client.GetIdentityTlsCertAuth(infisical.GetIdentityTlsCertAuthRequest{
+	identityTlsCertAuth, err := r.client.GetIdentityTlsCertAuth(ctx, infisical.GetIdentityTlsCertAuthRequest{
 		IdentityID: state.IdentityID.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
-			return
-		} else {
-			resp.Diagnostics.AddError(
-				"Error reading identity tls certificate auth",
-				"Couldn't read identity tls certificate auth from Infisical, unexpected error: "+err.Error(),
-			)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
+		resp.Diagnostics.AddError(
+			"Error reading identity tls certificate auth",
+			"Couldn't read identity tls certificate auth from Infisical, unexpected error: "+err.Error(),
+		)
+		return
 	}
 
 	updateTlsCertAuthTerraformStateFromApi(ctx, resp.Diagnostics, &state, &identityTlsCertAuth)
@@ -350,7 +349,7 @@ func (r *IdentityTlsCertAuthResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
-	updatedIdentityTlsCertAuth, err := r.client.UpdateIdentityTlsCertAuth(infisical.UpdateIdentityTlsCertAuthRequest{
+	updatedIdentityTlsCertAuth, err := r.client.UpdateIdentityTlsCertAuth(ctx, infisical.UpdateIdentityTlsCertAuthRequest{
 		IdentityID:                   plan.IdentityID.ValueString(),
 		CaCertificate:                plan.CaCertificate.ValueString(),
 		AllowedCommonNames:           allowedCommonNames,
@@ -398,7 +397,7 @@ func (r *IdentityTlsCertAuthResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
-	_, err := r.client.RevokeIdentityTlsCertAuth(infisical.RevokeIdentityTlsCertAuthRequest{
+	_, err := r.client.RevokeIdentityTlsCertAuth(ctx, infisical.RevokeIdentityTlsCertAuthRequest{
 		IdentityID: state.IdentityID.ValueString(),
 	})
 
@@ -420,11 +419,11 @@ func (r *IdentityTlsCertAuthResource) ImportState(ctx context.Context, req resou
 		return
 	}
 
-	identity, err := r.client.GetIdentity(infisical.GetIdentityRequest{
+	identity, err := r.client.GetIdentity(ctx, infisical.GetIdentityRequest{
 		IdentityID: req.ID,
 	})
 	if err != nil {
-		if err == infisical.ErrNotFound {
+		if errors.Is(err, infisical.ErrNotFound) {
 			resp.Diagnostics.AddError(
 				"Identity not found",
 				"The identity with the given ID was not found",
@@ -454,11 +453,11 @@ func (r *IdentityTlsCertAuthResource) ImportState(ctx context.Context, req resou
 		return
 	}
 
-	identityTlsCertAuth, err := r.client.GetIdentityTlsCertAuth(infisical.GetIdentityTlsCertAuthRequest{
+	identityTlsCertAuth, err := r.client.GetIdentityTlsCertAuth(ctx, infisical.GetIdentityTlsCertAuthRequest{
 		IdentityID: req.ID,
 	})
 	if err != nil {
-		if err == infisical.ErrNotFound {
+		if errors.Is(err, infisical.ErrNotFound) {
 			resp.Diagnostics.AddError(
 				"Identity tls certificate auth not found",
 				"The identity with the given ID does not have tls certificate auth configured",
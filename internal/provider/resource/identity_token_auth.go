@@ -2,10 +2,12 @@ package resource
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
 	"strconv"
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -170,7 +172,7 @@ func (r *IdentityTokenAuthResource) Create(ctx context.Context, req resource.Cre
 
 	accessTokenTrustedIps := tfPlanExpandIpFieldAsApiField(ctx, resp.Diagnostics, plan.AccessTokenTrustedIps)
 
-	newIdentityTokenAuth, err := r.client.CreateIdentityTokenAuth(infisical.CreateIdentityTokenAuthRequest{
+	newIdentityTokenAuth, err := r.client.CreateIdentityTokenAuth(ctx, infisical.CreateIdentityTokenAuthRequest{
 		IdentityID:              plan.IdentityID.ValueString(),
 		AccessTokenTTL:          plan.AccessTokenTTL.ValueInt64(),
 		AccessTokenMaxTTL:       plan.AccessTokenMaxTTL.ValueInt64(),
@@ -215,21 +217,19 @@ func (r *IdentityTokenAuthResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	// Get the latest data from the API
-	identityTokenAuth, err := r.client.GetIdentityTokenAuth(infisical.GetIdentityTokenAuthRequest{
+	identityTokenAuth, err := r.client.GetIdentityTokenAuth(ctx, infisical.GetIdentityTokenAuthRequest{
 		IdentityID: state.IdentityID.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
-			return
-		} else {
-			resp.Diagnostics.AddError(
-				"Error reading identity token auth",
-				"Couldn't read identity token auth from Infisical, unexpected error: "+err.Error(),
-			)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
+		resp.Diagnostics.AddError(
+			"Error reading identity token auth",
+			"Couldn't read identity token auth from Infisical, unexpected error: "+err.Error(),
+		)
+		return
 	}
 
 	updateTokenAuthStateByApi(ctx, resp.Diagnostics, &state, &identityTokenAuth)
@@ -267,7 +267,7 @@ func (r *IdentityTokenAuthResource) Update(ctx context.Context, req resource.Upd
 
 	accessTokenTrustedIps := tfPlanExpandIpFieldAsApiField(ctx, resp.Diagnostics, plan.AccessTokenTrustedIps)
 
-	updatedIdentityTokenAuth, err := r.client.UpdateIdentityTokenAuth(infisical.UpdateIdentityTokenAuthRequest{
+	updatedIdentityTokenAuth, err := r.client.UpdateIdentityTokenAuth(ctx, infisical.UpdateIdentityTokenAuthRequest{
 		IdentityID:              plan.IdentityID.ValueString(),
 		AccessTokenTTL:          plan.AccessTokenTTL.ValueInt64(),
 		AccessTokenMaxTTL:       plan.AccessTokenMaxTTL.ValueInt64(),
@@ -310,7 +310,7 @@ func (r *IdentityTokenAuthResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
-	_, err := r.client.RevokeIdentityTokenAuth(infisical.RevokeIdentityTokenAuthRequest{
+	_, err := r.client.RevokeIdentityTokenAuth(ctx, infisical.RevokeIdentityTokenAuthRequest{
 		IdentityID: state.IdentityID.ValueString(),
 	})
 
@@ -334,12 +334,12 @@ func (r *IdentityTokenAuthResource) ImportState(ctx context.Context, req resourc
 		return
 	}
 
-	identity, err := r.client.GetIdentity(infisical.GetIdentityRequest{
+	identity, err := r.client.GetIdentity(ctx, infisical.GetIdentityRequest{
 		IdentityID: req.ID,
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
+		if errors.Is(err, infisical.ErrNotFound) {
 			resp.Diagnostics.AddError(
 				"Identity not found",
 				"The identity with the given ID was not found",
@@ -371,7 +371,7 @@ func (r *IdentityTokenAuthResource) ImportState(ctx context.Context, req resourc
 		return
 	}
 
-	identityTokenAuth, err := r.client.GetIdentityTokenAuth(infisical.GetIdentityTokenAuthRequest{
+	identityTokenAuth, err := r.client.GetIdentityTokenAuth(ctx, infisical.GetIdentityTokenAuthRequest{
 		IdentityID: req.ID,
 	})
 
@@ -2,8 +2,10 @@ package resource
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -129,7 +131,7 @@ func (r *IdentityTokenAuthTokenResource) Create(ctx context.Context, req resourc
 		return
 	}
 
-	createResponse, err := r.client.CreateIdentityTokenAuthToken(infisical.CreateIdentityTokenAuthTokenRequest{
+	createResponse, err := r.client.CreateIdentityTokenAuthToken(ctx, infisical.CreateIdentityTokenAuthTokenRequest{
 		IdentityID: plan.IdentityID.ValueString(),
 		Name:       plan.Name.ValueString(),
 	})
@@ -177,21 +179,19 @@ func (r *IdentityTokenAuthTokenResource) Read(ctx context.Context, req resource.
 	}
 
 	// Get the latest data from the API
-	tokenData, err := r.client.GetIdentityTokenAuthToken(infisical.GetIdentityTokenAuthTokenRequest{
+	tokenData, err := r.client.GetIdentityTokenAuthToken(ctx, infisical.GetIdentityTokenAuthTokenRequest{
 		TokenID: state.ID.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
-			return
-		} else {
-			resp.Diagnostics.AddError(
-				"Error reading identity token auth token",
-				"Couldn't read identity token auth token from Infisical, unexpected error: "+err.Error(),
-			)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
+		resp.Diagnostics.AddError(
+			"Error reading identity token auth token",
+			"Couldn't read identity token auth token from Infisical, unexpected error: "+err.Error(),
+		)
+		return
 	}
 
 	if tokenData.IsAccessTokenRevoked {
@@ -242,7 +242,7 @@ func (r *IdentityTokenAuthTokenResource) Update(ctx context.Context, req resourc
 		return
 	}
 
-	updatedToken, err := r.client.UpdateIdentityTokenAuthToken(infisical.UpdateIdentityTokenAuthTokenRequest{
+	updatedToken, err := r.client.UpdateIdentityTokenAuthToken(ctx, infisical.UpdateIdentityTokenAuthTokenRequest{
 		TokenID: state.ID.ValueString(),
 		Name:    plan.Name.ValueString(),
 	})
@@ -289,7 +289,7 @@ func (r *IdentityTokenAuthTokenResource) Delete(ctx context.Context, req resourc
 		return
 	}
 
-	_, err := r.client.RevokeIdentityTokenAuthToken(infisical.RevokeIdentityTokenAuthTokenRequest{
+	_, err := r.client.RevokeIdentityTokenAuthToken(ctx, infisical.RevokeIdentityTokenAuthTokenRequest{
 		IdentityID: state.IdentityID.ValueString(),
 		TokenID:    state.ID.ValueString(),
 	})
@@ -313,12 +313,12 @@ func (r *IdentityTokenAuthTokenResource) ImportState(ctx context.Context, req re
 		return
 	}
 
-	tokenData, err := r.client.GetIdentityTokenAuthToken(infisical.GetIdentityTokenAuthTokenRequest{
+	tokenData, err := r.client.GetIdentityTokenAuthToken(ctx, infisical.GetIdentityTokenAuthTokenRequest{
 		TokenID: req.ID,
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
+		if errors.Is(err, infisical.ErrNotFound) {
 			resp.Diagnostics.AddError(
 				"Error importing identity token auth token",
 				fmt.Sprintf("No token found with token_id: %s", req.ID),
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -217,7 +218,7 @@ func (r *IdentityUniversalAuthResource) Create(ctx context.Context, req resource
 
 	accessTokenTrustedIps := tfPlanExpandIpFieldAsApiField(ctx, resp.Diagnostics, plan.AccessTokenTrustedIps)
 	clientSecretTrustedIps := tfPlanExpandIpFieldAsApiField(ctx, resp.Diagnostics, plan.ClientSecretTrustedIps)
-	newIdentityUniversalAuth, err := r.client.CreateIdentityUniversalAuth(infisical.CreateIdentityUniversalAuthRequest{
+	newIdentityUniversalAuth, err := r.client.CreateIdentityUniversalAuth(ctx, infisical.CreateIdentityUniversalAuthRequest{
 		IdentityID:              plan.IdentityID.ValueString(),
 		ClientSecretTrustedIPs:  clientSecretTrustedIps,
 		AccessTokenTrustedIPs:   accessTokenTrustedIps,
@@ -268,21 +269,19 @@ func (r *IdentityUniversalAuthResource) Read(ctx context.Context, req resource.R
 	}
 
 	// Get the latest data from the API
-	identityUniversalAuth, err := r.client.GetIdentityUniversalAuth(infisical.GetIdentityUniversalAuthRequest{
+	identityUniversalAuth, err := r.client.GetIdentityUniversalAuth(ctx, infisical.GetIdentityUniversalAuthRequest{
 		IdentityID: state.IdentityID.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
-			return
-		} else {
-			resp.Diagnostics.AddError(
-				"Error reading identity universal auth",
-				"Couldn't read identity universal auth from Infisical, unexpected error: "+err.Error(),
-			)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
+		resp.Diagnostics.AddError(
+			"Error reading identity universal auth",
+			"Couldn't read identity universal auth from Infisical, unexpected error: "+err.Error(),
+		)
+		return
 	}
 
 	updateUniversalAuthStateByApi(ctx, resp.Diagnostics, &state, &identityUniversalAuth)
@@ -320,7 +319,7 @@ func (r *IdentityUniversalAuthResource) Update(ctx context.Context, req resource
 
 	accessTokenTrustedIps := tfPlanExpandIpFieldAsApiField(ctx, resp.Diagnostics, plan.AccessTokenTrustedIps)
 	clientSecretTrustedIps := tfPlanExpandIpFieldAsApiField(ctx, resp.Diagnostics, plan.ClientSecretTrustedIps)
-	updatedIdentityUniversalAuth, err := r.client.UpdateIdentityUniversalAuth(infisical.UpdateIdentityUniversalAuthRequest{
+	updatedIdentityUniversalAuth, err := r.client.UpdateIdentityUniversalAuth(ctx, infisical.UpdateIdentityUniversalAuthRequest{
 		IdentityID:              plan.IdentityID.ValueString(),
 		ClientSecretTrustedIPs:  clientSecretTrustedIps,
 		AccessTokenTrustedIPs:   accessTokenTrustedIps,
@@ -364,7 +363,7 @@ func (r *IdentityUniversalAuthResource) Delete(ctx context.Context, req resource
 		return
 	}
 
-	_, err := r.client.RevokeIdentityUniversalAuth(infisical.RevokeIdentityUniversalAuthRequest{
+	_, err := r.client.RevokeIdentityUniversalAuth(ctx, infisical.RevokeIdentityUniversalAuthRequest{
 		IdentityID: state.IdentityID.ValueString(),
 	})
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -136,7 +137,7 @@ func (r *IdentityUniversalAuthClientSecretResource) Create(ctx context.Context,
 		return
 	}
 
-	universalAuth, err := r.client.GetIdentityUniversalAuth(infisical.GetIdentityUniversalAuthRequest{
+	universalAuth, err := r.client.GetIdentityUniversalAuth(ctx, infisical.GetIdentityUniversalAuthRequest{
 		IdentityID: plan.IdentityID.ValueString(),
 	})
 	if err != nil {
@@ -147,7 +148,7 @@ func (r *IdentityUniversalAuthClientSecretResource) Create(ctx context.Context,
 		return
 	}
 
-	newIdentityUniversalAuthClientSecret, err := r.client.CreateIdentityUniversalAuthClientSecret(infisical.CreateIdentityUniversalAuthClientSecretRequest{
+	newIdentityUniversalAuthClientSecret, err := r.client.CreateIdentityUniversalAuthClientSecret(ctx, infisical.CreateIdentityUniversalAuthClientSecretRequest{
 		IdentityID:   plan.IdentityID.ValueString(),
 		Description:  plan.Description.ValueString(),
 		NumUsesLimit: plan.NumberOfUsesLimit.ValueInt64(),
@@ -197,22 +198,20 @@ func (r *IdentityUniversalAuthClientSecretResource) Read(ctx context.Context, re
 	}
 
 	// Get the latest data from the API
-	identityUniversalAuthClientSecretData, err := r.client.GetIdentityUniversalAuthClientSecret(infisical.GetIdentityUniversalAuthClientSecretRequest{
+	identityUniversalAuthClientSecretData, err := r.client.GetIdentityUniversalAuthClientSecret(ctx, infisical.GetIdentityUniversalAuthClientSecretRequest{
 		IdentityID:     state.IdentityID.ValueString(),
 		ClientSecretID: state.ID.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
-			return
-		} else {
-			resp.Diagnostics.AddError(
-				"Error reading identity universal auth client secret",
-				"Couldn't read identity universal auth client secret from Infisical, unexpected error: "+err.Error(),
-			)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
+		resp.Diagnostics.AddError(
+			"Error reading identity universal auth client secret",
+			"Couldn't read identity universal auth client secret from Infisical, unexpected error: "+err.Error(),
+		)
+		return
 	}
 
 	state.IsRevoked = types.BoolValue(identityUniversalAuthClientSecretData.IsClientSecretRevoked)
@@ -255,7 +254,7 @@ func (r *IdentityUniversalAuthClientSecretResource) Delete(ctx context.Context,
 		return
 	}
 
-	_, err := r.client.RevokeIdentityUniversalAuthClientSecret(infisical.RevokeIdentityUniversalAuthClientSecretRequest{
+	_, err := r.client.RevokeIdentityUniversalAuthClientSecret(ctx, infisical.RevokeIdentityUniversalAuthClientSecretRequest{
 		IdentityID:     state.IdentityID.ValueString(),
 		ClientSecretID: state.ID.ValueString(),
 	})
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	infisical "terraform-provider-infisical/internal/client"
 	pkg "terraform-provider-infisical/internal/pkg/input"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -231,7 +232,7 @@ func (r *IntegrationAWSParameterStoreResource) Create(ctx context.Context, req r
 	}
 
 	// Create integration auth first
-	auth, err := r.client.CreateIntegrationAuth(createIntegrationAuthRequest)
+	auth, err := r.client.CreateIntegrationAuth(ctx, createIntegrationAuthRequest)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -260,7 +261,7 @@ func (r *IntegrationAWSParameterStoreResource) Create(ctx context.Context, req r
 	}
 
 	// Create the integration
-	integration, err := r.client.CreateIntegration(infisical.CreateIntegrationRequest{
+	integration, err := r.client.CreateIntegration(ctx, infisical.CreateIntegrationRequest{
 		IntegrationAuthID: auth.IntegrationAuth.ID,
 		Region:            plan.AWSRegion.ValueString(),
 		Metadata:          metadataMap,
@@ -307,19 +308,18 @@ func (r *IntegrationAWSParameterStoreResource) Read(ctx context.Context, req res
 		return
 	}
 
-	integration, err := r.client.GetIntegration(infisical.GetIntegrationRequest{
+	integration, err := r.client.GetIntegration(ctx, infisical.GetIntegrationRequest{
 		ID: state.IntegrationID.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
-		} else {
-			resp.Diagnostics.AddError(
-				"Unable to get integration",
-				err.Error(),
-			)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
+			return
 		}
+		resp.Diagnostics.AddError(
+			"Unable to get integration",
+			err.Error(),
+		)
 		return
 	}
 
@@ -417,7 +417,7 @@ func (r *IntegrationAWSParameterStoreResource) Update(ctx context.Context, req r
 		updateIntegrationAuthRequest.AWSAssumeIamRoleArn = plan.AssumeRoleArn.ValueString()
 	}
 
-	_, err = r.client.UpdateIntegrationAuth(updateIntegrationAuthRequest)
+	_, err = r.client.UpdateIntegrationAuth(ctx, updateIntegrationAuthRequest)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating integration auth",
@@ -437,7 +437,7 @@ func (r *IntegrationAWSParameterStoreResource) Update(ctx context.Context, req r
 	}
 
 	// Update the integration
-	updatedIntegration, err := r.client.UpdateIntegration(infisical.UpdateIntegrationRequest{
+	updatedIntegration, err := r.client.UpdateIntegration(ctx, infisical.UpdateIntegrationRequest{
 		ID:          state.IntegrationID.ValueString(),
 		Metadata:    metadataMap,
 		Environment: plan.Environment.ValueString(),
@@ -483,7 +483,7 @@ func (r *IntegrationAWSParameterStoreResource) Delete(ctx context.Context, req r
 		return
 	}
 
-	_, err := r.client.DeleteIntegrationAuth(infisical.DeleteIntegrationAuthRequest{
+	_, err := r.client.DeleteIntegrationAuth(ctx, infisical.DeleteIntegrationAuthRequest{
 		ID: state.IntegrationAuthID.ValueString(),
 	})
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	infisical "terraform-provider-infisical/internal/client"
 	pkg "terraform-provider-infisical/internal/pkg/input"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -265,7 +266,7 @@ func (r *IntegrationAWSSecretsManagerResource) Create(ctx context.Context, req r
 	}
 
 	// Create integration auth first
-	auth, err := r.client.CreateIntegrationAuth(createIntegrationAuthRequest)
+	auth, err := r.client.CreateIntegrationAuth(ctx, createIntegrationAuthRequest)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -327,7 +328,7 @@ func (r *IntegrationAWSSecretsManagerResource) Create(ctx context.Context, req r
 	}
 
 	// Create the integration
-	integration, err := r.client.CreateIntegration(request)
+	integration, err := r.client.CreateIntegration(ctx, request)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -367,19 +368,18 @@ func (r *IntegrationAWSSecretsManagerResource) Read(ctx context.Context, req res
 		return
 	}
 
-	integration, err := r.client.GetIntegration(infisical.GetIntegrationRequest{
+	integration, err := r.client.GetIntegration(ctx, infisical.GetIntegrationRequest{
 		ID: state.IntegrationID.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
-		} else {
-			resp.Diagnostics.AddError(
-				"Unable to read integration",
-				err.Error(),
-			)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
+			return
 		}
+		resp.Diagnostics.AddError(
+			"Unable to read integration",
+			err.Error(),
+		)
 		return
 	}
 
@@ -506,7 +506,7 @@ func (r *IntegrationAWSSecretsManagerResource) Update(ctx context.Context, req r
 		updateIntegrationAuthRequest.AWSAssumeIamRoleArn = plan.AssumeRoleArn.ValueString()
 	}
 
-	_, err = r.client.UpdateIntegrationAuth(updateIntegrationAuthRequest)
+	_, err = r.client.UpdateIntegrationAuth(ctx, updateIntegrationAuthRequest)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating integration auth",
@@ -548,7 +548,7 @@ func (r *IntegrationAWSSecretsManagerResource) Update(ctx context.Context, req r
 	}
 
 	// Update the integration
-	updatedIntegration, err := r.client.UpdateIntegration(updateIntegrationRequest)
+	updatedIntegration, err := r.client.UpdateIntegration(ctx, updateIntegrationRequest)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -586,7 +586,7 @@ func (r *IntegrationAWSSecretsManagerResource) Delete(ctx context.Context, req r
 		return
 	}
 
-	_, err := r.client.DeleteIntegrationAuth(infisical.DeleteIntegrationAuthRequest{
+	_, err := r.client.DeleteIntegrationAuth(ctx, infisical.DeleteIntegrationAuthRequest{
 		ID: state.IntegrationAuthID.ValueString(),
 	})
 
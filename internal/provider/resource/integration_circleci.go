@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -139,7 +140,7 @@ func (r *IntegrationCircleCIResource) Create(ctx context.Context, req resource.C
 	}
 
 	// Create integration auth first
-	auth, err := r.client.CreateIntegrationAuth(infisical.CreateIntegrationAuthRequest{
+	auth, err := r.client.CreateIntegrationAuth(ctx, infisical.CreateIntegrationAuthRequest{
 		AccessToken: plan.CircleCIToken.ValueString(),
 		ProjectID:   plan.ProjectID.ValueString(),
 		Integration: infisical.IntegrationAuthTypeCircleCi,
@@ -154,7 +155,7 @@ func (r *IntegrationCircleCIResource) Create(ctx context.Context, req resource.C
 	}
 
 	// Create the integration
-	integration, err := r.client.CreateIntegration(infisical.CreateIntegrationRequest{
+	integration, err := r.client.CreateIntegration(ctx, infisical.CreateIntegrationRequest{
 		IntegrationAuthID: auth.IntegrationAuth.ID,
 		App:               plan.CircleCIProjectID.ValueString(), // Needs to be the project slug
 		AppID:             plan.CircleCIProjectID.ValueString(), // Needs to be the project ID
@@ -201,19 +202,18 @@ func (r *IntegrationCircleCIResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
-	integration, err := r.client.GetIntegration(infisical.GetIntegrationRequest{
+	integration, err := r.client.GetIntegration(ctx, infisical.GetIntegrationRequest{
 		ID: state.IntegrationID.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
-		} else {
-			resp.Diagnostics.AddError(
-				"Unable to get integration",
-				err.Error(),
-			)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
+			return
 		}
+		resp.Diagnostics.AddError(
+			"Unable to get integration",
+			err.Error(),
+		)
 		return
 	}
 
@@ -254,7 +254,7 @@ func (r *IntegrationCircleCIResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
-	_, err := r.client.UpdateIntegrationAuth(infisical.UpdateIntegrationAuthRequest{
+	_, err := r.client.UpdateIntegrationAuth(ctx, infisical.UpdateIntegrationAuthRequest{
 		Integration:       infisical.IntegrationAuthTypeCircleCi,
 		IntegrationAuthId: plan.IntegrationAuthID.ValueString(),
 		AccessToken:       plan.CircleCIToken.ValueString(),
@@ -268,7 +268,7 @@ func (r *IntegrationCircleCIResource) Update(ctx context.Context, req resource.U
 	}
 
 	// Update the integration
-	_, err = r.client.UpdateIntegration(infisical.UpdateIntegrationRequest{
+	_, err = r.client.UpdateIntegration(ctx, infisical.UpdateIntegrationRequest{
 		ID:          state.IntegrationID.ValueString(),
 		Environment: plan.Environment.ValueString(),
 		SecretPath:  plan.SecretPath.ValueString(),
@@ -310,7 +310,7 @@ func (r *IntegrationCircleCIResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
-	_, err := r.client.DeleteIntegrationAuth(infisical.DeleteIntegrationAuthRequest{
+	_, err := r.client.DeleteIntegrationAuth(ctx, infisical.DeleteIntegrationAuthRequest{
 		ID: state.IntegrationAuthID.ValueString(),
 	})
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -138,7 +139,7 @@ func (r *IntegrationDatabricksResource) Create(ctx context.Context, req resource
 	}
 
 	// Create integration auth first
-	auth, err := r.client.CreateIntegrationAuth(infisical.CreateIntegrationAuthRequest{
+	auth, err := r.client.CreateIntegrationAuth(ctx, infisical.CreateIntegrationAuthRequest{
 		AccessToken: plan.DatabricksAccessToken.ValueString(),
 		URL:         plan.DatabricksHostURL.ValueString(),
 		ProjectID:   plan.ProjectID.ValueString(),
@@ -154,7 +155,7 @@ func (r *IntegrationDatabricksResource) Create(ctx context.Context, req resource
 	}
 
 	// Create the integration
-	integration, err := r.client.CreateIntegration(infisical.CreateIntegrationRequest{
+	integration, err := r.client.CreateIntegration(ctx, infisical.CreateIntegrationRequest{
 		IntegrationAuthID: auth.IntegrationAuth.ID,
 		App:               plan.DatabricksSecretScope.ValueString(),
 		SourceEnvironment: plan.Environment.ValueString(),
@@ -199,19 +200,18 @@ func (r *IntegrationDatabricksResource) Read(ctx context.Context, req resource.R
 		return
 	}
 
-	integration, err := r.client.GetIntegration(infisical.GetIntegrationRequest{
+	integration, err := r.client.GetIntegration(ctx, infisical.GetIntegrationRequest{
 		ID: state.IntegrationID.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
-		} else {
-			resp.Diagnostics.AddError(
-				"Unable to get integration",
-				err.Error(),
-			)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
+			return
 		}
+		resp.Diagnostics.AddError(
+			"Unable to get integration",
+			err.Error(),
+		)
 		return
 	}
 	state.SecretPath = types.StringValue(integration.Integration.SecretPath)
@@ -251,7 +251,7 @@ func (r *IntegrationDatabricksResource) Update(ctx context.Context, req resource
 		return
 	}
 
-	_, err := r.client.UpdateIntegrationAuth(infisical.UpdateIntegrationAuthRequest{
+	_, err := r.client.UpdateIntegrationAuth(ctx, infisical.UpdateIntegrationAuthRequest{
 		Integration:       infisical.IntegrationAuthTypeDatabricks,
 		IntegrationAuthId: plan.IntegrationAuthID.ValueString(),
 		AccessToken:       plan.DatabricksAccessToken.ValueString(),
@@ -266,7 +266,7 @@ func (r *IntegrationDatabricksResource) Update(ctx context.Context, req resource
 	}
 
 	// Update the integration
-	updatedIntegration, err := r.client.UpdateIntegration(infisical.UpdateIntegrationRequest{
+	updatedIntegration, err := r.client.UpdateIntegration(ctx, infisical.UpdateIntegrationRequest{
 		ID:          state.IntegrationID.ValueString(),
 		Environment: plan.Environment.ValueString(),
 		SecretPath:  plan.SecretPath.ValueString(),
@@ -310,7 +310,7 @@ func (r *IntegrationDatabricksResource) Delete(ctx context.Context, req resource
 		return
 	}
 
-	_, err := r.client.DeleteIntegrationAuth(infisical.DeleteIntegrationAuthRequest{
+	_, err := r.client.DeleteIntegrationAuth(ctx, infisical.DeleteIntegrationAuthRequest{
 		ID: state.IntegrationAuthID.ValueString(),
 	})
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -170,7 +171,7 @@ func (r *IntegrationGCPSecretManagerResource) Create(ctx context.Context, req re
 	}
 
 	// Create integration auth first
-	auth, err := r.client.CreateIntegrationAuth(infisical.CreateIntegrationAuthRequest{
+	auth, err := r.client.CreateIntegrationAuth(ctx, infisical.CreateIntegrationAuthRequest{
 		RefreshToken: plan.ServiceAccountJson.ValueString(),
 		ProjectID:    plan.ProjectID.ValueString(),
 		Integration:  infisical.IntegrationAuthTypeGcpSecretManager,
@@ -212,7 +213,7 @@ func (r *IntegrationGCPSecretManagerResource) Create(ctx context.Context, req re
 	}
 
 	// Create the integration
-	integration, err := r.client.CreateIntegration(infisical.CreateIntegrationRequest{
+	integration, err := r.client.CreateIntegration(ctx, infisical.CreateIntegrationRequest{
 		IntegrationAuthID: auth.IntegrationAuth.ID,
 		App:               plan.GCPProjectID.ValueString(),
 		AppID:             plan.GCPProjectID.ValueString(),
@@ -259,19 +260,18 @@ func (r *IntegrationGCPSecretManagerResource) Read(ctx context.Context, req reso
 		return
 	}
 
-	integration, err := r.client.GetIntegration(infisical.GetIntegrationRequest{
+	integration, err := r.client.GetIntegration(ctx, infisical.GetIntegrationRequest{
 		ID: state.IntegrationID.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
-		} else {
-			resp.Diagnostics.AddError(
-				"Unable to get integration",
-				err.Error(),
-			)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
+			return
 		}
+		resp.Diagnostics.AddError(
+			"Unable to get integration",
+			err.Error(),
+		)
 		return
 	}
 
@@ -366,7 +366,7 @@ func (r *IntegrationGCPSecretManagerResource) Update(ctx context.Context, req re
 		return
 	}
 
-	_, err := r.client.UpdateIntegrationAuth(infisical.UpdateIntegrationAuthRequest{
+	_, err := r.client.UpdateIntegrationAuth(ctx, infisical.UpdateIntegrationAuthRequest{
 		Integration:       infisical.IntegrationAuthTypeGcpSecretManager,
 		IntegrationAuthId: plan.IntegrationAuthID.ValueString(),
 		RefreshToken:      plan.ServiceAccountJson.ValueString(),
@@ -391,7 +391,7 @@ func (r *IntegrationGCPSecretManagerResource) Update(ctx context.Context, req re
 		"secretSuffix": options.SecretSuffix.ValueString(),
 	}
 
-	_, err = r.client.UpdateIntegration(infisical.UpdateIntegrationRequest{
+	_, err = r.client.UpdateIntegration(ctx, infisical.UpdateIntegrationRequest{
 		IsActive:    true,
 		ID:          state.IntegrationID.ValueString(),
 		Environment: plan.Environment.ValueString(),
@@ -434,7 +434,7 @@ func (r *IntegrationGCPSecretManagerResource) Delete(ctx context.Context, req re
 		return
 	}
 
-	_, err := r.client.DeleteIntegrationAuth(infisical.DeleteIntegrationAuthRequest{
+	_, err := r.client.DeleteIntegrationAuth(ctx, infisical.DeleteIntegrationAuthRequest{
 		ID: state.IntegrationAuthID.ValueString(),
 	})
 
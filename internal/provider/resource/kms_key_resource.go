@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 	"time"
 
 	infisical "terraform-provider-infisical/internal/client"
@@ -191,7 +192,7 @@ func (r *kmsKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 		createKMSKeyRequest.EncryptionAlgorithm = plan.EncryptionAlgorithm.ValueString()
 	}
 
-	kmsKey, err := r.client.CreateKMSKey(createKMSKeyRequest)
+	kmsKey, err := r.client.CreateKMSKey(ctx, createKMSKeyRequest)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating KMS key",
@@ -224,7 +225,7 @@ func (r *kmsKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 				IsDisabled: &isDisabled,
 			}
 
-			updatedKey, err := r.client.UpdateKMSKey(updateRequest)
+			updatedKey, err := r.client.UpdateKMSKey(ctx, updateRequest)
 			if err != nil {
 				resp.Diagnostics.AddError(
 					"Error updating KMS key disabled state",
@@ -249,13 +250,12 @@ func (r *kmsKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	kmsKey, err := r.client.GetKMSKey(infisical.GetKMSKeyRequest{
+	kmsKey, err := r.client.GetKMSKey(ctx, infisical.GetKMSKeyRequest{
 		KeyId: state.ID.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
 
@@ -307,7 +307,7 @@ func (r *kmsKeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		updateRequest.IsDisabled = &isDisabled
 	}
 
-	updatedKey, err := r.client.UpdateKMSKey(updateRequest)
+	updatedKey, err := r.client.UpdateKMSKey(ctx, updateRequest)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating KMS key",
@@ -340,7 +340,7 @@ func (r *kmsKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	_, err := r.client.DeleteKMSKey(infisical.DeleteKMSKeyRequest{
+	_, err := r.client.DeleteKMSKey(ctx, infisical.DeleteKMSKeyRequest{
 		KeyId: state.ID.ValueString(),
 	})
 
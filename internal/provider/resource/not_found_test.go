@@ -0,0 +1,82 @@
+package resource
+
+import (
+	"terraform-provider-infisical/internal/provider/resource/resourcetest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestReadRemovesResourcesDeletedOutsideOfTerraform(t *testing.T) {
+	cases := map[string]struct {
+		newResource func() resource.Resource
+		attributes  map[string]any
+	}{
+		"project": {
+			newResource: NewProjectResource,
+			attributes:  map[string]any{"id": "project-id", "slug": "my-project"},
+		},
+		"identity": {
+			newResource: NewIdentityResource,
+			attributes:  map[string]any{"id": "identity-id"},
+		},
+		"secret": {
+			newResource: NewSecretResource,
+			attributes:  map[string]any{"id": "secret-id", "name": "DB_URL"},
+		},
+		"cert manager certificate": {
+			newResource: NewCertManagerCertificateResource,
+			attributes:  map[string]any{"id": "certificate-id"},
+		},
+		"identity aws auth": {
+			newResource: NewIdentityAwsAuthResource,
+			attributes:  map[string]any{"id": "auth-id", "identity_id": "identity-id"},
+		},
+		"identity azure auth": {
+			newResource: NewIdentityAzureAuthResource,
+			attributes:  map[string]any{"id": "auth-id", "identity_id": "identity-id"},
+		},
+		"identity gcp auth": {
+			newResource: NewIdentityGcpAuthResource,
+			attributes:  map[string]any{"id": "auth-id", "identity_id": "identity-id"},
+		},
+		"identity jwt auth": {
+			newResource: NewIdentityJwtAuthResource,
+			attributes:  map[string]any{"id": "auth-id", "identity_id": "identity-id"},
+		},
+		"identity kubernetes auth": {
+			newResource: NewIdentityKubernetesAuthResource,
+			attributes:  map[string]any{"id": "auth-id", "identity_id": "identity-id"},
+		},
+		"identity oidc auth": {
+			newResource: NewIdentityOidcAuthResource,
+			attributes:  map[string]any{"id": "auth-id", "identity_id": "identity-id"},
+		},
+		"identity tls cert auth": {
+			newResource: NewIdentityTlsCertAuthResource,
+			attributes:  map[string]any{"id": "auth-id", "identity_id": "identity-id"},
+		},
+		"identity token auth": {
+			newResource: NewIdentityTokenAuthResource,
+			attributes:  map[string]any{"id": "auth-id", "identity_id": "identity-id"},
+		},
+		"identity token auth token": {
+			newResource: NewIdentityTokenAuthTokenResource,
+			attributes:  map[string]any{"id": "token-id", "identity_id": "identity-id"},
+		},
+		"identity universal auth": {
+			newResource: NewIdentityUniversalAuthResource,
+			attributes:  map[string]any{"id": "auth-id", "identity_id": "identity-id"},
+		},
+		"identity universal auth client secret": {
+			newResource: NewIdentityUniversalAuthClientSecretResource,
+			attributes:  map[string]any{"id": "client-secret-id", "identity_id": "identity-id"},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			resourcetest.AssertReadRemovesWhenNotFound(t, c.newResource, c.attributes)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package resource
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	infisical "terraform-provider-infisical/internal/client"
 	pkg "terraform-provider-infisical/internal/pkg/modifiers"
@@ -198,7 +199,7 @@ func (r *orgRoleResource) Create(ctx context.Context, req resource.CreateRequest
 		permissions[i] = permMap
 	}
 
-	newOrgRole, err := r.client.CreateOrgRole(infisical.CreateOrgRoleRequest{
+	newOrgRole, err := r.client.CreateOrgRole(ctx, infisical.CreateOrgRoleRequest{
 		Slug:        plan.Slug.ValueString(),
 		Name:        plan.Name.ValueString(),
 		Description: plan.Description.ValueString(),
@@ -240,13 +241,12 @@ func (r *orgRoleResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	orgRole, err := r.client.GetOrgRoleById(infisical.GetOrgRoleByIdRequest{
+	orgRole, err := r.client.GetOrgRoleById(ctx, infisical.GetOrgRoleByIdRequest{
 		RoleId: state.ID.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
 		resp.Diagnostics.AddError(
@@ -386,7 +386,7 @@ func (r *orgRoleResource) Update(ctx context.Context, req resource.UpdateRequest
 		permissions[i] = permMap
 	}
 
-	_, err := r.client.UpdateOrgRole(infisical.UpdateOrgRoleRequest{
+	_, err := r.client.UpdateOrgRole(ctx, infisical.UpdateOrgRoleRequest{
 		RoleId:      state.ID.ValueString(),
 		Slug:        plan.Slug.ValueString(),
 		Name:        plan.Name.ValueString(),
@@ -427,7 +427,7 @@ func (r *orgRoleResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	_, err := r.client.DeleteOrgRole(infisical.DeleteOrgRoleRequest{
+	_, err := r.client.DeleteOrgRole(ctx, infisical.DeleteOrgRoleRequest{
 		RoleId: state.ID.ValueString(),
 	})
 
@@ -450,12 +450,12 @@ func (r *orgRoleResource) ImportState(ctx context.Context, req resource.ImportSt
 		return
 	}
 
-	orgRole, err := r.client.GetOrgRoleById(infisical.GetOrgRoleByIdRequest{
+	orgRole, err := r.client.GetOrgRoleById(ctx, infisical.GetOrgRoleByIdRequest{
 		RoleId: req.ID,
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
+		if errors.Is(err, infisical.ErrNotFound) {
 			resp.Diagnostics.AddError(
 				"Organization role not found",
 				"The organization role with the given ID was not found",
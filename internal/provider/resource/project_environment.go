@@ -2,6 +2,7 @@ package resource
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	infisical "terraform-provider-infisical/internal/client"
 	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
@@ -129,7 +130,7 @@ func (r *projectEnvironmentResource) Create(ctx context.Context, req resource.Cr
 		request.Position = plan.Position.ValueInt64()
 	}
 
-	newProjectEnvironment, err := r.client.CreateProjectEnvironment(request)
+	newProjectEnvironment, err := r.client.CreateProjectEnvironment(ctx, request)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -167,7 +168,7 @@ func (r *projectEnvironmentResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
-	_, err := r.client.DeleteProjectEnvironment(infisical.DeleteProjectEnvironmentRequest{
+	_, err := r.client.DeleteProjectEnvironment(ctx, infisical.DeleteProjectEnvironmentRequest{
 		ID:        state.ID.ValueString(),
 		ProjectID: state.ProjectID.ValueString(),
 	})
@@ -205,21 +206,19 @@ func (r *projectEnvironmentResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
-	projectEnvironment, err := r.client.GetProjectEnvironmentByID(infisical.GetProjectEnvironmentByIDRequest{
+	projectEnvironment, err := r.client.GetProjectEnvironmentByID(ctx, infisical.GetProjectEnvironmentByIDRequest{
 		ID: state.ID.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
-			return
-		} else {
-			resp.Diagnostics.AddError(
-				"Error fetching environment from your project",
-				"Couldn't read project environment from Infisical, unexpected error: "+err.Error(),
-			)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
+		resp.Diagnostics.AddError(
+			"Error fetching environment from your project",
+			"Couldn't read project environment from Infisical, unexpected error: "+err.Error(),
+		)
+		return
 	}
 
 	state.Name = types.StringValue(projectEnvironment.Environment.Name)
@@ -258,7 +257,7 @@ func (r *projectEnvironmentResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
-	updatedEnvironment, err := r.client.UpdateProjectEnvironment(infisical.UpdateProjectEnvironmentRequest{
+	updatedEnvironment, err := r.client.UpdateProjectEnvironment(ctx, infisical.UpdateProjectEnvironmentRequest{
 		ProjectID: plan.ProjectID.ValueString(),
 		Name:      plan.Name.ValueString(),
 		ID:        plan.ID.ValueString(),
@@ -287,12 +286,12 @@ func (r *projectEnvironmentResource) Update(ctx context.Context, req resource.Up
 
 func (r *projectEnvironmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 
-	projectEnvironment, err := r.client.GetProjectEnvironmentByID(infisical.GetProjectEnvironmentByIDRequest{
+	projectEnvironment, err := r.client.GetProjectEnvironmentByID(ctx, infisical.GetProjectEnvironmentByIDRequest{
 		ID: req.ID,
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
+		if errors.Is(err, infisical.ErrNotFound) {
 			resp.Diagnostics.AddError(
 				"Project environment not found",
 				"The project environment with the given slug was not found",
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/url"
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -221,7 +222,7 @@ func (r *ProjectGroupResource) Create(ctx context.Context, req resource.CreateRe
 		request.GroupIdOrName = url.QueryEscape(plan.GroupName.ValueString())
 	}
 
-	projectGroupResponse, err := r.client.CreateProjectGroup(request)
+	projectGroupResponse, err := r.client.CreateProjectGroup(ctx, request)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -261,22 +262,20 @@ func (r *ProjectGroupResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	projectGroupMembership, err := r.client.GetProjectGroupMembership(infisical.GetProjectGroupMembershipRequest{
+	projectGroupMembership, err := r.client.GetProjectGroupMembership(ctx, infisical.GetProjectGroupMembershipRequest{
 		ProjectId: state.ProjectID.ValueString(),
 		GroupId:   state.GroupID.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
-			return
-		} else {
-			resp.Diagnostics.AddError(
-				"Error reading project group membership",
-				"Couldn't read project group membership from Infisical, unexpected error: "+err.Error(),
-			)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
+		resp.Diagnostics.AddError(
+			"Error reading project group membership",
+			"Couldn't read project group membership from Infisical, unexpected error: "+err.Error(),
+		)
+		return
 	}
 
 	stateRoleMap := make(map[string]ProjectGroupRole)
@@ -421,7 +420,7 @@ func (r *ProjectGroupResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
-	_, err := r.client.UpdateProjectGroup(infisical.UpdateProjectGroupRequest{
+	_, err := r.client.UpdateProjectGroup(ctx, infisical.UpdateProjectGroupRequest{
 		ProjectId: state.ProjectID.ValueString(),
 		GroupId:   state.GroupID.ValueString(),
 		Roles:     roles,
@@ -456,7 +455,7 @@ func (r *ProjectGroupResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
-	_, err := r.client.DeleteProjectGroup(infisical.DeleteProjectGroupRequest{
+	_, err := r.client.DeleteProjectGroup(ctx, infisical.DeleteProjectGroupRequest{
 		ProjectId: state.ProjectID.ValueString(),
 		GroupId:   state.GroupID.ValueString(),
 	})
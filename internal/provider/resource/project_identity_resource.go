@@ -201,7 +201,7 @@ func (r *ProjectIdentityResource) Create(ctx context.Context, req resource.Creat
 	var existing infisical.GetProjectIdentityByIDResponse
 	if plan.AdoptExisting.ValueBool() {
 		var getErr error
-		existing, getErr = r.client.GetProjectIdentityByID(infisical.GetProjectIdentityByIDRequest{
+		existing, getErr = r.client.GetProjectIdentityByID(ctx, infisical.GetProjectIdentityByIDRequest{
 			ProjectID:  plan.ProjectID.ValueString(),
 			IdentityID: plan.IdentityID.ValueString(),
 		})
@@ -241,7 +241,7 @@ func (r *ProjectIdentityResource) Create(ctx context.Context, req resource.Creat
 		for i, role := range roles {
 			updateRoles[i] = infisical.UpdateProjectIdentityRequestRoles(role)
 		}
-		_, err = r.client.UpdateProjectIdentity(infisical.UpdateProjectIdentityRequest{
+		_, err = r.client.UpdateProjectIdentity(ctx, infisical.UpdateProjectIdentityRequest{
 			ProjectID:  plan.ProjectID.ValueString(),
 			IdentityID: plan.IdentityID.ValueString(),
 			Roles:      updateRoles,
@@ -254,7 +254,7 @@ func (r *ProjectIdentityResource) Create(ctx context.Context, req resource.Creat
 			return
 		}
 	} else {
-		_, err = r.client.CreateProjectIdentity(infisical.CreateProjectIdentityRequest{
+		_, err = r.client.CreateProjectIdentity(ctx, infisical.CreateProjectIdentityRequest{
 			ProjectID:  plan.ProjectID.ValueString(),
 			IdentityID: plan.IdentityID.ValueString(),
 			Roles:      roles,
@@ -268,7 +268,7 @@ func (r *ProjectIdentityResource) Create(ctx context.Context, req resource.Creat
 		}
 	}
 
-	projectIdentityDetails, err := r.client.GetProjectIdentityByID(infisical.GetProjectIdentityByIDRequest{
+	projectIdentityDetails, err := r.client.GetProjectIdentityByID(ctx, infisical.GetProjectIdentityByIDRequest{
 		ProjectID:  plan.ProjectID.ValueString(),
 		IdentityID: plan.IdentityID.ValueString(),
 	})
@@ -326,14 +326,13 @@ func (r *ProjectIdentityResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	projectIdentityDetails, err := r.client.GetProjectIdentityByID(infisical.GetProjectIdentityByIDRequest{
+	projectIdentityDetails, err := r.client.GetProjectIdentityByID(ctx, infisical.GetProjectIdentityByIDRequest{
 		ProjectID:  state.ProjectID.ValueString(),
 		IdentityID: state.IdentityID.ValueString(),
 	})
 
 	if err != nil {
-		if errors.Is(err, infisical.ErrNotFound) {
-			resp.State.RemoveResource(ctx)
+		if tfpkg.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
 		resp.Diagnostics.AddError(
@@ -409,7 +408,7 @@ func (r *ProjectIdentityResource) Update(ctx context.Context, req resource.Updat
 		roles[i] = infisical.UpdateProjectIdentityRequestRoles(role)
 	}
 
-	_, err = r.client.UpdateProjectIdentity(infisical.UpdateProjectIdentityRequest{
+	_, err = r.client.UpdateProjectIdentity(ctx, infisical.UpdateProjectIdentityRequest{
 		ProjectID:  plan.ProjectID.ValueString(),
 		IdentityID: plan.IdentityID.ValueString(),
 		Roles:      roles,
@@ -422,7 +421,7 @@ func (r *ProjectIdentityResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	projectIdentityDetails, err := r.client.GetProjectIdentityByID(infisical.GetProjectIdentityByIDRequest{
+	projectIdentityDetails, err := r.client.GetProjectIdentityByID(ctx, infisical.GetProjectIdentityByIDRequest{
 		ProjectID:  plan.ProjectID.ValueString(),
 		IdentityID: plan.IdentityID.ValueString(),
 	})
@@ -473,7 +472,7 @@ func (r *ProjectIdentityResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
-	_, err := r.client.DeleteProjectIdentity(infisical.DeleteProjectIdentityRequest{
+	_, err := r.client.DeleteProjectIdentity(ctx, infisical.DeleteProjectIdentityRequest{
 		ProjectID:  state.ProjectID.ValueString(),
 		IdentityID: state.IdentityID.ValueString(),
 	})
@@ -489,7 +488,7 @@ func (r *ProjectIdentityResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *ProjectIdentityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	projectIdentityDetails, err := r.client.GetProjectIdentityByMembershipID(infisical.GetProjectIdentityByMembershipIDRequest{
+	projectIdentityDetails, err := r.client.GetProjectIdentityByMembershipID(ctx, infisical.GetProjectIdentityByMembershipIDRequest{
 		MembershipID: req.ID,
 	})
 	if err != nil {
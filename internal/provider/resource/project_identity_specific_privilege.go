@@ -299,7 +299,7 @@ func (r *projectIdentitySpecificPrivilegeResourceResource) Create(ctx context.Co
 				temporaryRange = "1h"
 			}
 
-			newProjectRole, err := r.client.CreateTemporaryProjectIdentitySpecificPrivilege(infisical.CreateTemporaryProjectIdentitySpecificPrivilegeRequest{
+			newProjectRole, err := r.client.CreateTemporaryProjectIdentitySpecificPrivilege(ctx, infisical.CreateTemporaryProjectIdentitySpecificPrivilegeRequest{
 				ProjectSlug:              plan.ProjectSlug.ValueString(),
 				Slug:                     plan.Slug.ValueString(),
 				IdentityId:               plan.IdentityID.ValueString(),
@@ -323,7 +323,7 @@ func (r *projectIdentitySpecificPrivilegeResourceResource) Create(ctx context.Co
 			plan.TemporaryRange = types.StringValue(newProjectRole.Privilege.TemporaryRange)
 			plan.TemporaryMode = types.StringValue(newProjectRole.Privilege.TemporaryMode)
 		} else {
-			newProjectRole, err := r.client.CreatePermanentProjectIdentitySpecificPrivilege(infisical.CreatePermanentProjectIdentitySpecificPrivilegeRequest{
+			newProjectRole, err := r.client.CreatePermanentProjectIdentitySpecificPrivilege(ctx, infisical.CreatePermanentProjectIdentitySpecificPrivilegeRequest{
 				ProjectSlug: plan.ProjectSlug.ValueString(),
 				Slug:        plan.Slug.ValueString(),
 				IdentityId:  plan.IdentityID.ValueString(),
@@ -346,7 +346,7 @@ func (r *projectIdentitySpecificPrivilegeResourceResource) Create(ctx context.Co
 		}
 	} else {
 		// Permission V2
-		project, err := r.client.GetProject(infisical.GetProjectRequest{
+		project, err := r.client.GetProject(ctx, infisical.GetProjectRequest{
 			Slug: plan.ProjectSlug.ValueString(),
 		})
 
@@ -416,7 +416,7 @@ func (r *projectIdentitySpecificPrivilegeResourceResource) Create(ctx context.Co
 			temporaryRange = "1h"
 		}
 
-		newProjectRole, err := r.client.CreateProjectIdentitySpecificPrivilegeV2(infisical.CreateProjectIdentitySpecificPrivilegeV2Request{
+		newProjectRole, err := r.client.CreateProjectIdentitySpecificPrivilegeV2(ctx, infisical.CreateProjectIdentitySpecificPrivilegeV2Request{
 			ProjectId:   project.ID,
 			Slug:        plan.Slug.ValueString(),
 			IdentityId:  plan.IdentityID.ValueString(),
@@ -480,13 +480,16 @@ func (r *projectIdentitySpecificPrivilegeResourceResource) Read(ctx context.Cont
 
 	if state.Permission != nil {
 		// Permission V1
-		projectIdentitySpecificPrivilegeResource, err := r.client.GetProjectIdentitySpecificPrivilegeBySlug(infisical.GetProjectIdentitySpecificPrivilegeRequest{
+		projectIdentitySpecificPrivilegeResource, err := r.client.GetProjectIdentitySpecificPrivilegeBySlug(ctx, infisical.GetProjectIdentitySpecificPrivilegeRequest{
 			PrivilegeSlug: state.Slug.ValueString(),
 			ProjectSlug:   state.ProjectSlug.ValueString(),
 			IdentityID:    state.IdentityID.ValueString(),
 		})
 
 		if err != nil {
+			if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
+				return
+			}
 			resp.Diagnostics.AddError(
 				"Error reading project identity specific privilege",
 				"Couldn't read project identity specific privilege from Infisical, unexpected error: "+err.Error(),
@@ -584,11 +587,14 @@ func (r *projectIdentitySpecificPrivilegeResourceResource) Read(ctx context.Cont
 		}
 	} else {
 		// Permission V2
-		projectIdentitySpecificPrivilegeResource, err := r.client.GetProjectIdentitySpecificPrivilegeV2(infisical.GetProjectIdentitySpecificPrivilegeV2Request{
+		projectIdentitySpecificPrivilegeResource, err := r.client.GetProjectIdentitySpecificPrivilegeV2(ctx, infisical.GetProjectIdentitySpecificPrivilegeV2Request{
 			ID: state.ID.ValueString(),
 		})
 
 		if err != nil {
+			if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
+				return
+			}
 			resp.Diagnostics.AddError(
 				"Error reading project identity specific privilege",
 				"Couldn't read project identity specific privilege from Infisical, unexpected error: "+err.Error(),
@@ -761,7 +767,7 @@ func (r *projectIdentitySpecificPrivilegeResourceResource) Update(ctx context.Co
 			temporaryRange = "1h"
 		}
 
-		updatedSpecificPrivilege, err := r.client.UpdateProjectIdentitySpecificPrivilege(infisical.UpdateProjectIdentitySpecificPrivilegeRequest{
+		updatedSpecificPrivilege, err := r.client.UpdateProjectIdentitySpecificPrivilege(ctx, infisical.UpdateProjectIdentitySpecificPrivilegeRequest{
 			ProjectSlug:   plan.ProjectSlug.ValueString(),
 			PrivilegeSlug: state.Slug.ValueString(),
 			IdentityId:    plan.IdentityID.ValueString(),
@@ -853,7 +859,7 @@ func (r *projectIdentitySpecificPrivilegeResourceResource) Update(ctx context.Co
 			temporaryRange = "1h"
 		}
 
-		updatedSpecificPrivilege, err := r.client.UpdateProjectIdentitySpecificPrivilegeV2(infisical.UpdateProjectIdentitySpecificPrivilegeV2Request{
+		updatedSpecificPrivilege, err := r.client.UpdateProjectIdentitySpecificPrivilegeV2(ctx, infisical.UpdateProjectIdentitySpecificPrivilegeV2Request{
 			ID:          plan.ID.ValueString(),
 			Slug:        plan.Slug.ValueString(),
 			Permissions: permissions,
@@ -918,7 +924,7 @@ func (r *projectIdentitySpecificPrivilegeResourceResource) ImportState(ctx conte
 	identityID := strings.TrimSpace(parts[1])
 	privilegeID := strings.TrimSpace(parts[2])
 
-	project, err := r.client.GetProjectById(infisical.GetProjectByIdRequest{ID: projectID})
+	project, err := r.client.GetProjectById(ctx, infisical.GetProjectByIdRequest{ID: projectID})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error fetching project",
@@ -957,7 +963,7 @@ func (r *projectIdentitySpecificPrivilegeResourceResource) Delete(ctx context.Co
 		return
 	}
 
-	_, err := r.client.DeleteProjectIdentitySpecificPrivilege(infisical.DeleteProjectIdentitySpecificPrivilegeRequest{
+	_, err := r.client.DeleteProjectIdentitySpecificPrivilege(ctx, infisical.DeleteProjectIdentitySpecificPrivilegeRequest{
 		ProjectSlug:   state.ProjectSlug.ValueString(),
 		IdentityId:    state.IdentityID.ValueString(),
 		PrivilegeSlug: state.Slug.ValueString(),
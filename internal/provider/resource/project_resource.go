@@ -2,6 +2,7 @@ package resource
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	infisical "terraform-provider-infisical/internal/client"
@@ -179,7 +180,7 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 		projectType = plan.Type.ValueString()
 	}
 
-	newProject, err := r.client.CreateProject(infisical.CreateProjectRequest{
+	newProject, err := r.client.CreateProject(ctx, infisical.CreateProjectRequest{
 		ProjectName:             plan.Name.ValueString(),
 		ProjectDescription:      plan.Description.ValueString(),
 		Slug:                    plan.Slug.ValueString(),
@@ -199,7 +200,7 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	if !plan.AuditLogRetentionDays.IsNull() && !plan.AuditLogRetentionDays.IsUnknown() {
-		_, err := r.client.UpdateProjectAuditLogRetention(infisical.UpdateProjectAuditLogRetentionRequest{
+		_, err := r.client.UpdateProjectAuditLogRetention(ctx, infisical.UpdateProjectAuditLogRetentionRequest{
 			ProjectSlug: plan.Slug.ValueString(),
 			Days:        plan.AuditLogRetentionDays.ValueInt64(),
 		})
@@ -212,7 +213,7 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 		}
 	}
 
-	project, err := r.client.GetProjectById(infisical.GetProjectByIdRequest{
+	project, err := r.client.GetProjectById(ctx, infisical.GetProjectByIdRequest{
 		ID: newProject.Project.ID,
 	})
 
@@ -261,13 +262,12 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	// Get the latest data from the API
-	project, err := r.client.GetProjectById(infisical.GetProjectByIdRequest{
+	project, err := r.client.GetProjectById(ctx, infisical.GetProjectByIdRequest{
 		ID: state.ID.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
 
@@ -368,7 +368,7 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 		updateRequest.ProjectDescription = plan.Description.ValueString()
 	}
 
-	_, err := r.client.UpdateProject(updateRequest)
+	_, err := r.client.UpdateProject(ctx, updateRequest)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -379,7 +379,7 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	if state.AuditLogRetentionDays != plan.AuditLogRetentionDays && !plan.AuditLogRetentionDays.IsNull() && !plan.AuditLogRetentionDays.IsUnknown() {
-		_, err := r.client.UpdateProjectAuditLogRetention(infisical.UpdateProjectAuditLogRetentionRequest{
+		_, err := r.client.UpdateProjectAuditLogRetention(ctx, infisical.UpdateProjectAuditLogRetentionRequest{
 			ProjectSlug: plan.Slug.ValueString(),
 			Days:        plan.AuditLogRetentionDays.ValueInt64(),
 		})
@@ -392,7 +392,7 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 		}
 	}
 
-	project, err := r.client.GetProjectById(infisical.GetProjectByIdRequest{
+	project, err := r.client.GetProjectById(ctx, infisical.GetProjectByIdRequest{
 		ID: plan.ID.ValueString(),
 	})
 
@@ -441,7 +441,7 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	err := r.client.DeleteProject(infisical.DeleteProjectRequest{
+	err := r.client.DeleteProject(ctx, infisical.DeleteProjectRequest{
 		Slug: state.Slug.ValueString(),
 	})
 
@@ -457,12 +457,12 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 func (r *projectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 
-	project, err := r.client.GetProjectById(infisical.GetProjectByIdRequest{
+	project, err := r.client.GetProjectById(ctx, infisical.GetProjectByIdRequest{
 		ID: req.ID,
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
+		if errors.Is(err, infisical.ErrNotFound) {
 			resp.Diagnostics.AddError(
 				"Project not found",
 				"The project with the given ID was not found",
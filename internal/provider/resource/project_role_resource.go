@@ -223,14 +223,14 @@ func (r *projectRoleResource) Configure(_ context.Context, req resource.Configur
 	r.client = client
 }
 
-func (r *projectRoleResource) getProject(plan projectRoleResourceModel) (infisical.ProjectWithEnvironments, error) {
+func (r *projectRoleResource) getProject(ctx context.Context, plan projectRoleResourceModel) (infisical.ProjectWithEnvironments, error) {
 	if !plan.ProjectSlug.IsNull() && !plan.ProjectSlug.IsUnknown() {
-		return r.client.GetProject(infisical.GetProjectRequest{
+		return r.client.GetProject(ctx, infisical.GetProjectRequest{
 			Slug: plan.ProjectSlug.ValueString(),
 		})
 	}
 
-	return r.client.GetProjectById(infisical.GetProjectByIdRequest{
+	return r.client.GetProjectById(ctx, infisical.GetProjectByIdRequest{
 		ID: plan.ProjectID.ValueString(),
 	})
 }
@@ -294,7 +294,7 @@ func (r *projectRoleResource) Create(ctx context.Context, req resource.CreateReq
 			})
 		}
 
-		project, err := r.getProject(plan)
+		project, err := r.getProject(ctx, plan)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error creating project role",
@@ -303,7 +303,7 @@ func (r *projectRoleResource) Create(ctx context.Context, req resource.CreateReq
 			return
 		}
 
-		newProjectRole, err := r.client.CreateProjectRole(infisical.CreateProjectRoleRequest{
+		newProjectRole, err := r.client.CreateProjectRole(ctx, infisical.CreateProjectRoleRequest{
 			ProjectSlug: project.Slug,
 			Slug:        plan.Slug.ValueString(),
 			Name:        plan.Name.ValueString(),
@@ -321,7 +321,7 @@ func (r *projectRoleResource) Create(ctx context.Context, req resource.CreateReq
 
 		plan.ID = types.StringValue(newProjectRole.Role.ID)
 	} else {
-		project, err := r.getProject(plan)
+		project, err := r.getProject(ctx, plan)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error creating project role",
@@ -363,7 +363,7 @@ func (r *projectRoleResource) Create(ctx context.Context, req resource.CreateReq
 			permissions[i] = permMap
 		}
 
-		newProjectRole, err := r.client.CreateProjectRoleV2(infisical.CreateProjectRoleV2Request{
+		newProjectRole, err := r.client.CreateProjectRoleV2(ctx, infisical.CreateProjectRoleV2Request{
 			ProjectId:   project.ID,
 			Slug:        plan.Slug.ValueString(),
 			Name:        plan.Name.ValueString(),
@@ -409,8 +409,11 @@ func (r *projectRoleResource) Read(ctx context.Context, req resource.ReadRequest
 
 	// Permissions V1
 	if !state.Permissions.IsNull() {
-		project, err := r.getProject(state)
+		project, err := r.getProject(ctx, state)
 		if err != nil {
+			if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
+				return
+			}
 			resp.Diagnostics.AddError(
 				"Error reading project role",
 				"Couldn't resolve project slug, unexpected error: "+err.Error(),
@@ -419,12 +422,15 @@ func (r *projectRoleResource) Read(ctx context.Context, req resource.ReadRequest
 		}
 
 		// Get the latest data from the API
-		projectRole, err := r.client.GetProjectRoleBySlug(infisical.GetProjectRoleBySlugRequest{
+		projectRole, err := r.client.GetProjectRoleBySlug(ctx, infisical.GetProjectRoleBySlugRequest{
 			RoleSlug:    state.Slug.ValueString(),
 			ProjectSlug: project.Slug,
 		})
 
 		if err != nil {
+			if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
+				return
+			}
 			resp.Diagnostics.AddError(
 				"Error reading project role",
 				"Couldn't read project role from Infisical, unexpected error: "+err.Error(),
@@ -549,8 +555,11 @@ func (r *projectRoleResource) Read(ctx context.Context, req resource.ReadRequest
 
 		state.Permissions = permissionListValue
 	} else {
-		project, err := r.getProject(state)
+		project, err := r.getProject(ctx, state)
 		if err != nil {
+			if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
+				return
+			}
 			resp.Diagnostics.AddError(
 				"Error reading project role",
 				"Couldn't resolve project ID, unexpected error: "+err.Error(),
@@ -558,12 +567,15 @@ func (r *projectRoleResource) Read(ctx context.Context, req resource.ReadRequest
 			return
 		}
 
-		projectRole, err := r.client.GetProjectRoleBySlugV2(infisical.GetProjectRoleBySlugV2Request{
+		projectRole, err := r.client.GetProjectRoleBySlugV2(ctx, infisical.GetProjectRoleBySlugV2Request{
 			ProjectId: project.ID,
 			RoleSlug:  state.Slug.ValueString(),
 		})
 
 		if err != nil {
+			if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
+				return
+			}
 			resp.Diagnostics.AddError(
 				"Error reading project role",
 				"Couldn't read project role from Infisical, unexpected error: "+err.Error(),
@@ -725,7 +737,7 @@ func (r *projectRoleResource) Update(ctx context.Context, req resource.UpdateReq
 			})
 		}
 
-		project, err := r.getProject(plan)
+		project, err := r.getProject(ctx, plan)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error updating project role",
@@ -734,7 +746,7 @@ func (r *projectRoleResource) Update(ctx context.Context, req resource.UpdateReq
 			return
 		}
 
-		_, err = r.client.UpdateProjectRole(infisical.UpdateProjectRoleRequest{
+		_, err = r.client.UpdateProjectRole(ctx, infisical.UpdateProjectRoleRequest{
 			ProjectSlug: project.Slug,
 			RoleId:      plan.ID.ValueString(),
 			Slug:        plan.Slug.ValueString(),
@@ -751,7 +763,7 @@ func (r *projectRoleResource) Update(ctx context.Context, req resource.UpdateReq
 			return
 		}
 	} else {
-		project, err := r.getProject(plan)
+		project, err := r.getProject(ctx, plan)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error updating project role",
@@ -792,7 +804,7 @@ func (r *projectRoleResource) Update(ctx context.Context, req resource.UpdateReq
 			permissions[i] = permMap
 		}
 
-		_, err = r.client.UpdateProjectRoleV2(infisical.UpdateProjectRoleV2Request{
+		_, err = r.client.UpdateProjectRoleV2(ctx, infisical.UpdateProjectRoleV2Request{
 			ProjectId:   project.ID,
 			RoleId:      plan.ID.ValueString(),
 			Slug:        plan.Slug.ValueString(),
@@ -836,7 +848,7 @@ func (r *projectRoleResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 
 	if !state.ProjectID.IsNull() && !state.ProjectID.IsUnknown() {
-		_, err := r.client.DeleteProjectRoleV2(infisical.DeleteProjectRoleV2Request{
+		_, err := r.client.DeleteProjectRoleV2(ctx, infisical.DeleteProjectRoleV2Request{
 			ProjectId: state.ProjectID.ValueString(),
 			RoleId:    state.ID.ValueString(),
 		})
@@ -849,7 +861,7 @@ func (r *projectRoleResource) Delete(ctx context.Context, req resource.DeleteReq
 			return
 		}
 	} else {
-		project, err := r.getProject(state)
+		project, err := r.getProject(ctx, state)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error deleting project role",
@@ -858,7 +870,7 @@ func (r *projectRoleResource) Delete(ctx context.Context, req resource.DeleteReq
 			return
 		}
 
-		_, err = r.client.DeleteProjectRole(infisical.DeleteProjectRoleRequest{
+		_, err = r.client.DeleteProjectRole(ctx, infisical.DeleteProjectRoleRequest{
 			ProjectSlug: project.Slug,
 			RoleId:      state.ID.ValueString(),
 		})
@@ -883,7 +895,7 @@ func (r *projectRoleResource) ImportState(ctx context.Context, req resource.Impo
 		return
 	}
 
-	projectRole, err := r.client.GetProjectRoleById(infisical.GetProjectRoleByIdRequest{
+	projectRole, err := r.client.GetProjectRoleById(ctx, infisical.GetProjectRoleByIdRequest{
 		RoleId: req.ID,
 	})
 	if err != nil {
@@ -2,6 +2,7 @@ package resource
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 	"strings"
@@ -194,7 +195,7 @@ func (r *ProjectScopedIdentityResource) Create(ctx context.Context, req resource
 
 	// The identity and its roles are created in a single atomic call: if a role is
 	// invalid the backend rejects the request without creating an orphan identity.
-	identity, err := r.client.CreateProjectScopedIdentity(infisical.CreateProjectScopedIdentityRequest{
+	identity, err := r.client.CreateProjectScopedIdentity(ctx, infisical.CreateProjectScopedIdentityRequest{
 		ProjectID:           plan.ProjectID.ValueString(),
 		Name:                plan.Name.ValueString(),
 		HasDeleteProtection: plan.HasDeleteProtection.ValueBool(),
@@ -211,7 +212,7 @@ func (r *ProjectScopedIdentityResource) Create(ctx context.Context, req resource
 
 	// The create response only returns the identity, so read the membership back to
 	// populate the computed role fields (ids, custom role slug, temporary end time).
-	membership, err := r.client.GetProjectScopedIdentityMembership(infisical.GetProjectIdentityByIDRequest{
+	membership, err := r.client.GetProjectScopedIdentityMembership(ctx, infisical.GetProjectIdentityByIDRequest{
 		ProjectID:  plan.ProjectID.ValueString(),
 		IdentityID: identity.ID,
 	})
@@ -257,13 +258,12 @@ func (r *ProjectScopedIdentityResource) Read(ctx context.Context, req resource.R
 		return
 	}
 
-	identity, err := r.client.GetProjectScopedIdentityByID(infisical.GetProjectScopedIdentityRequest{
+	identity, err := r.client.GetProjectScopedIdentityByID(ctx, infisical.GetProjectScopedIdentityRequest{
 		ProjectID:  state.ProjectID.ValueString(),
 		IdentityID: state.ID.ValueString(),
 	})
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
+		if tfpkg.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
 		resp.Diagnostics.AddError(
@@ -273,13 +273,12 @@ func (r *ProjectScopedIdentityResource) Read(ctx context.Context, req resource.R
 		return
 	}
 
-	membership, err := r.client.GetProjectScopedIdentityMembership(infisical.GetProjectIdentityByIDRequest{
+	membership, err := r.client.GetProjectScopedIdentityMembership(ctx, infisical.GetProjectIdentityByIDRequest{
 		ProjectID:  state.ProjectID.ValueString(),
 		IdentityID: state.ID.ValueString(),
 	})
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
+		if tfpkg.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
 		resp.Diagnostics.AddError(
@@ -331,7 +330,7 @@ func (r *ProjectScopedIdentityResource) Update(ctx context.Context, req resource
 		return
 	}
 
-	identity, err := r.client.UpdateProjectScopedIdentity(infisical.UpdateProjectScopedIdentityRequest{
+	identity, err := r.client.UpdateProjectScopedIdentity(ctx, infisical.UpdateProjectScopedIdentityRequest{
 		ProjectID:           state.ProjectID.ValueString(),
 		IdentityID:          state.ID.ValueString(),
 		Name:                plan.Name.ValueString(),
@@ -346,7 +345,7 @@ func (r *ProjectScopedIdentityResource) Update(ctx context.Context, req resource
 		return
 	}
 
-	if err := r.client.UpdateProjectScopedIdentityRoles(infisical.UpdateProjectScopedIdentityRolesRequest{
+	if err := r.client.UpdateProjectScopedIdentityRoles(ctx, infisical.UpdateProjectScopedIdentityRolesRequest{
 		ProjectID:  state.ProjectID.ValueString(),
 		IdentityID: state.ID.ValueString(),
 		Roles:      roles,
@@ -357,7 +356,7 @@ func (r *ProjectScopedIdentityResource) Update(ctx context.Context, req resource
 		if plan.Metadata != nil {
 			plan.Metadata = metadataFromAPI(identity.Metadata)
 		}
-		if current, readErr := r.client.GetProjectScopedIdentityMembership(infisical.GetProjectIdentityByIDRequest{
+		if current, readErr := r.client.GetProjectScopedIdentityMembership(ctx, infisical.GetProjectIdentityByIDRequest{
 			ProjectID:  state.ProjectID.ValueString(),
 			IdentityID: state.ID.ValueString(),
 		}); readErr == nil {
@@ -373,7 +372,7 @@ func (r *ProjectScopedIdentityResource) Update(ctx context.Context, req resource
 		return
 	}
 
-	membership, err := r.client.GetProjectScopedIdentityMembership(infisical.GetProjectIdentityByIDRequest{
+	membership, err := r.client.GetProjectScopedIdentityMembership(ctx, infisical.GetProjectIdentityByIDRequest{
 		ProjectID:  state.ProjectID.ValueString(),
 		IdentityID: state.ID.ValueString(),
 	})
@@ -414,12 +413,12 @@ func (r *ProjectScopedIdentityResource) Delete(ctx context.Context, req resource
 		return
 	}
 
-	_, err := r.client.DeleteProjectScopedIdentity(infisical.DeleteProjectScopedIdentityRequest{
+	_, err := r.client.DeleteProjectScopedIdentity(ctx, infisical.DeleteProjectScopedIdentityRequest{
 		ProjectID:  state.ProjectID.ValueString(),
 		IdentityID: state.ID.ValueString(),
 	})
 	if err != nil {
-		if err == infisical.ErrNotFound {
+		if errors.Is(err, infisical.ErrNotFound) {
 			return
 		}
 		resp.Diagnostics.AddError(
@@ -451,12 +450,12 @@ func (r *ProjectScopedIdentityResource) ImportState(ctx context.Context, req res
 	projectID := parts[0]
 	identityID := parts[1]
 
-	identity, err := r.client.GetProjectScopedIdentityByID(infisical.GetProjectScopedIdentityRequest{
+	identity, err := r.client.GetProjectScopedIdentityByID(ctx, infisical.GetProjectScopedIdentityRequest{
 		ProjectID:  projectID,
 		IdentityID: identityID,
 	})
 	if err != nil {
-		if err == infisical.ErrNotFound {
+		if errors.Is(err, infisical.ErrNotFound) {
 			resp.Diagnostics.AddError(
 				"Error importing project-scoped identity",
 				fmt.Sprintf("No project-scoped identity found with project_id=%s and id=%s", projectID, identityID),
@@ -470,7 +469,7 @@ func (r *ProjectScopedIdentityResource) ImportState(ctx context.Context, req res
 		return
 	}
 
-	membership, err := r.client.GetProjectScopedIdentityMembership(infisical.GetProjectIdentityByIDRequest{
+	membership, err := r.client.GetProjectScopedIdentityMembership(ctx, infisical.GetProjectIdentityByIDRequest{
 		ProjectID:  projectID,
 		IdentityID: identityID,
 	})
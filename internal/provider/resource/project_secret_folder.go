@@ -2,10 +2,12 @@ package resource
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"path/filepath"
 	"strings"
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -17,6 +19,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &projectSecretFolderResource{}
+	_ resource.ResourceWithModifyPlan = &projectSecretFolderResource{}
+)
+
 // NewProjectSecretFolderResource is a helper function to simplify the provider implementation.
 func NewProjectSecretFolderResource() resource.Resource {
 	return &projectSecretFolderResource{}
@@ -55,19 +63,31 @@ func (r *projectSecretFolderResource) Schema(_ context.Context, _ resource.Schem
 				Required:    true,
 			},
 			"folder_path": schema.StringAttribute{
-				Description:   "The path where the folder should be created/updated",
-				Required:      true,
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description: "The path where the folder should be created/updated. Defaults to the provider's `default_folder_path`.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"environment_slug": schema.StringAttribute{
-				Description:   "The environment slug of the folder to modify/create",
-				Required:      true,
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description: "The environment slug of the folder to modify/create. Defaults to the provider's `default_environment`.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"project_id": schema.StringAttribute{
-				Description:   "The Infisical project ID (Required for Machine Identity auth, and service tokens with multiple scopes)",
-				Required:      true,
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description: "The Infisical project ID (Required for Machine Identity auth, and service tokens with multiple scopes). Defaults to the provider's `default_project_id`.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"id": schema.StringAttribute{
 				Description:   "The ID of the folder",
@@ -118,6 +138,15 @@ func (r *projectSecretFolderResource) Configure(_ context.Context, req resource.
 	r.client = client
 }
 
+// ModifyPlan resolves the attributes that fall back to the provider defaults.
+func (r *projectSecretFolderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planProviderDefaults(ctx, r.client, req, resp,
+		providerDefault{attribute: "folder_path", providerAttribute: "default_folder_path", required: true, get: defaultFolderPath},
+		providerDefault{attribute: "environment_slug", providerAttribute: "default_environment", required: true, get: defaultEnvironment},
+		providerDefault{attribute: "project_id", providerAttribute: "default_project_id", required: true, get: defaultProjectId},
+	)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *projectSecretFolderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	if !r.client.Config.IsMachineIdentityAuth {
@@ -136,7 +165,7 @@ func (r *projectSecretFolderResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
-	newProjectSecretFolder, err := r.client.CreateSecretFolder(infisical.CreateSecretFolderRequest{
+	newProjectSecretFolder, err := r.client.CreateSecretFolder(ctx, infisical.CreateSecretFolderRequest{
 		Name:        plan.Name.ValueString(),
 		ProjectID:   plan.ProjectID.ValueString(),
 		Environment: plan.EnvironmentSlug.ValueString(),
@@ -184,21 +213,19 @@ func (r *projectSecretFolderResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get the latest data from the API
-	secretFolder, err := r.client.GetSecretFolderByID(infisical.GetSecretFolderByIDRequest{
+	secretFolder, err := r.client.GetSecretFolderByID(ctx, infisical.GetSecretFolderByIDRequest{
 		ID: state.ID.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
-			return
-		} else {
-			resp.Diagnostics.AddError(
-				"Error fetching folders from your project",
-				"Couldn't read project secret folder from Infisical, unexpected error: "+err.Error(),
-			)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
+		resp.Diagnostics.AddError(
+			"Error fetching folders from your project",
+			"Couldn't read project secret folder from Infisical, unexpected error: "+err.Error(),
+		)
+		return
 	}
 
 	state.EnvironmentID = types.StringValue(secretFolder.Folder.EnvID)
@@ -236,7 +263,7 @@ func (r *projectSecretFolderResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
-	updatedFolder, err := r.client.UpdateSecretFolder(infisical.UpdateSecretFolderRequest{
+	updatedFolder, err := r.client.UpdateSecretFolder(ctx, infisical.UpdateSecretFolderRequest{
 		ProjectID:   plan.ProjectID.ValueString(),
 		Name:        plan.Name.ValueString(),
 		ID:          plan.ID.ValueString(),
@@ -282,7 +309,7 @@ func (r *projectSecretFolderResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
-	_, err := r.client.DeleteSecretFolder(infisical.DeleteSecretFolderRequest{
+	_, err := r.client.DeleteSecretFolder(ctx, infisical.DeleteSecretFolderRequest{
 		ID:          state.ID.ValueString(),
 		ProjectID:   state.ProjectID.ValueString(),
 		Environment: state.EnvironmentSlug.ValueString(),
@@ -304,12 +331,12 @@ func (r *projectSecretFolderResource) ImportState(ctx context.Context, req resou
 
 	// secret_folder_id
 
-	folder, err := r.client.GetSecretFolderByID(infisical.GetSecretFolderByIDRequest{
+	folder, err := r.client.GetSecretFolderByID(ctx, infisical.GetSecretFolderByIDRequest{
 		ID: req.ID,
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
+		if errors.Is(err, infisical.ErrNotFound) {
 			resp.Diagnostics.AddError(
 				"Secrets folder not found",
 				"The secrets folder with the given ID was not found",
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -17,6 +18,12 @@ import (
 
 var notFoundErr *infisical.NotFoundError
 
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &projectSecretImportResource{}
+	_ resource.ResourceWithModifyPlan = &projectSecretImportResource{}
+)
+
 // NewProjectSecretImportResource is a helper function to simplify the provider implementation.
 func NewProjectSecretImportResource() resource.Resource {
 	return &projectSecretImportResource{}
@@ -54,19 +61,31 @@ func (r *projectSecretImportResource) Schema(_ context.Context, _ resource.Schem
 				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"project_id": schema.StringAttribute{
-				Description:   "The Infisical project ID",
-				Required:      true,
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description: "The Infisical project ID. Defaults to the provider's `default_project_id`.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"environment_slug": schema.StringAttribute{
-				Description:   "The environment slug of the secret import to modify/create",
-				Required:      true,
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description: "The environment slug of the secret import to modify/create. Defaults to the provider's `default_environment`.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"folder_path": schema.StringAttribute{
-				Description:   "The path where the secret should be imported",
-				Required:      true,
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description: "The path where the secret should be imported. Defaults to the provider's `default_folder_path`.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"import_folder_path": schema.StringAttribute{
 				Description:   "The path where the secret should be imported from",
@@ -107,6 +126,15 @@ func (r *projectSecretImportResource) Configure(_ context.Context, req resource.
 	r.client = client
 }
 
+// ModifyPlan resolves the attributes that fall back to the provider defaults.
+func (r *projectSecretImportResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planProviderDefaults(ctx, r.client, req, resp,
+		providerDefault{attribute: "project_id", providerAttribute: "default_project_id", required: true, get: defaultProjectId},
+		providerDefault{attribute: "environment_slug", providerAttribute: "default_environment", required: true, get: defaultEnvironment},
+		providerDefault{attribute: "folder_path", providerAttribute: "default_folder_path", required: true, get: defaultFolderPath},
+	)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *projectSecretImportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	if !r.client.Config.IsMachineIdentityAuth {
@@ -125,7 +153,7 @@ func (r *projectSecretImportResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
-	newProjectSecretImport, err := r.client.CreateSecretImport(infisical.CreateSecretImportRequest{
+	newProjectSecretImport, err := r.client.CreateSecretImport(ctx, infisical.CreateSecretImportRequest{
 		ProjectID:     plan.ProjectID.ValueString(),
 		Environment:   plan.EnvironmentSlug.ValueString(),
 		SecretPath:    plan.SecretPath.ValueString(),
@@ -175,7 +203,7 @@ func (r *projectSecretImportResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get the latest data from the API
-	_, err := r.client.GetSecretImport(infisical.GetSecretImportRequest{
+	_, err := r.client.GetSecretImport(ctx, infisical.GetSecretImportRequest{
 		ID:          state.ID.ValueString(),
 		ProjectID:   state.ProjectID.ValueString(),
 		Environment: state.EnvironmentSlug.ValueString(),
@@ -183,14 +211,13 @@ func (r *projectSecretImportResource) Read(ctx context.Context, req resource.Rea
 	})
 
 	if err != nil {
-		if errors.As(err, &notFoundErr) {
-			resp.State.RemoveResource(ctx)
-		} else {
-			resp.Diagnostics.AddError(
-				"Error fetching secret imports from your project",
-				"Couldn't read project secret import from Infisical, unexpected error: "+err.Error(),
-			)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
+			return
 		}
+		resp.Diagnostics.AddError(
+			"Error fetching secret imports from your project",
+			"Couldn't read project secret import from Infisical, unexpected error: "+err.Error(),
+		)
 		return
 	}
 
@@ -219,7 +246,7 @@ func (r *projectSecretImportResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
-	_, err := r.client.UpdateSecretImport(infisical.UpdateSecretImportRequest{
+	_, err := r.client.UpdateSecretImport(ctx, infisical.UpdateSecretImportRequest{
 		ProjectID:   plan.ProjectID.ValueString(),
 		ID:          plan.ID.ValueString(),
 		Environment: plan.EnvironmentSlug.ValueString(),
@@ -264,7 +291,7 @@ func (r *projectSecretImportResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
-	_, err := r.client.DeleteSecretImport(infisical.DeleteSecretImportRequest{
+	_, err := r.client.DeleteSecretImport(ctx, infisical.DeleteSecretImportRequest{
 		ID:          state.ID.ValueString(),
 		ProjectID:   state.ProjectID.ValueString(),
 		Environment: state.EnvironmentSlug.ValueString(),
@@ -286,12 +313,12 @@ func (r *projectSecretImportResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *projectSecretImportResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	secretImport, err := r.client.GetSecretImportByID(infisical.GetSecretImportByIDRequest{
+	secretImport, err := r.client.GetSecretImportByID(ctx, infisical.GetSecretImportByIDRequest{
 		ID: req.ID,
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
+		if errors.Is(err, infisical.ErrNotFound) {
 			resp.Diagnostics.AddError(
 				"Secret Import not found",
 				"The secret import with the given ID was not found",
@@ -2,6 +2,7 @@ package resource
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	infisical "terraform-provider-infisical/internal/client"
@@ -111,7 +112,7 @@ func (r *projectSecretTagResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
-	newProjectSecretTag, err := r.client.CreateProjectTag(infisical.CreateProjectTagRequest{
+	newProjectSecretTag, err := r.client.CreateProjectTag(ctx, infisical.CreateProjectTagRequest{
 		ProjectID: plan.ProjectID.ValueString(),
 		Slug:      plan.Slug.ValueString(),
 		Name:      plan.Name.ValueString(),
@@ -155,22 +156,20 @@ func (r *projectSecretTagResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	// Get the latest data from the API
-	secretTag, err := r.client.GetProjectTagByID(infisical.GetProjectTagByIDRequest{
+	secretTag, err := r.client.GetProjectTagByID(ctx, infisical.GetProjectTagByIDRequest{
 		ProjectID: state.ProjectID.ValueString(),
 		TagID:     state.ID.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
-			return
-		} else {
-			resp.Diagnostics.AddError(
-				"Error reading project secret tag",
-				"Couldn't read project secret tag from Infisical, unexpected error: "+err.Error(),
-			)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
+		resp.Diagnostics.AddError(
+			"Error reading project secret tag",
+			"Couldn't read project secret tag from Infisical, unexpected error: "+err.Error(),
+		)
+		return
 	}
 
 	state.Color = types.StringValue(secretTag.Tag.Color)
@@ -208,7 +207,7 @@ func (r *projectSecretTagResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
-	_, err := r.client.UpdateProjectTag(infisical.UpdateProjectTagRequest{
+	_, err := r.client.UpdateProjectTag(ctx, infisical.UpdateProjectTagRequest{
 		ProjectID: plan.ProjectID.ValueString(),
 		Slug:      plan.Slug.ValueString(),
 		Name:      plan.Name.ValueString(),
@@ -255,12 +254,12 @@ func (r *projectSecretTagResource) ImportState(ctx context.Context, req resource
 	projectID := strings.TrimSpace(parts[0])
 	tagID := strings.TrimSpace(parts[1])
 
-	secretTag, err := r.client.GetProjectTagByID(infisical.GetProjectTagByIDRequest{
+	secretTag, err := r.client.GetProjectTagByID(ctx, infisical.GetProjectTagByIDRequest{
 		ProjectID: projectID,
 		TagID:     tagID,
 	})
 	if err != nil {
-		if err == infisical.ErrNotFound {
+		if errors.Is(err, infisical.ErrNotFound) {
 			resp.Diagnostics.AddError(
 				"Secret tag not found",
 				fmt.Sprintf("No secret tag with ID %q was found in project %q", tagID, projectID),
@@ -299,7 +298,7 @@ func (r *projectSecretTagResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
-	_, err := r.client.DeleteProjectTag(infisical.DeleteProjectTagRequest{
+	_, err := r.client.DeleteProjectTag(ctx, infisical.DeleteProjectTagRequest{
 		ProjectID: state.ProjectID.ValueString(),
 		TagID:     state.ID.ValueString(),
 	})
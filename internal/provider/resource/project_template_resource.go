@@ -3,6 +3,7 @@ package resource
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	infisical "terraform-provider-infisical/internal/client"
 	pkg "terraform-provider-infisical/internal/pkg/modifiers"
@@ -277,7 +278,7 @@ func (r *ProjectTemplateResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	res, err := r.client.CreateProjectTemplate(infisical.CreateProjectTemplateRequest{
+	res, err := r.client.CreateProjectTemplate(ctx, infisical.CreateProjectTemplateRequest{
 		Name:         plan.Name.ValueString(),
 		Description:  plan.Description.ValueString(),
 		Type:         plan.Type.ValueString(),
@@ -354,11 +355,10 @@ func (r *ProjectTemplateResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	// Fetch the project template from Infisical
-	template, err := r.client.GetProjectTemplateById(plan.ID.ValueString())
+	template, err := r.client.GetProjectTemplateById(ctx, plan.ID.ValueString())
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
 		resp.Diagnostics.AddError(
@@ -469,7 +469,7 @@ func (r *ProjectTemplateResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	apiResp, err := r.client.UpdateProjectTemplate(infisical.UpdateProjectTemplateRequest{
+	apiResp, err := r.client.UpdateProjectTemplate(ctx, infisical.UpdateProjectTemplateRequest{
 		ID:           plan.ID.ValueString(),
 		Name:         plan.Name.ValueString(),
 		Description:  plan.Description.ValueString(),
@@ -534,10 +534,10 @@ func (r *ProjectTemplateResource) Delete(ctx context.Context, req resource.Delet
 	}
 
 	// Call the Infisical API to delete the project template
-	_, err := r.client.DeleteProjectTemplate(state.ID.ValueString())
+	_, err := r.client.DeleteProjectTemplate(ctx, state.ID.ValueString())
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
+		if errors.Is(err, infisical.ErrNotFound) {
 			return
 		}
 
@@ -2,10 +2,12 @@ package resource
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 	"strings"
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 	"time"
 
 	"github.com/hashicorp/go-uuid"
@@ -323,7 +325,7 @@ func (r *ProjectUserResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	invitedUser, err := r.client.InviteUsersToProject(infisical.InviteUsersToProjectRequest{
+	invitedUser, err := r.client.InviteUsersToProject(ctx, infisical.InviteUsersToProjectRequest{
 		ProjectID: plan.ProjectID.ValueString(),
 		Usernames: []string{plan.Username.ValueString()},
 	})
@@ -337,7 +339,7 @@ func (r *ProjectUserResource) Create(ctx context.Context, req resource.CreateReq
 
 	var membershipId string
 	if len(invitedUser) == 0 {
-		projectMember, err := r.client.GetProjectUserByUsername(infisical.GetProjectUserByUserNameRequest{
+		projectMember, err := r.client.GetProjectUserByUsername(ctx, infisical.GetProjectUserByUserNameRequest{
 			ProjectID: plan.ProjectID.ValueString(),
 			Username:  plan.Username.ValueString(),
 		})
@@ -355,7 +357,7 @@ func (r *ProjectUserResource) Create(ctx context.Context, req resource.CreateReq
 		membershipId = invitedUser[0].ID
 	}
 
-	_, err = r.client.UpdateProjectUser(infisical.UpdateProjectUserRequest{
+	_, err = r.client.UpdateProjectUser(ctx, infisical.UpdateProjectUserRequest{
 		ProjectID:    plan.ProjectID.ValueString(),
 		MembershipID: membershipId,
 		Roles:        roles,
@@ -368,7 +370,7 @@ func (r *ProjectUserResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	projectUserDetails, err := r.client.GetProjectUserByUsername(infisical.GetProjectUserByUserNameRequest{
+	projectUserDetails, err := r.client.GetProjectUserByUsername(ctx, infisical.GetProjectUserByUserNameRequest{
 		ProjectID: plan.ProjectID.ValueString(),
 		Username:  plan.Username.ValueString(),
 	})
@@ -419,14 +421,13 @@ func (r *ProjectUserResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	projectUserDetails, err := r.client.GetProjectUserByUsername(infisical.GetProjectUserByUserNameRequest{
+	projectUserDetails, err := r.client.GetProjectUserByUsername(ctx, infisical.GetProjectUserByUserNameRequest{
 		ProjectID: state.ProjectID.ValueString(),
 		Username:  state.Username.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
 
@@ -532,7 +533,7 @@ func (r *ProjectUserResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	_, err := r.client.UpdateProjectUser(infisical.UpdateProjectUserRequest{
+	_, err := r.client.UpdateProjectUser(ctx, infisical.UpdateProjectUserRequest{
 		ProjectID:    plan.ProjectID.ValueString(),
 		MembershipID: plan.MembershipId.ValueString(),
 		Roles:        roles,
@@ -545,7 +546,7 @@ func (r *ProjectUserResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	projectUserDetails, err := r.client.GetProjectUserByUsername(infisical.GetProjectUserByUserNameRequest{
+	projectUserDetails, err := r.client.GetProjectUserByUsername(ctx, infisical.GetProjectUserByUserNameRequest{
 		ProjectID: plan.ProjectID.ValueString(),
 		Username:  plan.Username.ValueString(),
 	})
@@ -589,7 +590,7 @@ func (r *ProjectUserResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	_, err := r.client.DeleteProjectUser(infisical.DeleteProjectUserRequest{
+	_, err := r.client.DeleteProjectUser(ctx, infisical.DeleteProjectUserRequest{
 		ProjectID: state.ProjectID.ValueString(),
 		Username:  []string{state.Username.ValueString()},
 	})
@@ -639,12 +640,12 @@ func (r *ProjectUserResource) ImportState(ctx context.Context, req resource.Impo
 		return
 	}
 
-	projectUserDetails, err := r.client.GetProjectMembershipByUserID(infisical.GetProjectMembershipByUserIDRequest{
+	projectUserDetails, err := r.client.GetProjectMembershipByUserID(ctx, infisical.GetProjectMembershipByUserIDRequest{
 		ProjectID: projectID,
 		UserID:    userID,
 	})
 	if err != nil {
-		if err == infisical.ErrNotFound {
+		if errors.Is(err, infisical.ErrNotFound) {
 			resp.Diagnostics.AddError(
 				"Project or user not found",
 				fmt.Sprintf(
@@ -0,0 +1,71 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	infisical "terraform-provider-infisical/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// providerDefault is an Optional+Computed attribute that falls back to a provider-level default.
+type providerDefault struct {
+	attribute         string
+	providerAttribute string
+	required          bool
+	get               func(infisical.Config) string
+}
+
+func defaultProjectId(config infisical.Config) string   { return config.DefaultProjectId }
+func defaultEnvironment(config infisical.Config) string { return config.DefaultEnvironment }
+func defaultFolderPath(config infisical.Config) string  { return config.DefaultFolderPath }
+
+// planProviderDefaults fills the unset attributes with the provider defaults, and replaces the
+// resource when a resolved default differs from the state. It runs from the resource's ModifyPlan,
+// because attribute plan modifiers are read from a schema instance that is never configured. The
+// attributes keep UseStateForUnknown and RequiresReplace, so that an unset attribute is only
+// replaced when its default has changed.
+func planProviderDefaults(ctx context.Context, client *infisical.Client, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, defaults ...providerDefault) {
+	// nothing to resolve on destroy, or before the provider configuration is known
+	if req.Plan.Raw.IsNull() || client == nil {
+		return
+	}
+
+	for _, d := range defaults {
+		attributePath := path.Root(d.attribute)
+
+		var configured types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, attributePath, &configured)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !configured.IsNull() {
+			continue
+		}
+
+		defaultValue := d.get(client.Config)
+		if defaultValue == "" {
+			if d.required {
+				resp.Diagnostics.AddAttributeError(
+					attributePath,
+					"Missing required attribute",
+					fmt.Sprintf("The attribute %s must be set, either on the resource or through %s in the provider configuration.", d.attribute, d.providerAttribute),
+				)
+			}
+			continue
+		}
+
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, attributePath, types.StringValue(defaultValue))...)
+		if req.State.Raw.IsNull() {
+			continue
+		}
+
+		var stored types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, attributePath, &stored)...)
+		if !stored.IsNull() && stored.ValueString() != defaultValue {
+			resp.RequiresReplace = append(resp.RequiresReplace, attributePath)
+		}
+	}
+}
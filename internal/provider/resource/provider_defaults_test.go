@@ -0,0 +1,147 @@
+package resource
+
+import (
+	"context"
+	infisical "terraform-provider-infisical/internal/client"
+	"terraform-provider-infisical/internal/provider/resource/resourcetest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestPlanFallsBackToTheProviderDefaults(t *testing.T) {
+	client := &infisical.Client{Config: infisical.Config{
+		IsMachineIdentityAuth: true,
+		DefaultProjectId:      "default-project",
+		DefaultEnvironment:    "dev",
+		DefaultFolderPath:     "/apps",
+	}}
+
+	cases := map[string]struct {
+		newResource func() resource.Resource
+		config      map[string]any
+		want        map[string]string
+	}{
+		"secret": {
+			newResource: NewSecretResource,
+			config:      map[string]any{"name": "DB_URL", "value": "postgres://"},
+			want:        map[string]string{"workspace_id": "default-project", "env_slug": "dev", "folder_path": "/apps"},
+		},
+		"secret with its own environment": {
+			newResource: NewSecretResource,
+			config:      map[string]any{"name": "DB_URL", "value": "postgres://", "env_slug": "prod"},
+			want:        map[string]string{"workspace_id": "default-project", "env_slug": "prod", "folder_path": "/apps"},
+		},
+		"secret folder": {
+			newResource: NewProjectSecretFolderResource,
+			config:      map[string]any{"name": "backend"},
+			want:        map[string]string{"project_id": "default-project", "environment_slug": "dev", "folder_path": "/apps"},
+		},
+		"secret import": {
+			newResource: NewProjectSecretImportResource,
+			config:      map[string]any{"import_environment_slug": "staging", "import_folder_path": "/"},
+			want:        map[string]string{"project_id": "default-project", "environment_slug": "dev", "folder_path": "/apps"},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			plan, resp := resourcetest.Plan(t, c.newResource, client, c.config, nil)
+			if len(resp.Diagnostics) > 0 {
+				t.Fatalf("unexpected diagnostics: %v", diagnosticSummaries(resp.Diagnostics))
+			}
+
+			for attribute, want := range c.want {
+				var got types.String
+				plan.GetAttribute(context.Background(), path.Root(attribute), &got)
+				if got.ValueString() != want {
+					t.Errorf("%s = %s, want %q", attribute, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestPlanRequiresAnAttributeWithoutAProviderDefault(t *testing.T) {
+	client := &infisical.Client{Config: infisical.Config{IsMachineIdentityAuth: true, DefaultProjectId: "default-project"}}
+
+	_, resp := resourcetest.Plan(t, NewProjectSecretFolderResource, client, map[string]any{"name": "backend", "folder_path": "/"}, nil)
+
+	if len(resp.Diagnostics) != 1 {
+		t.Fatalf("got diagnostics %v, want a single error", diagnosticSummaries(resp.Diagnostics))
+	}
+	diagnostic := resp.Diagnostics[0]
+	if diagnostic.Severity != tfprotov6.DiagnosticSeverityError || !diagnostic.Attribute.Equal(tftypes.NewAttributePath().WithAttributeName("environment_slug")) {
+		t.Errorf("got %s at %v, want an error at environment_slug", diagnostic.Summary, diagnostic.Attribute)
+	}
+}
+
+func TestPlanReplacesWhenTheProviderDefaultChanges(t *testing.T) {
+	prior := map[string]any{
+		"id":               "folder-id",
+		"name":             "backend",
+		"project_id":       "default-project",
+		"environment_slug": "dev",
+		"folder_path":      "/apps",
+		"environment_id":   "environment-id",
+		"path":             "/apps/backend",
+		"force_delete":     false,
+		"description":      "",
+	}
+	config := map[string]any{"name": "backend"}
+
+	cases := map[string]struct {
+		defaultEnvironment string
+		wantReplace        bool
+	}{
+		"unchanged default": {defaultEnvironment: "dev"},
+		"changed default":   {defaultEnvironment: "prod", wantReplace: true},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := &infisical.Client{Config: infisical.Config{
+				IsMachineIdentityAuth: true,
+				DefaultProjectId:      "default-project",
+				DefaultEnvironment:    c.defaultEnvironment,
+				DefaultFolderPath:     "/apps",
+			}}
+
+			plan, resp := resourcetest.Plan(t, NewProjectSecretFolderResource, client, config, prior)
+			if len(resp.Diagnostics) > 0 {
+				t.Fatalf("unexpected diagnostics: %v", diagnosticSummaries(resp.Diagnostics))
+			}
+
+			var environment types.String
+			plan.GetAttribute(context.Background(), path.Root("environment_slug"), &environment)
+			if environment.ValueString() != c.defaultEnvironment {
+				t.Errorf("environment_slug = %s, want %q", environment, c.defaultEnvironment)
+			}
+
+			wantReplace := []*tftypes.AttributePath{}
+			if c.wantReplace {
+				wantReplace = append(wantReplace, tftypes.NewAttributePath().WithAttributeName("environment_slug"))
+			}
+			if len(resp.RequiresReplace) != len(wantReplace) {
+				t.Fatalf("replaced on %v, want %v", resp.RequiresReplace, wantReplace)
+			}
+			for i := range wantReplace {
+				if !resp.RequiresReplace[i].Equal(wantReplace[i]) {
+					t.Errorf("replaced on %v, want %v", resp.RequiresReplace, wantReplace)
+				}
+			}
+		})
+	}
+}
+
+func diagnosticSummaries(diagnostics []*tfprotov6.Diagnostic) []string {
+	summaries := make([]string, 0, len(diagnostics))
+	for _, d := range diagnostics {
+		summaries = append(summaries, d.Summary+": "+d.Detail)
+	}
+	return summaries
+}
@@ -0,0 +1,197 @@
+// Package resourcetest drives resources against a stubbed Infisical API in unit tests.
+package resourcetest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	infisical "terraform-provider-infisical/internal/client"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Configure returns r configured with a machine identity client pointed at server.
+func Configure(t *testing.T, r resource.Resource, server *httptest.Server) resource.Resource {
+	t.Helper()
+
+	client := &infisical.Client{Config: infisical.Config{
+		HttpClient:            resty.New().SetBaseURL(server.URL),
+		IsMachineIdentityAuth: true,
+	}}
+
+	if configurable, ok := r.(resource.ResourceWithConfigure); ok {
+		var resp resource.ConfigureResponse
+		configurable.Configure(context.Background(), resource.ConfigureRequest{ProviderData: client}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("configuring the resource: %v", resp.Diagnostics)
+		}
+	}
+	return r
+}
+
+// State returns the state of r with the given attributes set and every other attribute null.
+// Attribute values are strings, numbers and booleans, or maps of them for object attributes.
+func State(t *testing.T, r resource.Resource, attributes map[string]any) tfsdk.State {
+	t.Helper()
+
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("building the schema: %v", schemaResp.Diagnostics)
+	}
+
+	return tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    value(schemaResp.Schema.Type().TerraformType(ctx), attributes),
+	}
+}
+
+func value(valueType tftypes.Type, v any) tftypes.Value {
+	objectType, isObject := valueType.(tftypes.Object)
+	attributes, hasAttributes := v.(map[string]any)
+	if !isObject || !hasAttributes {
+		return tftypes.NewValue(valueType, v)
+	}
+
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = value(attributeType, attributes[name])
+	}
+	return tftypes.NewValue(objectType, values)
+}
+
+// AssertReadRemovesWhenNotFound reads r, with the given attributes in state, against an API that
+// answers every request with a 404, and fails the test unless the resource is removed from state
+// without an error, so that Terraform plans to recreate it.
+func AssertReadRemovesWhenNotFound(t *testing.T, newResource func() resource.Resource, attributes map[string]any) {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"statusCode":404,"message":"Not found","error":"NotFound"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	r := Configure(t, newResource(), server)
+	state := State(t, r, attributes)
+	resp := resource.ReadResponse{State: state}
+	r.Read(context.Background(), resource.ReadRequest{State: state}, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Error("the resource was kept in state, want it removed")
+	}
+}
+
+// Plan plans the resource returned by newResource through the provider server, the way Terraform
+// does, with the provider configured with client. prior is nil when the resource is created. The
+// proposed new state is the config, with the computed attributes it leaves unset taken from prior.
+func Plan(t *testing.T, newResource func() resource.Resource, client *infisical.Client, config, prior map[string]any) (tfsdk.Plan, *tfprotov6.PlanResourceChangeResponse) {
+	t.Helper()
+
+	ctx := context.Background()
+	r := newResource()
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	var metadataResp resource.MetadataResponse
+	r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "infisical"}, &metadataResp)
+
+	server, err := providerserver.NewProtocol6WithError(&testProvider{client: client, newResource: newResource})()
+	if err != nil {
+		t.Fatalf("starting the provider server: %v", err)
+	}
+
+	providerConfig := dynamicValue(t, tftypes.Object{AttributeTypes: map[string]tftypes.Type{}}, map[string]any{})
+	configureResp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{Config: &providerConfig})
+	if err != nil || len(configureResp.Diagnostics) > 0 {
+		t.Fatalf("configuring the provider: %v %v", err, configureResp.Diagnostics)
+	}
+
+	proposed := make(map[string]any, len(schemaResp.Schema.Attributes))
+	for name, attribute := range schemaResp.Schema.Attributes {
+		if v, ok := config[name]; ok {
+			proposed[name] = v
+		} else if attribute.IsComputed() {
+			proposed[name] = prior[name]
+		}
+	}
+
+	schemaType := schemaResp.Schema.Type().TerraformType(ctx)
+	configValue := dynamicValue(t, schemaType, config)
+	proposedValue := dynamicValue(t, schemaType, proposed)
+	priorValue, err := tfprotov6.NewDynamicValue(schemaType, tftypes.NewValue(schemaType, nil))
+	if err != nil {
+		t.Fatalf("encoding the prior state: %v", err)
+	}
+	if prior != nil {
+		priorValue = dynamicValue(t, schemaType, prior)
+	}
+
+	resp, err := server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         metadataResp.TypeName,
+		PriorState:       &priorValue,
+		ProposedNewState: &proposedValue,
+		Config:           &configValue,
+	})
+	if err != nil {
+		t.Fatalf("planning the resource: %v", err)
+	}
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaType, nil)}
+	if resp.PlannedState != nil {
+		plan.Raw, err = resp.PlannedState.Unmarshal(schemaType)
+		if err != nil {
+			t.Fatalf("decoding the planned state: %v", err)
+		}
+	}
+	return plan, resp
+}
+
+func dynamicValue(t *testing.T, valueType tftypes.Type, attributes map[string]any) tfprotov6.DynamicValue {
+	t.Helper()
+
+	dynamic, err := tfprotov6.NewDynamicValue(valueType, value(valueType, attributes))
+	if err != nil {
+		t.Fatalf("encoding %v: %v", attributes, err)
+	}
+	return dynamic
+}
+
+// testProvider serves a single resource, and hands it client when the provider is configured.
+type testProvider struct {
+	client      *infisical.Client
+	newResource func() resource.Resource
+}
+
+func (p *testProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "infisical"
+}
+
+func (p *testProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = providerschema.Schema{}
+}
+
+func (p *testProvider) Configure(_ context.Context, _ provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	resp.ResourceData = p.client
+}
+
+func (p *testProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *testProvider) Resources(_ context.Context) []func() resource.Resource {
+	return []func() resource.Resource{p.newResource}
+}
@@ -257,7 +257,7 @@ func (r *secretApprovalPolicyResource) Create(ctx context.Context, req resource.
 		environments = []string{}
 	}
 
-	secretApprovalPolicy, err := r.client.CreateSecretApprovalPolicy(infisical.CreateSecretApprovalPolicyRequest{
+	secretApprovalPolicy, err := r.client.CreateSecretApprovalPolicy(ctx, infisical.CreateSecretApprovalPolicyRequest{
 		Name:                 plan.Name.ValueString(),
 		ProjectID:            plan.ProjectID.ValueString(),
 		Environments:         environments,
@@ -306,21 +306,19 @@ func (r *secretApprovalPolicyResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
-	secretApprovalPolicy, err := r.client.GetSecretApprovalPolicyByID(infisical.GetSecretApprovalPolicyByIDRequest{
+	secretApprovalPolicy, err := r.client.GetSecretApprovalPolicyByID(ctx, infisical.GetSecretApprovalPolicyByIDRequest{
 		ID: state.ID.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
-			return
-		} else {
-			resp.Diagnostics.AddError(
-				"Error fetching secret approval policy from your project",
-				"Couldn't read secret approval policy from Infisical, unexpected error: "+err.Error(),
-			)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
+		resp.Diagnostics.AddError(
+			"Error fetching secret approval policy from your project",
+			"Couldn't read secret approval policy from Infisical, unexpected error: "+err.Error(),
+		)
+		return
 	}
 
 	if secretApprovalPolicy.SecretApprovalPolicy.DeletedAt != nil {
@@ -484,7 +482,7 @@ func (r *secretApprovalPolicyResource) Update(ctx context.Context, req resource.
 	} else {
 		environments = []string{plan.EnvironmentSlug.ValueString()}
 	}
-	_, err := r.client.UpdateSecretApprovalPolicy(infisical.UpdateSecretApprovalPolicyRequest{
+	_, err := r.client.UpdateSecretApprovalPolicy(ctx, infisical.UpdateSecretApprovalPolicyRequest{
 		ID:                   plan.ID.ValueString(),
 		Name:                 plan.Name.ValueString(),
 		SecretPath:           plan.SecretPath.ValueString(),
@@ -528,7 +526,7 @@ func (r *secretApprovalPolicyResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
-	_, err := r.client.DeleteSecretApprovalPolicy(infisical.DeleteSecretApprovalPolicyRequest{
+	_, err := r.client.DeleteSecretApprovalPolicy(ctx, infisical.DeleteSecretApprovalPolicyRequest{
 		ID: state.ID.ValueString(),
 	})
 
@@ -6,7 +6,10 @@ import (
 	"fmt"
 	"strings"
 	infisical "terraform-provider-infisical/internal/client"
+	"terraform-provider-infisical/internal/crypto"
+	modifiers "terraform-provider-infisical/internal/pkg/modifiers"
 	pkg "terraform-provider-infisical/internal/pkg/strings"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
@@ -24,7 +27,8 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource = &secretResource{}
+	_ resource.Resource               = &secretResource{}
+	_ resource.ResourceWithModifyPlan = &secretResource{}
 )
 
 // NewsecretResource is a helper function to simplify the provider implementation.
@@ -51,6 +55,8 @@ type secretResourceModel struct {
 	Value          types.String    `tfsdk:"value"`
 	ValueWO        types.String    `tfsdk:"value_wo"`
 	ValueWOVersion types.Int64     `tfsdk:"value_wo_version"`
+	ValueWOHash    types.String    `tfsdk:"value_wo_hash"`
+	DriftDetection types.String    `tfsdk:"drift_detection"`
 	WorkspaceId    types.String    `tfsdk:"workspace_id"`
 	LastUpdated    types.String    `tfsdk:"last_updated"`
 	Tags           types.Set       `tfsdk:"tag_ids"`
@@ -128,16 +134,22 @@ func (r *secretResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 		Description: "Create secrets & save to Infisical",
 		Attributes: map[string]schema.Attribute{
 			"folder_path": schema.StringAttribute{
-				Description:   "The path to the folder where the given secret resides",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
-				Required:      true,
-				Computed:      false,
+				Description: "The path to the folder where the given secret resides. Defaults to the provider's `default_folder_path`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Optional: true,
+				Computed: true,
 			},
 			"env_slug": schema.StringAttribute{
-				Description:   "The environment slug of the secret to modify/create",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
-				Required:      true,
-				Computed:      false,
+				Description: "The environment slug of the secret to modify/create. Defaults to the provider's `default_environment`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Optional: true,
+				Computed: true,
 			},
 			"name": schema.StringAttribute{
 				Description: "The name of the secret",
@@ -176,11 +188,31 @@ func (r *secretResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 					}...),
 				},
 			},
+			"value_wo_hash": schema.StringAttribute{
+				Description: "A salted hash of the write-only value, recorded at apply time and used to detect changes made outside of Terraform.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					// the value is only written, and hashed again, when its version changes
+					modifiers.UnknownWhenChangedModifier{Attributes: []path.Path{
+						path.Root("value"),
+						path.Root("value_wo_version"),
+					}},
+				},
+			},
+			"drift_detection": schema.StringAttribute{
+				Description: infisicaltf.DriftDetectionDescription("write-only secret value", infisicaltf.DriftDetectionWarn),
+				Optional:    true,
+				Validators:  []validator.String{infisicaltf.DriftDetectionValidator},
+			},
 			"workspace_id": schema.StringAttribute{
-				Description:   "The Infisical project ID (Required for Machine Identity auth, and service tokens with multiple scopes)",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
-				Optional:      true,
-				Computed:      true,
+				Description: "The Infisical project ID (Required for Machine Identity auth, and service tokens with multiple scopes). Defaults to the provider's `default_project_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Optional: true,
+				Computed: true,
 			},
 
 			"last_updated": schema.StringAttribute{
@@ -246,6 +278,15 @@ func (r *secretResource) Configure(_ context.Context, req resource.ConfigureRequ
 	r.client = client
 }
 
+// ModifyPlan resolves the attributes that fall back to the provider defaults.
+func (r *secretResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planProviderDefaults(ctx, r.client, req, resp,
+		providerDefault{attribute: "folder_path", providerAttribute: "default_folder_path", required: true, get: defaultFolderPath},
+		providerDefault{attribute: "env_slug", providerAttribute: "default_environment", required: true, get: defaultEnvironment},
+		providerDefault{attribute: "workspace_id", providerAttribute: "default_project_id", required: false, get: defaultProjectId},
+	)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *secretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 
@@ -299,7 +340,7 @@ func (r *secretResource) Create(ctx context.Context, req resource.CreateRequest,
 	var workspaceId string
 
 	if r.client.Config.AuthStrategy == infisical.AuthStrategy.SERVICE_TOKEN {
-		serviceTokenDetails, err := r.client.GetServiceTokenDetailsV2()
+		serviceTokenDetails, err := r.client.GetServiceTokenDetailsV2(ctx)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error creating secret",
@@ -328,7 +369,7 @@ func (r *secretResource) Create(ctx context.Context, req resource.CreateRequest,
 		secretReminderRepeatDays = plan.SecretReminder.RepeatDays.ValueInt64()
 	}
 
-	secret, err := r.client.CreateRawSecretsV3(infisical.CreateRawSecretV3Request{
+	secret, err := r.client.CreateRawSecretsV3(ctx, infisical.CreateRawSecretV3Request{
 		Environment:              plan.EnvSlug.ValueString(),
 		WorkspaceID:              workspaceId,
 		Type:                     "shared",
@@ -354,6 +395,19 @@ func (r *secretResource) Create(ctx context.Context, req resource.CreateRequest,
 		plan.LastUpdated = types.StringValue(secret.UpdatedAt)
 	}
 
+	plan.ValueWOHash = types.StringNull()
+	if secretData.IsWriteOnly {
+		valueHash, err := crypto.HashSecretValue(secretData.Value)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating secret",
+				"Couldn't hash write-only secret value, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		plan.ValueWOHash = types.StringValue(valueHash)
+	}
+
 	if len(secret.SecretMetadata) > 0 {
 		metadataMap := make(map[string]types.String, len(secret.SecretMetadata))
 		for _, item := range secret.SecretMetadata {
@@ -388,19 +442,18 @@ func (r *secretResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	// Read by ID instead of name+path so folder renames don't cause a spurious deletion.
-	response, err := r.client.GetSingleSecretByIDV3(infisical.GetSingleSecretByIDV3Request{
+	response, err := r.client.GetSingleSecretByIDV3(ctx, infisical.GetSingleSecretByIDV3Request{
 		ID: state.ID.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
-		} else {
-			resp.Diagnostics.AddError(
-				"Error Reading Infisical secret",
-				"Could not read Infisical secret with ID "+state.ID.ValueString()+": "+err.Error(),
-			)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
+			return
 		}
+		resp.Diagnostics.AddError(
+			"Error Reading Infisical secret",
+			"Could not read Infisical secret with ID "+state.ID.ValueString()+": "+err.Error(),
+		)
 		return
 	}
 
@@ -414,6 +467,18 @@ func (r *secretResource) Read(ctx context.Context, req resource.ReadRequest, res
 	if !state.Value.IsNull() && !state.Value.IsUnknown() {
 		// Resource was configured with regular Value field
 		state.Value = types.StringValue(response.Secret.SecretValue)
+	} else if !state.ValueWOHash.IsNull() && !crypto.SecretValueMatchesHash(response.Secret.SecretValue, state.ValueWOHash.ValueString()) {
+		switch infisicaltf.ResolveDriftDetectionMode(state.DriftDetection, infisicaltf.DriftDetectionWarn) {
+		case infisicaltf.DriftDetectionWarn:
+			resp.Diagnostics.AddWarning(
+				"Secret value changed outside of Terraform",
+				fmt.Sprintf("The write-only value of secret %s (ID %s) no longer matches the value applied by Terraform. Increment value_wo_version to overwrite it, or set drift_detection to reconcile.", state.Name.ValueString(), state.ID.ValueString()),
+			)
+		case infisicaltf.DriftDetectionReconcile:
+			// Clearing the version makes the plan differ from the configuration, which triggers an
+			// update that writes the configured value back.
+			state.ValueWOVersion = types.Int64Null()
+		}
 	}
 
 	if len(response.Secret.Tags) > 0 {
@@ -526,7 +591,7 @@ func (r *secretResource) Update(ctx context.Context, req resource.UpdateRequest,
 	var workspaceId string
 	if r.client.Config.AuthStrategy == infisical.AuthStrategy.SERVICE_TOKEN {
 
-		serviceTokenDetails, err := r.client.GetServiceTokenDetailsV2()
+		serviceTokenDetails, err := r.client.GetServiceTokenDetailsV2(ctx)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error updating secret",
@@ -559,15 +624,30 @@ func (r *secretResource) Update(ctx context.Context, req resource.UpdateRequest,
 		SecretMetadata:           secretMetadata,
 	}
 
+	plan.ValueWOHash = types.StringNull()
 	if secretData.ShouldUpdateValue {
 		updateRequest.SecretValue = pkg.StringToPtr(secretData.Value)
+
+		if secretData.IsWriteOnly {
+			valueHash, err := crypto.HashSecretValue(secretData.Value)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error updating secret",
+					"Couldn't hash write-only secret value, unexpected error: "+err.Error(),
+				)
+				return
+			}
+			plan.ValueWOHash = types.StringValue(valueHash)
+		}
+	} else if secretData.IsWriteOnly {
+		plan.ValueWOHash = state.ValueWOHash
 	}
 
 	if plan.Name.ValueString() != state.Name.ValueString() {
 		updateRequest.NewSecretName = plan.Name.ValueString()
 	}
 
-	updatedSecret, err := r.client.UpdateRawSecretV3(updateRequest)
+	updatedSecret, err := r.client.UpdateRawSecretV3(ctx, updateRequest)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -614,7 +694,7 @@ func (r *secretResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 	if r.client.Config.IsMachineIdentityAuth || r.client.Config.AuthStrategy == infisical.AuthStrategy.SERVICE_TOKEN {
-		err := r.client.DeleteRawSecretV3(infisical.DeleteRawSecretV3Request{
+		err := r.client.DeleteRawSecretV3(ctx, infisical.DeleteRawSecretV3Request{
 			SecretName:  state.Name.ValueString(),
 			SecretPath:  state.FolderPath.ValueString(),
 			Environment: state.EnvSlug.ValueString(),
@@ -663,12 +743,12 @@ func (r *secretResource) ImportState(ctx context.Context, req resource.ImportSta
 	}
 
 	if _, err := uuid.ParseUUID(importID); err == nil {
-		secret, err := r.client.GetSingleSecretByIDV3(infisical.GetSingleSecretByIDV3Request{
+		secret, err := r.client.GetSingleSecretByIDV3(ctx, infisical.GetSingleSecretByIDV3Request{
 			ID: importID,
 		})
 
 		if err != nil {
-			if err == infisical.ErrNotFound {
+			if errors.Is(err, infisical.ErrNotFound) {
 				resp.Diagnostics.AddError(
 					"Secret not found",
 					"The secret with the given ID was not found",
@@ -709,7 +789,7 @@ func (r *secretResource) ImportState(ctx context.Context, req resource.ImportSta
 			return
 		}
 
-		secret, err := r.client.GetSingleRawSecretByNameV3(infisical.GetSingleSecretByNameV3Request{
+		secret, err := r.client.GetSingleRawSecretByNameV3(ctx, infisical.GetSingleSecretByNameV3Request{
 			WorkspaceId: parts[0],
 			Environment: parts[1],
 			SecretPath:  parts[2],
@@ -718,7 +798,7 @@ func (r *secretResource) ImportState(ctx context.Context, req resource.ImportSta
 		}, nil)
 
 		if err != nil {
-			if err == infisical.ErrNotFound {
+			if errors.Is(err, infisical.ErrNotFound) {
 				resp.Diagnostics.AddError(
 					"Secret not found",
 					"The secret with the given ID was not found",
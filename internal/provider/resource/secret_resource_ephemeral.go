@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -46,14 +48,14 @@ func (r *ephemeralSecretResource) Schema(_ context.Context, _ ephemeral.SchemaRe
 		Description: "Read ephemeral secrets from Infisical",
 		Attributes: map[string]schema.Attribute{
 			"folder_path": schema.StringAttribute{
-				Description: "The path to the folder where the given secret resides",
-				Required:    true,
-				Computed:    false,
+				Description: "The path to the folder where the given secret resides. Defaults to the provider's `default_folder_path`.",
+				Optional:    true,
+				Computed:    true,
 			},
 			"env_slug": schema.StringAttribute{
-				Description: "The environment slug of the secret to fetch",
-				Required:    true,
-				Computed:    false,
+				Description: "The environment slug of the secret to fetch. Defaults to the provider's `default_environment`.",
+				Optional:    true,
+				Computed:    true,
 			},
 			"name": schema.StringAttribute{
 				Description: "The name of the secret",
@@ -61,9 +63,9 @@ func (r *ephemeralSecretResource) Schema(_ context.Context, _ ephemeral.SchemaRe
 				Computed:    false,
 			},
 			"workspace_id": schema.StringAttribute{
-				Description: "The Infisical project ID",
-				Required:    true,
-				Computed:    false,
+				Description: "The Infisical project ID. Defaults to the provider's `default_project_id`.",
+				Optional:    true,
+				Computed:    true,
 			},
 			"value": schema.StringAttribute{
 				Description: "The value of the secret",
@@ -126,7 +128,14 @@ func (r *ephemeralSecretResource) Open(ctx context.Context, req ephemeral.OpenRe
 		return
 	}
 
-	res, err := r.client.GetSingleRawSecretByNameV3(infisical.GetSingleSecretByNameV3Request{
+	infisicaltf.ApplyProviderDefault(&config.FolderPath, r.client.Config.DefaultFolderPath, path.Root("folder_path"), "default_folder_path", &resp.Diagnostics)
+	infisicaltf.ApplyProviderDefault(&config.EnvSlug, r.client.Config.DefaultEnvironment, path.Root("env_slug"), "default_environment", &resp.Diagnostics)
+	infisicaltf.ApplyProviderDefault(&config.WorkspaceId, r.client.Config.DefaultProjectId, path.Root("workspace_id"), "default_project_id", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.GetSingleRawSecretByNameV3(ctx, infisical.GetSingleSecretByNameV3Request{
 		SecretName:  config.Name.ValueString(),
 		Type:        "shared",
 		WorkspaceId: config.WorkspaceId.ValueString(),
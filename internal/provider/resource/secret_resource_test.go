@@ -0,0 +1,159 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"terraform-provider-infisical/internal/crypto"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
+	"terraform-provider-infisical/internal/provider/resource/resourcetest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// readWriteOnlySecret reads a secret whose write-only value was applied as appliedValue and is
+// now currentValue in Infisical.
+func readWriteOnlySecret(t *testing.T, appliedValue, currentValue string, driftDetection any) (secretResourceModel, resource.ReadResponse) {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"secret":{"id":"secret-id","secretKey":"DB_URL","secretValue":%q,"secretPath":"/","environment":"dev"}}`, currentValue)
+	}))
+	t.Cleanup(server.Close)
+
+	hash, err := crypto.HashSecretValue(appliedValue)
+	if err != nil {
+		t.Fatalf("hashing: %v", err)
+	}
+
+	r := resourcetest.Configure(t, NewSecretResource(), server)
+	state := resourcetest.State(t, r, map[string]any{
+		"id":               "secret-id",
+		"name":             "DB_URL",
+		"value_wo_version": int64(1),
+		"value_wo_hash":    hash,
+		"drift_detection":  driftDetection,
+	})
+
+	resp := resource.ReadResponse{State: state}
+	r.Read(context.Background(), resource.ReadRequest{State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var model secretResourceModel
+	if diags := resp.State.Get(context.Background(), &model); diags.HasError() {
+		t.Fatalf("reading the state: %v", diags)
+	}
+	return model, resp
+}
+
+func TestSecretReadDriftDetection(t *testing.T) {
+	cases := map[string]struct {
+		driftDetection any
+		currentValue   string
+		wantWarning    bool
+		wantVersion    types.Int64
+	}{
+		"unchanged value": {
+			currentValue: "applied",
+			wantVersion:  types.Int64Value(1),
+		},
+		"warn by default": {
+			currentValue: "changed",
+			wantWarning:  true,
+			wantVersion:  types.Int64Value(1),
+		},
+		"warn": {
+			driftDetection: infisicaltf.DriftDetectionWarn,
+			currentValue:   "changed",
+			wantWarning:    true,
+			wantVersion:    types.Int64Value(1),
+		},
+		"reconcile": {
+			driftDetection: infisicaltf.DriftDetectionReconcile,
+			currentValue:   "changed",
+			wantVersion:    types.Int64Null(),
+		},
+		"ignore": {
+			driftDetection: infisicaltf.DriftDetectionIgnore,
+			currentValue:   "changed",
+			wantVersion:    types.Int64Value(1),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			model, resp := readWriteOnlySecret(t, "applied", c.currentValue, c.driftDetection)
+
+			if got := resp.Diagnostics.WarningsCount() > 0; got != c.wantWarning {
+				t.Errorf("warned = %t, want %t: %v", got, c.wantWarning, resp.Diagnostics)
+			}
+			if !model.ValueWOVersion.Equal(c.wantVersion) {
+				t.Errorf("got value_wo_version %s, want %s", model.ValueWOVersion, c.wantVersion)
+			}
+			if model.ValueWOHash.IsNull() {
+				t.Error("the recorded hash was dropped from state")
+			}
+		})
+	}
+}
+
+func TestSecretValueWOHashPlan(t *testing.T) {
+	r := NewSecretResource()
+	state := resourcetest.State(t, r, map[string]any{
+		"id":               "secret-id",
+		"value_wo_version": int64(1),
+		"value_wo_hash":    "salt:digest",
+	})
+
+	cases := map[string]struct {
+		version  int64
+		wantHash types.String
+	}{
+		"same version keeps the hash":    {version: 1, wantHash: types.StringValue("salt:digest")},
+		"new version rehashes the value": {version: 2, wantHash: types.StringUnknown()},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			planned := resourcetest.State(t, r, map[string]any{
+				"id":               "secret-id",
+				"value_wo_version": c.version,
+			})
+			plan := tfsdk.Plan{Schema: planned.Schema, Raw: planned.Raw}
+
+			req := planmodifier.StringRequest{
+				Path:        path.Root("value_wo_hash"),
+				Plan:        plan,
+				PlanValue:   types.StringUnknown(),
+				State:       state,
+				StateValue:  types.StringValue("salt:digest"),
+				ConfigValue: types.StringNull(),
+			}
+			resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+
+			var secretSchema resource.SchemaResponse
+			r.Schema(context.Background(), resource.SchemaRequest{}, &secretSchema)
+			for _, modifier := range secretSchema.Schema.Attributes["value_wo_hash"].(schema.StringAttribute).PlanModifiers {
+				req.PlanValue = resp.PlanValue
+				modifier.PlanModifyString(context.Background(), req, resp)
+			}
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if !resp.PlanValue.Equal(c.wantHash) {
+				t.Errorf("planned value_wo_hash %s, want %s", resp.PlanValue, c.wantHash)
+			}
+		})
+	}
+}
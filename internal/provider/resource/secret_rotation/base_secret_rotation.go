@@ -4,9 +4,13 @@ import (
 	"context"
 	"fmt"
 	infisical "terraform-provider-infisical/internal/client"
+	modifiers "terraform-provider-infisical/internal/pkg/modifiers"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -60,6 +64,39 @@ type SecretRotationBaseResourceModel struct {
 	Parameters          types.Object `tfsdk:"parameters"`
 	SecretsMapping      types.Object `tfsdk:"secrets_mapping"`
 	TemporaryParameters types.Object `tfsdk:"temporary_parameters"`
+
+	RotateTrigger   types.String `tfsdk:"rotate_trigger"`
+	WaitForRotation types.Bool   `tfsdk:"wait_for_rotation"`
+
+	LastRotatedAt       types.String `tfsdk:"last_rotated_at"`
+	NextRotationAt      types.String `tfsdk:"next_rotation_at"`
+	RotationStatus      types.String `tfsdk:"rotation_status"`
+	LastRotationMessage types.String `tfsdk:"last_rotation_message"`
+
+	Timeouts *infisicaltf.Timeouts `tfsdk:"timeouts"`
+}
+
+// rotationPollInterval is how often the status of a rotation is checked while waiting for it.
+const rotationPollInterval = 2 * time.Second
+
+// apiFields maps the fields of the secret rotation payload to the resource's attributes.
+func (r *SecretRotationBaseResource) apiFields() infisicaltf.APIFields {
+	return infisicaltf.APIFields{
+		"name":                  path.Root("name"),
+		"description":           path.Root("description"),
+		"isAutoRotationEnabled": path.Root("auto_rotation_enabled"),
+		"projectId":             path.Root("project_id"),
+		"connectionId":          path.Root("connection_id"),
+		"environment":           path.Root("environment"),
+		"secretPath":            path.Root("secret_path"),
+		"rotationInterval":      path.Root("rotation_interval"),
+		"rotateAtUtc":           path.Root("rotate_at_utc"),
+		"rotateAtUtc.hours":     path.Root("rotate_at_utc").AtName("hours"),
+		"rotateAtUtc.minutes":   path.Root("rotate_at_utc").AtName("minutes"),
+	}.
+		WithObject("parameters", path.Root("parameters"), r.ParametersAttributes).
+		WithObject("secretsMapping", path.Root("secrets_mapping"), r.SecretsMappingAttributes).
+		WithObject("temporaryParameters", path.Root("temporary_parameters"), r.TemporaryParametersAttributes)
 }
 
 // Metadata returns the resource type name.
@@ -159,6 +196,59 @@ func (r *SecretRotationBaseResource) Schema(_ context.Context, _ resource.Schema
 				Description: "Temporary parameters to modify how secrets are rotated.",
 				Attributes:  r.TemporaryParametersAttributes,
 			},
+
+			"rotate_trigger": schema.StringAttribute{
+				Optional:    true,
+				Description: "Any value, such as a timestamp. Changing it to a new non-empty value rotates the secrets immediately, regardless of the schedule, e.g. after an incident. The secrets are already rotated on creation, so the value set when the secret rotation is created doesn't trigger a rotation.",
+			},
+			"wait_for_rotation": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether a rotation requested through rotate_trigger waits until the new secrets are active, failing the apply when the rotation fails.",
+				Default:     booldefault.StaticBool(true),
+			},
+
+			"last_rotated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the secrets were last rotated successfully, in RFC 3339 format.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					modifiers.UnknownWhenChangedModifier{Attributes: []path.Path{path.Root("rotate_trigger")}},
+				},
+			},
+			"next_rotation_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the secrets are next rotated, in RFC 3339 format. Null when auto rotation is disabled.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					// the schedule, as well as a rotation, moves the next rotation
+					modifiers.UnknownWhenChangedModifier{Attributes: []path.Path{
+						path.Root("rotate_trigger"),
+						path.Root("auto_rotation_enabled"),
+						path.Root("rotation_interval"),
+						path.Root("rotate_at_utc"),
+					}},
+				},
+			},
+			"rotation_status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the last rotation attempt, either `success` or `failed`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					modifiers.UnknownWhenChangedModifier{Attributes: []path.Path{path.Root("rotate_trigger")}},
+				},
+			},
+			"last_rotation_message": schema.StringAttribute{
+				Computed:    true,
+				Description: "The message of the last rotation attempt, describing why it failed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					modifiers.UnknownWhenChangedModifier{Attributes: []path.Path{path.Root("rotate_trigger")}},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": infisicaltf.TimeoutsBlock(),
 		},
 	}
 }
@@ -201,6 +291,9 @@ func (r *SecretRotationBaseResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	ctx, cancel := plan.Timeouts.CreateContext(ctx, infisicaltf.DefaultTimeout)
+	defer cancel()
+
 	parameters, diags := r.ReadParametersFromPlan(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -228,7 +321,7 @@ func (r *SecretRotationBaseResource) Create(ctx context.Context, req resource.Cr
 		temporaryParameters = nil
 	}
 
-	secretRotation, err := r.client.CreateSecretRotation(infisical.CreateSecretRotationRequest{
+	secretRotation, err := r.client.CreateSecretRotation(ctx, infisical.CreateSecretRotationRequest{
 		Provider:            r.Provider,
 		Name:                plan.Name.ValueString(),
 		Description:         plan.Description.ValueString(),
@@ -250,14 +343,12 @@ func (r *SecretRotationBaseResource) Create(ctx context.Context, req resource.Cr
 	})
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating secret rotation",
-			"Couldn't create secret rotation, unexpected error: "+err.Error(),
-		)
+		infisicaltf.AddAPIError(&resp.Diagnostics, "Error creating secret rotation", "Couldn't create secret rotation, unexpected error: ", err, r.apiFields())
 		return
 	}
 
 	plan.ID = types.StringValue(secretRotation.ID)
+	setRotationStatus(&plan, secretRotation)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -284,22 +375,23 @@ func (r *SecretRotationBaseResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
-	secretRotation, err := r.client.GetSecretRotationById(infisical.GetSecretRotationByIdRequest{
+	ctx, cancel := state.Timeouts.ReadContext(ctx, infisicaltf.DefaultTimeout)
+	defer cancel()
+
+	secretRotation, err := r.client.GetSecretRotationById(ctx, infisical.GetSecretRotationByIdRequest{
 		Provider: r.Provider,
 		ID:       state.ID.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
-			return
-		} else {
-			resp.Diagnostics.AddError(
-				"Error reading secret rotation",
-				"Couldn't read secret rotation, unexpected error: "+err.Error(),
-			)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
+		resp.Diagnostics.AddError(
+			"Error reading secret rotation",
+			"Couldn't read secret rotation, unexpected error: "+err.Error(),
+		)
+		return
 	}
 
 	state.Name = types.StringValue(secretRotation.Name)
@@ -345,6 +437,14 @@ func (r *SecretRotationBaseResource) Read(ctx context.Context, req resource.Read
 		}
 	}
 
+	setRotationStatus(&state, secretRotation)
+
+	// State written before wait_for_rotation existed has no value for it; fill in its default so
+	// the next plan doesn't show an update
+	if state.WaitForRotation.IsNull() {
+		state.WaitForRotation = types.BoolValue(true)
+	}
+
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 }
@@ -366,6 +466,9 @@ func (r *SecretRotationBaseResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	ctx, cancel := plan.Timeouts.UpdateContext(ctx, infisicaltf.DefaultTimeout)
+	defer cancel()
+
 	var state SecretRotationBaseResourceModel
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -400,7 +503,7 @@ func (r *SecretRotationBaseResource) Update(ctx context.Context, req resource.Up
 		temporaryParameters = nil
 	}
 
-	_, err := r.client.UpdateSecretRotation(infisical.UpdateSecretRotationRequest{
+	secretRotation, err := r.client.UpdateSecretRotation(ctx, infisical.UpdateSecretRotationRequest{
 		Provider:            r.Provider,
 		ID:                  state.ID.ValueString(),
 		Name:                plan.Name.ValueString(),
@@ -422,13 +525,22 @@ func (r *SecretRotationBaseResource) Update(ctx context.Context, req resource.Up
 	})
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating secret rotation",
-			"Couldn't update secret rotation, unexpected error: "+err.Error(),
-		)
+		infisicaltf.AddAPIError(&resp.Diagnostics, "Error updating secret rotation", "Couldn't update secret rotation, unexpected error: ", err, r.apiFields())
 		return
 	}
 
+	if rotateRequested(plan, state) {
+		secretRotation, diags = r.rotate(ctx, state.ID.ValueString(), plan.WaitForRotation.ValueBool(), secretRotation)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			// the update itself was applied, but keeping the prior trigger makes the next apply
+			// request the rotation again
+			plan.RotateTrigger = state.RotateTrigger
+		}
+	}
+
+	setPlannedRotationStatus(&plan, secretRotation)
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -452,7 +564,10 @@ func (r *SecretRotationBaseResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
-	_, err := r.client.DeleteSecretRotation(infisical.DeleteSecretRotationRequest{
+	ctx, cancel := state.Timeouts.DeleteContext(ctx, infisicaltf.DefaultTimeout)
+	defer cancel()
+
+	_, err := r.client.DeleteSecretRotation(ctx, infisical.DeleteSecretRotationRequest{
 		Provider: r.Provider,
 		ID:       state.ID.ValueString(),
 	})
@@ -464,3 +579,122 @@ func (r *SecretRotationBaseResource) Delete(ctx context.Context, req resource.De
 		)
 	}
 }
+
+// rotate rotates the secrets now. When wait is set, it then waits until Infisical has attempted the
+// rotation, which previous was read before, and reports the rotation failing as an error.
+func (r *SecretRotationBaseResource) rotate(ctx context.Context, id string, wait bool, previous infisical.SecretRotation) (infisical.SecretRotation, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	secretRotation, err := r.client.RotateSecretRotation(ctx, infisical.RotateSecretRotationRequest{
+		Provider: r.Provider,
+		ID:       id,
+	})
+	if err != nil {
+		diags.AddError(
+			"Error rotating secrets",
+			"Couldn't rotate the secrets of the secret rotation, unexpected error: "+err.Error(),
+		)
+		return previous, diags
+	}
+
+	if !wait {
+		return secretRotation, diags
+	}
+
+	for !rotationAttemptedSince(secretRotation, previous.LastRotationAttemptedAt) {
+		select {
+		case <-ctx.Done():
+			diags.AddError(
+				"Secret rotation timeout",
+				"The secrets were not rotated before the operation timed out: "+ctx.Err().Error(),
+			)
+			return secretRotation, diags
+		case <-time.After(rotationPollInterval):
+		}
+
+		secretRotation, err = r.client.GetSecretRotationById(ctx, infisical.GetSecretRotationByIdRequest{
+			Provider: r.Provider,
+			ID:       id,
+		})
+		if err != nil {
+			diags.AddError(
+				"Error reading secret rotation",
+				"Couldn't read the secret rotation while waiting for the rotation, unexpected error: "+err.Error(),
+			)
+			return secretRotation, diags
+		}
+	}
+
+	if secretRotation.RotationStatus == infisical.SecretRotationStatusFailed {
+		message := "no reason was given"
+		if secretRotation.LastRotationMessage != nil && *secretRotation.LastRotationMessage != "" {
+			message = *secretRotation.LastRotationMessage
+		}
+		diags.AddError(
+			"Secret rotation failed",
+			"Infisical couldn't rotate the secrets: "+message,
+		)
+	}
+
+	return secretRotation, diags
+}
+
+// rotateRequested reports whether the plan requests a rotation, which is when rotate_trigger
+// changes to a new non-empty value.
+func rotateRequested(plan, state SecretRotationBaseResourceModel) bool {
+	return !plan.RotateTrigger.IsNull() && plan.RotateTrigger.ValueString() != "" && !plan.RotateTrigger.Equal(state.RotateTrigger)
+}
+
+// rotationAttemptedSince reports whether a rotation was attempted after previous, the last attempt
+// before the rotation was requested. Comparing against Infisical's own timestamp avoids clock skew.
+func rotationAttemptedSince(secretRotation infisical.SecretRotation, previous *time.Time) bool {
+	if secretRotation.LastRotationAttemptedAt == nil {
+		return false
+	}
+	return previous == nil || secretRotation.LastRotationAttemptedAt.After(*previous)
+}
+
+// setRotationStatus sets the computed attributes describing the last and next rotation.
+func setRotationStatus(model *SecretRotationBaseResourceModel, secretRotation infisical.SecretRotation) {
+	model.LastRotatedAt = timeValue(secretRotation.LastRotatedAt)
+	model.NextRotationAt = timeValue(secretRotation.NextRotationAt)
+
+	model.RotationStatus = types.StringNull()
+	if secretRotation.RotationStatus != "" {
+		model.RotationStatus = types.StringValue(secretRotation.RotationStatus)
+	}
+
+	model.LastRotationMessage = types.StringNull()
+	if secretRotation.LastRotationMessage != nil && *secretRotation.LastRotationMessage != "" {
+		model.LastRotationMessage = types.StringValue(*secretRotation.LastRotationMessage)
+	}
+}
+
+// setPlannedRotationStatus sets the rotation status attributes the plan left unknown. The others
+// were planned from state and are kept, since a scheduled rotation may have run since the plan was
+// made and the result of an apply has to match the known planned values; the next refresh reads
+// its outcome.
+func setPlannedRotationStatus(model *SecretRotationBaseResourceModel, secretRotation infisical.SecretRotation) {
+	var status SecretRotationBaseResourceModel
+	setRotationStatus(&status, secretRotation)
+
+	if model.LastRotatedAt.IsUnknown() {
+		model.LastRotatedAt = status.LastRotatedAt
+	}
+	if model.NextRotationAt.IsUnknown() {
+		model.NextRotationAt = status.NextRotationAt
+	}
+	if model.RotationStatus.IsUnknown() {
+		model.RotationStatus = status.RotationStatus
+	}
+	if model.LastRotationMessage.IsUnknown() {
+		model.LastRotationMessage = status.LastRotationMessage
+	}
+}
+
+func timeValue(t *time.Time) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(t.UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,170 @@
+package resource
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	infisical "terraform-provider-infisical/internal/client"
+	"terraform-provider-infisical/internal/provider/resource/resourcetest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestRotateRequested(t *testing.T) {
+	cases := map[string]struct {
+		prior, planned types.String
+		want           bool
+	}{
+		"unset":              {prior: types.StringNull(), planned: types.StringNull()},
+		"unchanged":          {prior: types.StringValue("2026-10-01"), planned: types.StringValue("2026-10-01")},
+		"set":                {prior: types.StringNull(), planned: types.StringValue("2026-10-19"), want: true},
+		"changed":            {prior: types.StringValue("2026-10-01"), planned: types.StringValue("2026-10-19"), want: true},
+		"changed to empty":   {prior: types.StringValue("2026-10-01"), planned: types.StringValue("")},
+		"removed":            {prior: types.StringValue("2026-10-01"), planned: types.StringNull()},
+		"empty to non-empty": {prior: types.StringValue(""), planned: types.StringValue("2026-10-19"), want: true},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			plan := SecretRotationBaseResourceModel{RotateTrigger: c.planned}
+			state := SecretRotationBaseResourceModel{RotateTrigger: c.prior}
+			if got := rotateRequested(plan, state); got != c.want {
+				t.Errorf("rotateRequested = %t, want %t", got, c.want)
+			}
+		})
+	}
+}
+
+func TestRotationAttemptedSince(t *testing.T) {
+	before := time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+	after := before.Add(time.Minute)
+
+	cases := map[string]struct {
+		attempted *time.Time
+		previous  *time.Time
+		want      bool
+	}{
+		"never attempted":               {previous: &before},
+		"first attempt":                 {attempted: &before, want: true},
+		"no attempt since the previous": {attempted: &before, previous: &before},
+		"attempted since the previous":  {attempted: &after, previous: &before, want: true},
+		"never attempted, nor before":   {},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			secretRotation := infisical.SecretRotation{LastRotationAttemptedAt: c.attempted}
+			if got := rotationAttemptedSince(secretRotation, c.previous); got != c.want {
+				t.Errorf("rotationAttemptedSince = %t, want %t", got, c.want)
+			}
+		})
+	}
+}
+
+// updateRotation applies a plan changing rotate_trigger from prior to planned against an API whose
+// rotations end with rotationStatus, and returns the resulting state and the number of rotations
+// requested.
+func updateRotation(t *testing.T, prior, planned, rotationStatus string) (resource.UpdateResponse, int) {
+	t.Helper()
+
+	rotations := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodPatch:
+			_, _ = w.Write([]byte(`{"secretRotation":{"id":"rotation-id","rotationStatus":"success","lastRotatedAt":"2026-10-19T08:00:00Z","lastRotationAttemptedAt":"2026-10-19T08:00:00Z"}}`))
+		case http.MethodPost:
+			rotations++
+			_, _ = w.Write([]byte(`{"secretRotation":{"id":"rotation-id","rotationStatus":"` + rotationStatus + `","lastRotatedAt":"2026-10-19T08:00:00Z","lastRotationAttemptedAt":"2026-10-19T09:00:00Z","lastRotationMessage":"access denied"}}`))
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	r := resourcetest.Configure(t, NewSecretRotationMySqlCredentialsResource(), server)
+	attributes := func(trigger string, status any) map[string]any {
+		return map[string]any{
+			"id":                    "rotation-id",
+			"name":                  "mysql",
+			"project_id":            "project-id",
+			"connection_id":         "connection-id",
+			"environment":           "dev",
+			"secret_path":           "/",
+			"auto_rotation_enabled": true,
+			"rotation_interval":     int64(30),
+			"rotate_at_utc":         map[string]any{"hours": int64(0), "minutes": int64(0)},
+			"parameters":            map[string]any{"username1": "app1", "username2": "app2"},
+			"secrets_mapping":       map[string]any{"username": "DB_USER", "password": "DB_PASSWORD"},
+			"rotate_trigger":        trigger,
+			"wait_for_rotation":     true,
+			"last_rotated_at":       status,
+			"next_rotation_at":      status,
+			"rotation_status":       status,
+			"last_rotation_message": status,
+		}
+	}
+
+	state := resourcetest.State(t, r, attributes(prior, nil))
+	plannedState := resourcetest.State(t, r, attributes(planned, tftypes.UnknownValue))
+	plan := tfsdk.Plan{Schema: plannedState.Schema, Raw: plannedState.Raw}
+
+	// like Terraform, start from the planned state
+	resp := resource.UpdateResponse{State: plannedState}
+	r.Update(context.Background(), resource.UpdateRequest{Plan: plan, State: state}, &resp)
+	return resp, rotations
+}
+
+func TestUpdateRotatesOnANewTrigger(t *testing.T) {
+	resp, rotations := updateRotation(t, "2026-10-01", "2026-10-19", infisical.SecretRotationStatusSuccess)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if rotations != 1 {
+		t.Errorf("requested %d rotations, want 1", rotations)
+	}
+
+	var trigger, status types.String
+	resp.State.GetAttribute(context.Background(), path.Root("rotate_trigger"), &trigger)
+	resp.State.GetAttribute(context.Background(), path.Root("rotation_status"), &status)
+	if trigger.ValueString() != "2026-10-19" || status.ValueString() != infisical.SecretRotationStatusSuccess {
+		t.Errorf("got rotate_trigger %s and rotation_status %s, want the new trigger and success", trigger, status)
+	}
+}
+
+func TestUpdateDoesNotRotateOnAnUnchangedTrigger(t *testing.T) {
+	resp, rotations := updateRotation(t, "2026-10-01", "2026-10-01", infisical.SecretRotationStatusSuccess)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if rotations != 0 {
+		t.Errorf("requested %d rotations, want none", rotations)
+	}
+}
+
+func TestUpdateKeepsThePriorTriggerOfAFailedRotation(t *testing.T) {
+	resp, rotations := updateRotation(t, "2026-10-01", "2026-10-19", infisical.SecretRotationStatusFailed)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected the failed rotation to fail the apply")
+	}
+	if rotations != 1 {
+		t.Errorf("requested %d rotations, want 1", rotations)
+	}
+
+	var trigger, status, message types.String
+	resp.State.GetAttribute(context.Background(), path.Root("rotate_trigger"), &trigger)
+	resp.State.GetAttribute(context.Background(), path.Root("rotation_status"), &status)
+	resp.State.GetAttribute(context.Background(), path.Root("last_rotation_message"), &message)
+	if trigger.ValueString() != "2026-10-01" {
+		t.Errorf("got rotate_trigger %s, want the prior trigger so the next apply retries", trigger)
+	}
+	if status.ValueString() != infisical.SecretRotationStatusFailed || message.ValueString() != "access denied" {
+		t.Errorf("got rotation_status %s and message %s, want the failure", status, message)
+	}
+}
@@ -0,0 +1,13 @@
+package resource
+
+import (
+	"terraform-provider-infisical/internal/provider/resource/resourcetest"
+	"testing"
+)
+
+func TestReadRemovesSecretRotationsDeletedOutsideOfTerraform(t *testing.T) {
+	resourcetest.AssertReadRemovesWhenNotFound(t, NewSecretRotationMySqlCredentialsResource, map[string]any{
+		"id":            "secret-rotation-id",
+		"rotate_at_utc": map[string]any{"hours": int64(0), "minutes": int64(0)},
+	})
+}
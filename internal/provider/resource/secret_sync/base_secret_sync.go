@@ -4,11 +4,14 @@ import (
 	"context"
 	"fmt"
 	infisical "terraform-provider-infisical/internal/client"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -44,6 +47,23 @@ type SecretSyncBaseResourceModel struct {
 	SyncOptions       types.Object `tfsdk:"sync_options"`
 	AutoSyncEnabled   types.Bool   `tfsdk:"auto_sync_enabled"`
 	DestinationConfig types.Object `tfsdk:"destination_config"`
+
+	Timeouts *infisicaltf.Timeouts `tfsdk:"timeouts"`
+}
+
+// apiFields maps the fields of the secret sync payload to the resource's attributes.
+func (r *SecretSyncBaseResource) apiFields() infisicaltf.APIFields {
+	return infisicaltf.APIFields{
+		"name":              path.Root("name"),
+		"description":       path.Root("description"),
+		"projectId":         path.Root("project_id"),
+		"connectionId":      path.Root("connection_id"),
+		"environment":       path.Root("environment"),
+		"secretPath":        path.Root("secret_path"),
+		"isAutoSyncEnabled": path.Root("auto_sync_enabled"),
+	}.
+		WithObject("syncOptions", path.Root("sync_options"), r.SyncOptionsAttributes).
+		WithObject("destinationConfig", path.Root("destination_config"), r.DestinationConfigAttributes)
 }
 
 // Metadata returns the resource type name.
@@ -102,6 +122,9 @@ func (r *SecretSyncBaseResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Attributes:  r.DestinationConfigAttributes,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": infisicaltf.TimeoutsBlock(),
+		},
 	}
 }
 
@@ -125,6 +148,66 @@ func (r *SecretSyncBaseResource) Configure(_ context.Context, req resource.Confi
 	r.client = client
 }
 
+// ValidateConfig reports an invalid destination config when planning rather than on apply,
+// whatever the authentication method. A destination config that is only invalid because some of
+// its values are not known yet is checked again on apply.
+func (r *SecretSyncBaseResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config SecretSyncBaseResourceModel
+	if diags := req.Config.Get(ctx, &config); diags.HasError() {
+		return
+	}
+
+	destinationConfig, err := config.DestinationConfig.ToTerraformValue(ctx)
+	if err != nil || destinationConfig.IsNull() || !destinationConfig.IsFullyKnown() {
+		return
+	}
+
+	var diags diag.Diagnostics
+	config.DestinationConfig, diags = r.withDestinationConfigDefaults(ctx, config.DestinationConfig)
+	if diags.HasError() {
+		return
+	}
+
+	_, diags = r.ReadDestinationConfigForCreateFromPlan(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// withDestinationConfigDefaults fills in the destination config attributes left out of the
+// configuration with their defaults, as the plan does.
+func (r *SecretSyncBaseResource) withDestinationConfigDefaults(ctx context.Context, destinationConfig types.Object) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	values := destinationConfig.Attributes()
+
+	for name, attribute := range r.DestinationConfigAttributes {
+		value, ok := values[name]
+		if !ok || !value.IsNull() {
+			continue
+		}
+
+		attributePath := path.Root("destination_config").AtName(name)
+		switch a := attribute.(type) {
+		case schema.StringAttribute:
+			if a.Default != nil {
+				var defaultResp defaults.StringResponse
+				a.Default.DefaultString(ctx, defaults.StringRequest{Path: attributePath}, &defaultResp)
+				diags.Append(defaultResp.Diagnostics...)
+				values[name] = defaultResp.PlanValue
+			}
+		case schema.BoolAttribute:
+			if a.Default != nil {
+				var defaultResp defaults.BoolResponse
+				a.Default.DefaultBool(ctx, defaults.BoolRequest{Path: attributePath}, &defaultResp)
+				diags.Append(defaultResp.Diagnostics...)
+				values[name] = defaultResp.PlanValue
+			}
+		}
+	}
+
+	withDefaults, objectDiags := types.ObjectValue(destinationConfig.AttributeTypes(ctx), values)
+	diags.Append(objectDiags...)
+	return withDefaults, diags
+}
+
 func (r *SecretSyncBaseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() || r.client == nil || !r.client.Config.IsMachineIdentityAuth {
 		return
@@ -174,7 +257,7 @@ func (r *SecretSyncBaseResource) ModifyPlan(ctx context.Context, req resource.Mo
 		ExcludeSyncId:     excludeSyncId,
 	}
 
-	duplicateCheck, err := r.client.CheckDuplicateDestination(checkRequest)
+	duplicateCheck, err := r.client.CheckDuplicateDestination(ctx, checkRequest)
 	if err != nil {
 		resp.Diagnostics.AddWarning(
 			"Unable to check for duplicate destinations",
@@ -234,6 +317,9 @@ func (r *SecretSyncBaseResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	ctx, cancel := plan.Timeouts.CreateContext(ctx, infisicaltf.DefaultTimeout)
+	defer cancel()
+
 	syncOptions, diags := r.ReadSyncOptionsForCreateFromPlan(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -272,7 +358,7 @@ func (r *SecretSyncBaseResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	secretSync, err := r.client.CreateSecretSync(infisical.CreateSecretSyncRequest{
+	secretSync, err := r.client.CreateSecretSync(ctx, infisical.CreateSecretSyncRequest{
 		App:               r.App,
 		Name:              plan.Name.ValueString(),
 		Description:       plan.Description.ValueString(),
@@ -286,10 +372,7 @@ func (r *SecretSyncBaseResource) Create(ctx context.Context, req resource.Create
 	})
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating secret sync",
-			"Couldn't create secret sync, unexpected error: "+err.Error(),
-		)
+		infisicaltf.AddAPIError(&resp.Diagnostics, "Error creating secret sync", "Couldn't create secret sync, unexpected error: ", err, r.apiFields())
 		return
 	}
 
@@ -320,22 +403,23 @@ func (r *SecretSyncBaseResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	secretSync, err := r.client.GetSecretSyncById(infisical.GetSecretSyncByIdRequest{
+	ctx, cancel := state.Timeouts.ReadContext(ctx, infisicaltf.DefaultTimeout)
+	defer cancel()
+
+	secretSync, err := r.client.GetSecretSyncById(ctx, infisical.GetSecretSyncByIdRequest{
 		App: r.App,
 		ID:  state.ID.ValueString(),
 	})
 
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
-			return
-		} else {
-			resp.Diagnostics.AddError(
-				"Error reading secret sync",
-				"Couldn't read secret sync, unexpected error: "+err.Error(),
-			)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
+		resp.Diagnostics.AddError(
+			"Error reading secret sync",
+			"Couldn't read secret sync, unexpected error: "+err.Error(),
+		)
+		return
 	}
 
 	state.ConnectionID = types.StringValue(secretSync.Connection.ConnectionID)
@@ -382,6 +466,9 @@ func (r *SecretSyncBaseResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	ctx, cancel := plan.Timeouts.UpdateContext(ctx, infisicaltf.DefaultTimeout)
+	defer cancel()
+
 	var state SecretSyncBaseResourceModel
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -427,7 +514,7 @@ func (r *SecretSyncBaseResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	_, err := r.client.UpdateSecretSync(infisical.UpdateSecretSyncRequest{
+	_, err := r.client.UpdateSecretSync(ctx, infisical.UpdateSecretSyncRequest{
 		App:               r.App,
 		ID:                state.ID.ValueString(),
 		Name:              plan.Name.ValueString(),
@@ -442,10 +529,7 @@ func (r *SecretSyncBaseResource) Update(ctx context.Context, req resource.Update
 	})
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating secret sync",
-			"Couldn't update secret sync, unexpected error: "+err.Error(),
-		)
+		infisicaltf.AddAPIError(&resp.Diagnostics, "Error updating secret sync", "Couldn't update secret sync, unexpected error: ", err, r.apiFields())
 		return
 	}
 
@@ -472,7 +556,10 @@ func (r *SecretSyncBaseResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
-	_, err := r.client.DeleteSecretSync(infisical.DeleteSecretSyncRequest{
+	ctx, cancel := state.Timeouts.DeleteContext(ctx, infisicaltf.DefaultTimeout)
+	defer cancel()
+
+	_, err := r.client.DeleteSecretSync(ctx, infisical.DeleteSecretSyncRequest{
 		App: r.App,
 		ID:  state.ID.ValueString(),
 	})
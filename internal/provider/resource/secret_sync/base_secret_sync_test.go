@@ -0,0 +1,90 @@
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"terraform-provider-infisical/internal/provider/resource/resourcetest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestValidateConfigChecksTheDestinationConfig(t *testing.T) {
+	cases := map[string]struct {
+		newResource       func() resource.Resource
+		destinationConfig map[string]any
+		wantError         bool
+	}{
+		"valid": {
+			newResource:       NewSecretSyncGcpSecretManagerResource,
+			destinationConfig: map[string]any{"project_id": "gcp-project"},
+		},
+		"invalid with defaults applied": {
+			newResource:       NewSecretSyncGcpSecretManagerResource,
+			destinationConfig: map[string]any{"project_id": "gcp-project", "location_id": "us-east1"},
+			wantError:         true,
+		},
+		"invalid once known": {
+			newResource:       NewSecretSyncGcpSecretManagerResource,
+			destinationConfig: map[string]any{"project_id": "gcp-project", "scope": "region"},
+			wantError:         true,
+		},
+		"not known yet": {
+			newResource:       NewSecretSyncGcpSecretManagerResource,
+			destinationConfig: map[string]any{"project_id": "gcp-project", "scope": "region", "location_id": tftypes.UnknownValue},
+		},
+		"terraform cloud workspace without its ID": {
+			newResource:       NewSecretSyncTerraformCloudResource,
+			destinationConfig: map[string]any{"scope": "workspace", "organization": "acme", "variable_category": "env"},
+			wantError:         true,
+		},
+		"terraform cloud workspace": {
+			newResource:       NewSecretSyncTerraformCloudResource,
+			destinationConfig: map[string]any{"scope": "workspace", "organization": "acme", "workspace_id": "ws-1", "variable_category": "env"},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := c.newResource()
+			values := resourcetest.State(t, r, map[string]any{
+				"name":               "sync",
+				"destination_config": destinationConfigValue(t, r, c.destinationConfig),
+			})
+			config := tfsdk.Config{Schema: values.Schema, Raw: values.Raw}
+
+			var resp resource.ValidateConfigResponse
+			r.(resource.ResourceWithValidateConfig).ValidateConfig(context.Background(), resource.ValidateConfigRequest{Config: config}, &resp)
+
+			if resp.Diagnostics.HasError() != c.wantError {
+				t.Errorf("got errors %v, want an error: %t", resp.Diagnostics, c.wantError)
+			}
+		})
+	}
+}
+
+// destinationConfigValue returns the destination config in the form the schema of r takes it: an
+// object, or a JSON string in the Crossplane build.
+func destinationConfigValue(t *testing.T, r resource.Resource, destinationConfig map[string]any) any {
+	t.Helper()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	if _, isString := schemaResp.Schema.Attributes["destination_config"].(schema.StringAttribute); !isString {
+		return destinationConfig
+	}
+
+	for _, value := range destinationConfig {
+		if value == tftypes.UnknownValue {
+			return tftypes.UnknownValue
+		}
+	}
+	encoded, err := json.Marshal(destinationConfig)
+	if err != nil {
+		t.Fatalf("encoding the destination config: %v", err)
+	}
+	return string(encoded)
+}
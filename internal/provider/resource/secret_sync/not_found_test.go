@@ -0,0 +1,10 @@
+package resource
+
+import (
+	"terraform-provider-infisical/internal/provider/resource/resourcetest"
+	"testing"
+)
+
+func TestReadRemovesSecretSyncsDeletedOutsideOfTerraform(t *testing.T) {
+	resourcetest.AssertReadRemovesWhenNotFound(t, NewSecretSyncGithubResource, map[string]any{"id": "secret-sync-id"})
+}
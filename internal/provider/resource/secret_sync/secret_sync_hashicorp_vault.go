@@ -0,0 +1,244 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	infisical "terraform-provider-infisical/internal/client"
+	"terraform-provider-infisical/internal/pkg/terraform"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func verifyHashicorpVaultDestinationConfigState(destinationConfig map[string]attr.Value, diags *diag.Diagnostics) bool {
+	requiredFields := []string{"mount", "path"}
+
+	// Check required fields are not empty
+	for _, field := range requiredFields {
+		value, exists := destinationConfig[field]
+		if !exists {
+			diags.AddError("Invalid destination config", fmt.Sprintf("Expected '%s' to be present", field))
+			return false
+		}
+
+		if terraform.IsAttrValueEmpty(value) {
+			diags.AddError("Invalid destination config", fmt.Sprintf("Expected '%s' to be set", field))
+			return false
+		}
+	}
+
+	// Check for unexpected fields
+	allowedFieldsMap := make(map[string]bool)
+	for _, field := range requiredFields {
+		allowedFieldsMap[field] = true
+	}
+
+	for field := range destinationConfig {
+		if !allowedFieldsMap[field] {
+			if terraform.IsAttrValueEmpty(destinationConfig[field]) {
+				continue
+			}
+
+			diags.AddError("Invalid destination config", fmt.Sprintf("Unexpected field '%s'. Supported destination_config fields are: %v", field, requiredFields))
+			return false
+		}
+	}
+
+	return true
+}
+
+type SecretSyncHashicorpVaultDestinationConfigModel struct {
+	Mount types.String `tfsdk:"mount"`
+	Path  types.String `tfsdk:"path"`
+}
+
+type SecretSyncHashicorpVaultSyncOptionsModel struct {
+	InitialSyncBehavior   types.String `tfsdk:"initial_sync_behavior"`
+	DisableSecretDeletion types.Bool   `tfsdk:"disable_secret_deletion"`
+	KeySchema             types.String `tfsdk:"key_schema"`
+}
+
+func NewSecretSyncHashicorpVaultResource() resource.Resource {
+	return &SecretSyncBaseResource{
+		App:              infisical.SecretSyncAppHashicorpVault,
+		SyncName:         "HashiCorp Vault",
+		ResourceTypeName: "_secret_sync_hashicorp_vault",
+		CanImportSecrets: true,
+		AppConnection:    infisical.AppConnectionAppHashicorpVault,
+		DestinationConfigAttributes: map[string]schema.Attribute{
+			"mount": schema.StringAttribute{
+				Required:    true,
+				Description: "The path of the KV secrets engine mount to sync secrets to, such as `secret`. Both KV version 1 and version 2 mounts are supported; the version is detected from the mount.",
+			},
+			"path": schema.StringAttribute{
+				Required:    true,
+				Description: "The path within the mount to sync secrets to, such as `dev/my-app`. Secrets are written as the key-value pairs of the secret at this path.",
+			},
+		},
+		SyncOptionsAttributes: map[string]schema.Attribute{
+			"initial_sync_behavior": schema.StringAttribute{
+				Required:    true,
+				Description: "Specify how Infisical should resolve the initial sync to the destination. Supported options: overwrite-destination, import-prioritize-source, import-prioritize-destination",
+			},
+			"disable_secret_deletion": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "When set to true, Infisical will not remove secrets from HashiCorp Vault. Enable this option if you intend to manage some secrets manually outside of Infisical.",
+				Default:     booldefault.StaticBool(false),
+			},
+			"key_schema": schema.StringAttribute{
+				Optional:    true,
+				Description: "The format to use for structuring secret keys in the HashiCorp Vault destination.",
+			},
+		},
+
+		ReadSyncOptionsForCreateFromPlan: func(ctx context.Context, plan SecretSyncBaseResourceModel) (map[string]interface{}, diag.Diagnostics) {
+			syncOptionsMap := make(map[string]interface{})
+
+			var syncOptions SecretSyncHashicorpVaultSyncOptionsModel
+			diags := plan.SyncOptions.As(ctx, &syncOptions, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			syncOptionsMap["initialSyncBehavior"] = syncOptions.InitialSyncBehavior.ValueString()
+			syncOptionsMap["disableSecretDeletion"] = syncOptions.DisableSecretDeletion.ValueBool()
+			syncOptionsMap["keySchema"] = syncOptions.KeySchema.ValueString()
+
+			return syncOptionsMap, nil
+		},
+
+		ReadSyncOptionsFromApi: func(ctx context.Context, secretSync infisical.SecretSync) (types.Object, diag.Diagnostics) {
+			syncOptionsMap := make(map[string]attr.Value)
+
+			initialSyncBehavior, ok := secretSync.SyncOptions["initialSyncBehavior"].(string)
+			if !ok {
+				initialSyncBehavior = ""
+			}
+
+			disableSecretDeletion, ok := secretSync.SyncOptions["disableSecretDeletion"].(bool)
+			if !ok {
+				disableSecretDeletion = false
+			}
+
+			syncOptionsMap["initial_sync_behavior"] = types.StringValue(initialSyncBehavior)
+			syncOptionsMap["disable_secret_deletion"] = types.BoolValue(disableSecretDeletion)
+
+			keySchema, ok := secretSync.SyncOptions["keySchema"].(string)
+			if keySchema == "" || !ok {
+				syncOptionsMap["key_schema"] = types.StringNull()
+			} else {
+				syncOptionsMap["key_schema"] = types.StringValue(keySchema)
+			}
+
+			return types.ObjectValue(map[string]attr.Type{
+				"initial_sync_behavior":   types.StringType,
+				"disable_secret_deletion": types.BoolType,
+				"key_schema":              types.StringType,
+			}, syncOptionsMap)
+		},
+
+		ReadSyncOptionsForUpdateFromPlan: func(ctx context.Context, plan SecretSyncBaseResourceModel, state SecretSyncBaseResourceModel) (map[string]interface{}, diag.Diagnostics) {
+			syncOptionsMap := make(map[string]interface{})
+
+			var syncOptions SecretSyncHashicorpVaultSyncOptionsModel
+			diags := plan.SyncOptions.As(ctx, &syncOptions, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			syncOptionsMap["initialSyncBehavior"] = syncOptions.InitialSyncBehavior.ValueString()
+			syncOptionsMap["disableSecretDeletion"] = syncOptions.DisableSecretDeletion.ValueBool()
+			syncOptionsMap["keySchema"] = syncOptions.KeySchema.ValueString()
+
+			return syncOptionsMap, nil
+		},
+
+		ReadDestinationConfigForCreateFromPlan: func(ctx context.Context, plan SecretSyncBaseResourceModel) (map[string]interface{}, diag.Diagnostics) {
+			destinationConfig := make(map[string]interface{})
+
+			var cfg SecretSyncHashicorpVaultDestinationConfigModel
+			diags := plan.DestinationConfig.As(ctx, &cfg, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			rawVaultCfg := map[string]attr.Value{
+				"mount": cfg.Mount,
+				"path":  cfg.Path,
+			}
+
+			if !verifyHashicorpVaultDestinationConfigState(rawVaultCfg, &diags) {
+				return nil, diags
+			}
+
+			destinationConfig["mount"] = cfg.Mount.ValueString()
+			destinationConfig["path"] = cfg.Path.ValueString()
+
+			return destinationConfig, diags
+		},
+		ReadDestinationConfigForUpdateFromPlan: func(ctx context.Context, plan SecretSyncBaseResourceModel, _ SecretSyncBaseResourceModel) (map[string]interface{}, diag.Diagnostics) {
+			destinationConfig := make(map[string]interface{})
+
+			var cfg SecretSyncHashicorpVaultDestinationConfigModel
+			diags := plan.DestinationConfig.As(ctx, &cfg, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			rawVaultCfg := map[string]attr.Value{
+				"mount": cfg.Mount,
+				"path":  cfg.Path,
+			}
+
+			if !verifyHashicorpVaultDestinationConfigState(rawVaultCfg, &diags) {
+				return nil, diags
+			}
+
+			destinationConfig["mount"] = cfg.Mount.ValueString()
+			destinationConfig["path"] = cfg.Path.ValueString()
+
+			return destinationConfig, diags
+		},
+		ReadDestinationConfigFromApi: func(ctx context.Context, secretSync infisical.SecretSync) (types.Object, diag.Diagnostics) {
+			var diags diag.Diagnostics
+
+			mountVal, ok := secretSync.DestinationConfig["mount"].(string)
+			if !ok {
+				diags.AddError(
+					"Invalid type",
+					"Expected 'mount' to be a string but got something else",
+				)
+				return types.ObjectNull(map[string]attr.Type{}), diags
+			}
+
+			pathVal, ok := secretSync.DestinationConfig["path"].(string)
+			if !ok {
+				diags.AddError(
+					"Invalid type",
+					"Expected 'path' to be a string but got something else",
+				)
+				return types.ObjectNull(map[string]attr.Type{}), diags
+			}
+
+			destinationConfig := map[string]attr.Value{
+				"mount": types.StringValue(mountVal),
+				"path":  types.StringValue(pathVal),
+			}
+
+			if !verifyHashicorpVaultDestinationConfigState(destinationConfig, &diags) {
+				return types.ObjectNull(map[string]attr.Type{}), diags
+			}
+
+			return types.ObjectValue(map[string]attr.Type{
+				"mount": types.StringType,
+				"path":  types.StringType,
+			}, destinationConfig)
+		},
+	}
+}
@@ -0,0 +1,318 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	infisical "terraform-provider-infisical/internal/client"
+	"terraform-provider-infisical/internal/pkg/terraform"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+const KubernetesSecretTypeOpaque = "Opaque"
+
+func verifyKubernetesDestinationConfigState(destinationConfig map[string]attr.Value, diags *diag.Diagnostics) bool {
+	requiredFields := []string{"namespace", "secret_name"}
+	allowedFields := []string{"namespace", "secret_name", "secret_type"}
+
+	// Check required fields are not empty
+	for _, field := range requiredFields {
+		value, exists := destinationConfig[field]
+		if !exists {
+			diags.AddError("Invalid destination config", fmt.Sprintf("Expected '%s' to be present", field))
+			return false
+		}
+
+		if terraform.IsAttrValueEmpty(value) {
+			diags.AddError("Invalid destination config", fmt.Sprintf("Expected '%s' to be set", field))
+			return false
+		}
+	}
+
+	// Check for unexpected fields
+	allowedFieldsMap := make(map[string]bool)
+	for _, field := range allowedFields {
+		allowedFieldsMap[field] = true
+	}
+
+	for field := range destinationConfig {
+		if !allowedFieldsMap[field] {
+			if terraform.IsAttrValueEmpty(destinationConfig[field]) {
+				continue
+			}
+
+			diags.AddError("Invalid destination config", fmt.Sprintf("Unexpected field '%s'. Supported destination_config fields are: %v", field, allowedFields))
+			return false
+		}
+	}
+
+	return true
+}
+
+// kubernetesMetadataToMap reads the labels or annotations the API returned for a Kubernetes
+// Secret sync. Missing or empty metadata is read as null, as it is left unset in configuration.
+func kubernetesMetadataToMap(ctx context.Context, raw interface{}, field string) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if raw == nil {
+		return types.MapNull(types.StringType), diags
+	}
+
+	rawMap, ok := raw.(map[string]interface{})
+	if !ok {
+		diags.AddError("Invalid type", fmt.Sprintf("Expected '%s' to be an object but got something else", field))
+		return types.MapNull(types.StringType), diags
+	}
+
+	if len(rawMap) == 0 {
+		return types.MapNull(types.StringType), diags
+	}
+
+	values := make(map[string]string, len(rawMap))
+	for key, value := range rawMap {
+		stringValue, ok := value.(string)
+		if !ok {
+			diags.AddError("Invalid type", fmt.Sprintf("Expected '%s.%s' to be a string but got something else", field, key))
+			return types.MapNull(types.StringType), diags
+		}
+		values[key] = stringValue
+	}
+
+	return types.MapValueFrom(ctx, types.StringType, values)
+}
+
+// kubernetesMetadataFromPlan returns the labels or annotations to send to the API. Unset
+// metadata is sent as an empty object so that metadata removed from configuration is removed
+// from the Secret.
+func kubernetesMetadataFromPlan(ctx context.Context, metadata types.Map) (map[string]string, diag.Diagnostics) {
+	values := make(map[string]string)
+	if metadata.IsNull() || metadata.IsUnknown() {
+		return values, nil
+	}
+
+	diags := metadata.ElementsAs(ctx, &values, false)
+	return values, diags
+}
+
+type SecretSyncKubernetesDestinationConfigModel struct {
+	Namespace  types.String `tfsdk:"namespace"`
+	SecretName types.String `tfsdk:"secret_name"`
+	SecretType types.String `tfsdk:"secret_type"`
+}
+
+type SecretSyncKubernetesSyncOptionsModel struct {
+	InitialSyncBehavior   types.String `tfsdk:"initial_sync_behavior"`
+	DisableSecretDeletion types.Bool   `tfsdk:"disable_secret_deletion"`
+	KeySchema             types.String `tfsdk:"key_schema"`
+	Labels                types.Map    `tfsdk:"labels"`
+	Annotations           types.Map    `tfsdk:"annotations"`
+}
+
+func readKubernetesSyncOptionsFromPlan(ctx context.Context, plan SecretSyncBaseResourceModel) (map[string]interface{}, diag.Diagnostics) {
+	syncOptionsMap := make(map[string]interface{})
+
+	var syncOptions SecretSyncKubernetesSyncOptionsModel
+	diags := plan.SyncOptions.As(ctx, &syncOptions, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	syncOptionsMap["initialSyncBehavior"] = syncOptions.InitialSyncBehavior.ValueString()
+	syncOptionsMap["disableSecretDeletion"] = syncOptions.DisableSecretDeletion.ValueBool()
+	syncOptionsMap["keySchema"] = syncOptions.KeySchema.ValueString()
+
+	labels, labelDiags := kubernetesMetadataFromPlan(ctx, syncOptions.Labels)
+	diags.Append(labelDiags...)
+	annotations, annotationDiags := kubernetesMetadataFromPlan(ctx, syncOptions.Annotations)
+	diags.Append(annotationDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	syncOptionsMap["labels"] = labels
+	syncOptionsMap["annotations"] = annotations
+
+	return syncOptionsMap, nil
+}
+
+func readKubernetesDestinationConfigFromPlan(ctx context.Context, plan SecretSyncBaseResourceModel) (map[string]interface{}, diag.Diagnostics) {
+	destinationConfig := make(map[string]interface{})
+
+	var cfg SecretSyncKubernetesDestinationConfigModel
+	diags := plan.DestinationConfig.As(ctx, &cfg, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	rawKubernetesCfg := map[string]attr.Value{
+		"namespace":   cfg.Namespace,
+		"secret_name": cfg.SecretName,
+		"secret_type": cfg.SecretType,
+	}
+
+	if !verifyKubernetesDestinationConfigState(rawKubernetesCfg, &diags) {
+		return nil, diags
+	}
+
+	destinationConfig["namespace"] = cfg.Namespace.ValueString()
+	destinationConfig["secretName"] = cfg.SecretName.ValueString()
+	destinationConfig["secretType"] = cfg.SecretType.ValueString()
+
+	return destinationConfig, diags
+}
+
+func NewSecretSyncKubernetesResource() resource.Resource {
+	return &SecretSyncBaseResource{
+		App:              infisical.SecretSyncAppKubernetes,
+		SyncName:         "Kubernetes",
+		ResourceTypeName: "_secret_sync_kubernetes",
+		CanImportSecrets: true,
+		AppConnection:    infisical.AppConnectionAppKubernetes,
+		DestinationConfigAttributes: map[string]schema.Attribute{
+			"namespace": schema.StringAttribute{
+				Required:    true,
+				Description: "The namespace of the Kubernetes Secret to sync secrets to.",
+			},
+			"secret_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the Kubernetes Secret to sync secrets to. The Secret is created if it doesn't exist.",
+			},
+			"secret_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The type of the Kubernetes Secret, such as `Opaque` or `kubernetes.io/dockerconfigjson`. The secret keys must match the keys the type requires. Defaults to `Opaque`.",
+				Default:     stringdefault.StaticString(KubernetesSecretTypeOpaque),
+			},
+		},
+		SyncOptionsAttributes: map[string]schema.Attribute{
+			"initial_sync_behavior": schema.StringAttribute{
+				Required:    true,
+				Description: "Specify how Infisical should resolve the initial sync to the destination. Supported options: overwrite-destination, import-prioritize-source, import-prioritize-destination",
+			},
+			"disable_secret_deletion": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "When set to true, Infisical will not remove keys from the Kubernetes Secret. Enable this option if you intend to manage some keys manually outside of Infisical.",
+				Default:     booldefault.StaticBool(false),
+			},
+			"key_schema": schema.StringAttribute{
+				Optional:    true,
+				Description: "The format to use for structuring secret keys in the Kubernetes Secret.",
+			},
+			"labels": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The labels to set on the Kubernetes Secret.",
+			},
+			"annotations": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The annotations to set on the Kubernetes Secret.",
+			},
+		},
+
+		ReadSyncOptionsForCreateFromPlan: readKubernetesSyncOptionsFromPlan,
+
+		ReadSyncOptionsFromApi: func(ctx context.Context, secretSync infisical.SecretSync) (types.Object, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			syncOptionsMap := make(map[string]attr.Value)
+
+			initialSyncBehavior, ok := secretSync.SyncOptions["initialSyncBehavior"].(string)
+			if !ok {
+				initialSyncBehavior = ""
+			}
+
+			disableSecretDeletion, ok := secretSync.SyncOptions["disableSecretDeletion"].(bool)
+			if !ok {
+				disableSecretDeletion = false
+			}
+
+			syncOptionsMap["initial_sync_behavior"] = types.StringValue(initialSyncBehavior)
+			syncOptionsMap["disable_secret_deletion"] = types.BoolValue(disableSecretDeletion)
+
+			keySchema, ok := secretSync.SyncOptions["keySchema"].(string)
+			if keySchema == "" || !ok {
+				syncOptionsMap["key_schema"] = types.StringNull()
+			} else {
+				syncOptionsMap["key_schema"] = types.StringValue(keySchema)
+			}
+
+			labels, labelDiags := kubernetesMetadataToMap(ctx, secretSync.SyncOptions["labels"], "labels")
+			diags.Append(labelDiags...)
+			annotations, annotationDiags := kubernetesMetadataToMap(ctx, secretSync.SyncOptions["annotations"], "annotations")
+			diags.Append(annotationDiags...)
+			if diags.HasError() {
+				return types.ObjectNull(map[string]attr.Type{}), diags
+			}
+
+			syncOptionsMap["labels"] = labels
+			syncOptionsMap["annotations"] = annotations
+
+			return types.ObjectValue(map[string]attr.Type{
+				"initial_sync_behavior":   types.StringType,
+				"disable_secret_deletion": types.BoolType,
+				"key_schema":              types.StringType,
+				"labels":                  types.MapType{ElemType: types.StringType},
+				"annotations":             types.MapType{ElemType: types.StringType},
+			}, syncOptionsMap)
+		},
+
+		ReadSyncOptionsForUpdateFromPlan: func(ctx context.Context, plan SecretSyncBaseResourceModel, _ SecretSyncBaseResourceModel) (map[string]interface{}, diag.Diagnostics) {
+			return readKubernetesSyncOptionsFromPlan(ctx, plan)
+		},
+
+		ReadDestinationConfigForCreateFromPlan: readKubernetesDestinationConfigFromPlan,
+		ReadDestinationConfigForUpdateFromPlan: func(ctx context.Context, plan SecretSyncBaseResourceModel, _ SecretSyncBaseResourceModel) (map[string]interface{}, diag.Diagnostics) {
+			return readKubernetesDestinationConfigFromPlan(ctx, plan)
+		},
+		ReadDestinationConfigFromApi: func(ctx context.Context, secretSync infisical.SecretSync) (types.Object, diag.Diagnostics) {
+			var diags diag.Diagnostics
+
+			namespaceVal, ok := secretSync.DestinationConfig["namespace"].(string)
+			if !ok {
+				diags.AddError(
+					"Invalid type",
+					"Expected 'namespace' to be a string but got something else",
+				)
+				return types.ObjectNull(map[string]attr.Type{}), diags
+			}
+
+			secretNameVal, ok := secretSync.DestinationConfig["secretName"].(string)
+			if !ok {
+				diags.AddError(
+					"Invalid type",
+					"Expected 'secretName' to be a string but got something else",
+				)
+				return types.ObjectNull(map[string]attr.Type{}), diags
+			}
+
+			secretTypeVal, ok := secretSync.DestinationConfig["secretType"].(string)
+			if !ok || secretTypeVal == "" {
+				secretTypeVal = KubernetesSecretTypeOpaque
+			}
+
+			destinationConfig := map[string]attr.Value{
+				"namespace":   types.StringValue(namespaceVal),
+				"secret_name": types.StringValue(secretNameVal),
+				"secret_type": types.StringValue(secretTypeVal),
+			}
+
+			if !verifyKubernetesDestinationConfigState(destinationConfig, &diags) {
+				return types.ObjectNull(map[string]attr.Type{}), diags
+			}
+
+			return types.ObjectValue(map[string]attr.Type{
+				"namespace":   types.StringType,
+				"secret_name": types.StringType,
+				"secret_type": types.StringType,
+			}, destinationConfig)
+		},
+	}
+}
@@ -0,0 +1,275 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	infisical "terraform-provider-infisical/internal/client"
+	"terraform-provider-infisical/internal/pkg/terraform"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// NetlifyDeployContexts are the deploy contexts a Netlify environment variable can apply to.
+var NetlifyDeployContexts = []string{"all", "production", "deploy-preview", "branch-deploy", "dev", "dev-server"}
+
+func verifyNetlifyDestinationConfigState(destinationConfig map[string]attr.Value, diags *diag.Diagnostics) bool {
+	requiredFields := []string{"account_id", "context"}
+	optionalFields := []string{"site_id"}
+
+	// Check required fields are not empty
+	for _, field := range requiredFields {
+		value, exists := destinationConfig[field]
+		if !exists {
+			diags.AddError("Invalid destination config", fmt.Sprintf("Expected '%s' to be present", field))
+			return false
+		}
+
+		if terraform.IsAttrValueEmpty(value) {
+			diags.AddError("Invalid destination config", fmt.Sprintf("Expected '%s' to be set", field))
+			return false
+		}
+	}
+
+	if context, ok := destinationConfig["context"].(types.String); ok && !slices.Contains(NetlifyDeployContexts, context.ValueString()) {
+		diags.AddError("Invalid destination config", fmt.Sprintf("Invalid context '%s', expected one of %v", context.ValueString(), NetlifyDeployContexts))
+		return false
+	}
+
+	// Check for unexpected fields
+	allowedFieldsMap := make(map[string]bool)
+	for _, field := range requiredFields {
+		allowedFieldsMap[field] = true
+	}
+	for _, field := range optionalFields {
+		allowedFieldsMap[field] = true
+	}
+
+	for field := range destinationConfig {
+		if !allowedFieldsMap[field] {
+			if terraform.IsAttrValueEmpty(destinationConfig[field]) {
+				continue
+			}
+
+			diags.AddError("Invalid destination config", fmt.Sprintf("Unexpected field '%s'. Supported destination_config fields are: %v", field, append(requiredFields, optionalFields...)))
+			return false
+		}
+	}
+
+	return true
+}
+
+type SecretSyncNetlifyDestinationConfigModel struct {
+	AccountId types.String `tfsdk:"account_id"`
+	SiteId    types.String `tfsdk:"site_id"`
+	Context   types.String `tfsdk:"context"`
+}
+
+type SecretSyncNetlifySyncOptionsModel struct {
+	InitialSyncBehavior   types.String `tfsdk:"initial_sync_behavior"`
+	DisableSecretDeletion types.Bool   `tfsdk:"disable_secret_deletion"`
+	KeySchema             types.String `tfsdk:"key_schema"`
+}
+
+func NewSecretSyncNetlifyResource() resource.Resource {
+	return &SecretSyncBaseResource{
+		App:              infisical.SecretSyncAppNetlify,
+		SyncName:         "Netlify",
+		ResourceTypeName: "_secret_sync_netlify",
+		AppConnection:    infisical.AppConnectionAppNetlify,
+		DestinationConfigAttributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Netlify account (team) to sync secrets to.",
+			},
+			"site_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the Netlify site to sync secrets to. When unset, secrets are synced to the shared environment variables of the account.",
+			},
+			"context": schema.StringAttribute{
+				Required:    true,
+				Description: "The Netlify deploy context the secrets apply to. Supported options: all, production, deploy-preview, branch-deploy, dev, dev-server",
+			},
+		},
+		SyncOptionsAttributes: map[string]schema.Attribute{
+			"initial_sync_behavior": schema.StringAttribute{
+				Required:    true,
+				Description: "Specify how Infisical should resolve the initial sync to the destination. Supported options: overwrite-destination",
+			},
+			"disable_secret_deletion": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "When set to true, Infisical will not remove secrets from Netlify. Enable this option if you intend to manage some secrets manually outside of Infisical.",
+				Default:     booldefault.StaticBool(false),
+			},
+			"key_schema": schema.StringAttribute{
+				Optional:    true,
+				Description: "The format to use for structuring secret keys in the Netlify destination.",
+			},
+		},
+
+		ReadSyncOptionsForCreateFromPlan: func(ctx context.Context, plan SecretSyncBaseResourceModel) (map[string]interface{}, diag.Diagnostics) {
+			syncOptionsMap := make(map[string]interface{})
+
+			var syncOptions SecretSyncNetlifySyncOptionsModel
+			diags := plan.SyncOptions.As(ctx, &syncOptions, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			syncOptionsMap["initialSyncBehavior"] = syncOptions.InitialSyncBehavior.ValueString()
+			syncOptionsMap["disableSecretDeletion"] = syncOptions.DisableSecretDeletion.ValueBool()
+			syncOptionsMap["keySchema"] = syncOptions.KeySchema.ValueString()
+
+			return syncOptionsMap, nil
+		},
+
+		ReadSyncOptionsFromApi: func(ctx context.Context, secretSync infisical.SecretSync) (types.Object, diag.Diagnostics) {
+			syncOptionsMap := make(map[string]attr.Value)
+
+			initialSyncBehavior, ok := secretSync.SyncOptions["initialSyncBehavior"].(string)
+			if !ok {
+				initialSyncBehavior = ""
+			}
+
+			disableSecretDeletion, ok := secretSync.SyncOptions["disableSecretDeletion"].(bool)
+			if !ok {
+				disableSecretDeletion = false
+			}
+
+			syncOptionsMap["initial_sync_behavior"] = types.StringValue(initialSyncBehavior)
+			syncOptionsMap["disable_secret_deletion"] = types.BoolValue(disableSecretDeletion)
+
+			keySchema, ok := secretSync.SyncOptions["keySchema"].(string)
+			if keySchema == "" || !ok {
+				syncOptionsMap["key_schema"] = types.StringNull()
+			} else {
+				syncOptionsMap["key_schema"] = types.StringValue(keySchema)
+			}
+
+			return types.ObjectValue(map[string]attr.Type{
+				"initial_sync_behavior":   types.StringType,
+				"disable_secret_deletion": types.BoolType,
+				"key_schema":              types.StringType,
+			}, syncOptionsMap)
+		},
+
+		ReadSyncOptionsForUpdateFromPlan: func(ctx context.Context, plan SecretSyncBaseResourceModel, state SecretSyncBaseResourceModel) (map[string]interface{}, diag.Diagnostics) {
+			syncOptionsMap := make(map[string]interface{})
+
+			var syncOptions SecretSyncNetlifySyncOptionsModel
+			diags := plan.SyncOptions.As(ctx, &syncOptions, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			syncOptionsMap["initialSyncBehavior"] = syncOptions.InitialSyncBehavior.ValueString()
+			syncOptionsMap["disableSecretDeletion"] = syncOptions.DisableSecretDeletion.ValueBool()
+			syncOptionsMap["keySchema"] = syncOptions.KeySchema.ValueString()
+
+			return syncOptionsMap, nil
+		},
+
+		ReadDestinationConfigForCreateFromPlan: func(ctx context.Context, plan SecretSyncBaseResourceModel) (map[string]interface{}, diag.Diagnostics) {
+			destinationConfig := make(map[string]interface{})
+
+			var cfg SecretSyncNetlifyDestinationConfigModel
+			diags := plan.DestinationConfig.As(ctx, &cfg, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			rawNetlifyCfg := map[string]attr.Value{
+				"account_id": cfg.AccountId,
+				"site_id":    cfg.SiteId,
+				"context":    cfg.Context,
+			}
+
+			if !verifyNetlifyDestinationConfigState(rawNetlifyCfg, &diags) {
+				return nil, diags
+			}
+
+			destinationConfig["accountId"] = cfg.AccountId.ValueString()
+			destinationConfig["context"] = cfg.Context.ValueString()
+			if !cfg.SiteId.IsNull() && !cfg.SiteId.IsUnknown() {
+				destinationConfig["siteId"] = cfg.SiteId.ValueString()
+			}
+
+			return destinationConfig, diags
+		},
+		ReadDestinationConfigForUpdateFromPlan: func(ctx context.Context, plan SecretSyncBaseResourceModel, _ SecretSyncBaseResourceModel) (map[string]interface{}, diag.Diagnostics) {
+			destinationConfig := make(map[string]interface{})
+
+			var cfg SecretSyncNetlifyDestinationConfigModel
+			diags := plan.DestinationConfig.As(ctx, &cfg, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			rawNetlifyCfg := map[string]attr.Value{
+				"account_id": cfg.AccountId,
+				"site_id":    cfg.SiteId,
+				"context":    cfg.Context,
+			}
+
+			if !verifyNetlifyDestinationConfigState(rawNetlifyCfg, &diags) {
+				return nil, diags
+			}
+
+			destinationConfig["accountId"] = cfg.AccountId.ValueString()
+			destinationConfig["context"] = cfg.Context.ValueString()
+			if !cfg.SiteId.IsNull() && !cfg.SiteId.IsUnknown() {
+				destinationConfig["siteId"] = cfg.SiteId.ValueString()
+			}
+
+			return destinationConfig, diags
+		},
+		ReadDestinationConfigFromApi: func(ctx context.Context, secretSync infisical.SecretSync) (types.Object, diag.Diagnostics) {
+			var diags diag.Diagnostics
+
+			accountIdVal, ok := secretSync.DestinationConfig["accountId"].(string)
+			if !ok {
+				diags.AddError(
+					"Invalid type",
+					"Expected 'accountId' to be a string but got something else",
+				)
+				return types.ObjectNull(map[string]attr.Type{}), diags
+			}
+
+			contextVal, ok := secretSync.DestinationConfig["context"].(string)
+			if !ok {
+				diags.AddError(
+					"Invalid type",
+					"Expected 'context' to be a string but got something else",
+				)
+				return types.ObjectNull(map[string]attr.Type{}), diags
+			}
+
+			destinationConfig := map[string]attr.Value{
+				"account_id": types.StringValue(accountIdVal),
+				"site_id":    types.StringNull(),
+				"context":    types.StringValue(contextVal),
+			}
+
+			if siteIdVal, ok := secretSync.DestinationConfig["siteId"].(string); ok && siteIdVal != "" {
+				destinationConfig["site_id"] = types.StringValue(siteIdVal)
+			}
+
+			if !verifyNetlifyDestinationConfigState(destinationConfig, &diags) {
+				return types.ObjectNull(map[string]attr.Type{}), diags
+			}
+
+			return types.ObjectValue(map[string]attr.Type{
+				"account_id": types.StringType,
+				"site_id":    types.StringType,
+				"context":    types.StringType,
+			}, destinationConfig)
+		},
+	}
+}
@@ -0,0 +1,334 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	infisical "terraform-provider-infisical/internal/client"
+	"terraform-provider-infisical/internal/pkg/terraform"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type TerraformCloudSyncScope string
+
+const (
+	TerraformCloudSyncScopeWorkspace   TerraformCloudSyncScope = "workspace"
+	TerraformCloudSyncScopeVariableSet TerraformCloudSyncScope = "variable-set"
+)
+
+const (
+	TerraformCloudVariableCategoryEnv       = "env"
+	TerraformCloudVariableCategoryTerraform = "terraform"
+)
+
+func verifyTerraformCloudDestinationConfigState(destinationConfig map[string]attr.Value, diags *diag.Diagnostics) bool {
+	scopeAttr, exists := destinationConfig["scope"]
+	if !exists {
+		diags.AddError("Invalid destination config", "Expected 'scope' to be present")
+		return false
+	}
+
+	scopeVal, ok := scopeAttr.(types.String)
+	if !ok {
+		diags.AddError("Invalid destination config", "Expected 'scope' to be a string type")
+		return false
+	}
+
+	if scopeVal.IsNull() || scopeVal.IsUnknown() {
+		diags.AddError("Invalid destination config", "Expected 'scope' to have a value")
+		return false
+	}
+
+	scope := TerraformCloudSyncScope(scopeVal.ValueString())
+	var requiredFields []string
+
+	switch scope {
+	case TerraformCloudSyncScopeWorkspace:
+		requiredFields = []string{"scope", "organization", "workspace_id", "variable_category"}
+	case TerraformCloudSyncScopeVariableSet:
+		requiredFields = []string{"scope", "organization", "variable_set_id", "variable_category"}
+	default:
+		diags.AddError("Invalid destination config", fmt.Sprintf("Invalid scope '%s' expected options '%s', '%s'", scope, TerraformCloudSyncScopeWorkspace, TerraformCloudSyncScopeVariableSet))
+		return false
+	}
+
+	// Check required fields are not empty
+	for _, field := range requiredFields {
+		value, exists := destinationConfig[field]
+		if !exists {
+			diags.AddError("Invalid destination config", fmt.Sprintf("Expected '%s' to be present when scope is %s", field, scope))
+			return false
+		}
+
+		if terraform.IsAttrValueEmpty(value) {
+			diags.AddError("Invalid destination config", fmt.Sprintf("Expected '%s' to be set when scope is %s", field, scope))
+			return false
+		}
+	}
+
+	if category, ok := destinationConfig["variable_category"].(types.String); ok {
+		if category.ValueString() != TerraformCloudVariableCategoryEnv && category.ValueString() != TerraformCloudVariableCategoryTerraform {
+			diags.AddError("Invalid destination config", fmt.Sprintf("Invalid variable_category '%s' expected options '%s', '%s'", category.ValueString(), TerraformCloudVariableCategoryEnv, TerraformCloudVariableCategoryTerraform))
+			return false
+		}
+	}
+
+	// Check for unexpected fields
+	allowedFieldsMap := make(map[string]bool)
+	for _, field := range requiredFields {
+		allowedFieldsMap[field] = true
+	}
+
+	for field := range destinationConfig {
+		if !allowedFieldsMap[field] {
+			if terraform.IsAttrValueEmpty(destinationConfig[field]) {
+				continue
+			}
+
+			diags.AddError("Invalid destination config", fmt.Sprintf("Unexpected field '%s' for scope '%s'. Supported destination_config fields are: %v", field, scope, requiredFields))
+			return false
+		}
+	}
+
+	return true
+}
+
+type SecretSyncTerraformCloudDestinationConfigModel struct {
+	Scope            types.String `tfsdk:"scope"`
+	Organization     types.String `tfsdk:"organization"`
+	WorkspaceId      types.String `tfsdk:"workspace_id"`
+	VariableSetId    types.String `tfsdk:"variable_set_id"`
+	VariableCategory types.String `tfsdk:"variable_category"`
+}
+
+type SecretSyncTerraformCloudSyncOptionsModel struct {
+	InitialSyncBehavior   types.String `tfsdk:"initial_sync_behavior"`
+	DisableSecretDeletion types.Bool   `tfsdk:"disable_secret_deletion"`
+	KeySchema             types.String `tfsdk:"key_schema"`
+	Sensitive             types.Bool   `tfsdk:"sensitive"`
+}
+
+func readTerraformCloudSyncOptionsFromPlan(ctx context.Context, plan SecretSyncBaseResourceModel) (map[string]interface{}, diag.Diagnostics) {
+	syncOptionsMap := make(map[string]interface{})
+
+	var syncOptions SecretSyncTerraformCloudSyncOptionsModel
+	diags := plan.SyncOptions.As(ctx, &syncOptions, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	syncOptionsMap["initialSyncBehavior"] = syncOptions.InitialSyncBehavior.ValueString()
+	syncOptionsMap["disableSecretDeletion"] = syncOptions.DisableSecretDeletion.ValueBool()
+	syncOptionsMap["keySchema"] = syncOptions.KeySchema.ValueString()
+	syncOptionsMap["sensitive"] = syncOptions.Sensitive.ValueBool()
+
+	return syncOptionsMap, nil
+}
+
+func readTerraformCloudDestinationConfigFromPlan(ctx context.Context, plan SecretSyncBaseResourceModel) (map[string]interface{}, diag.Diagnostics) {
+	destinationConfig := make(map[string]interface{})
+
+	var cfg SecretSyncTerraformCloudDestinationConfigModel
+	diags := plan.DestinationConfig.As(ctx, &cfg, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	rawTerraformCloudCfg := map[string]attr.Value{
+		"scope":             cfg.Scope,
+		"organization":      cfg.Organization,
+		"workspace_id":      cfg.WorkspaceId,
+		"variable_set_id":   cfg.VariableSetId,
+		"variable_category": cfg.VariableCategory,
+	}
+
+	if !verifyTerraformCloudDestinationConfigState(rawTerraformCloudCfg, &diags) {
+		return nil, diags
+	}
+
+	destinationConfig["scope"] = cfg.Scope.ValueString()
+	destinationConfig["org"] = cfg.Organization.ValueString()
+	destinationConfig["category"] = cfg.VariableCategory.ValueString()
+
+	switch TerraformCloudSyncScope(cfg.Scope.ValueString()) {
+	case TerraformCloudSyncScopeWorkspace:
+		destinationConfig["destinationId"] = cfg.WorkspaceId.ValueString()
+	case TerraformCloudSyncScopeVariableSet:
+		destinationConfig["destinationId"] = cfg.VariableSetId.ValueString()
+	}
+
+	return destinationConfig, diags
+}
+
+func NewSecretSyncTerraformCloudResource() resource.Resource {
+	return &SecretSyncBaseResource{
+		App:              infisical.SecretSyncAppTerraformCloud,
+		SyncName:         "Terraform Cloud",
+		ResourceTypeName: "_secret_sync_terraform_cloud",
+		AppConnection:    infisical.AppConnectionAppTerraformCloud,
+		DestinationConfigAttributes: map[string]schema.Attribute{
+			"scope": schema.StringAttribute{
+				Required:    true,
+				Description: "The kind of destination to sync secrets to. Supported options: workspace, variable-set",
+			},
+			"organization": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the Terraform Cloud / HCP Terraform organization the destination belongs to.",
+			},
+			"workspace_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the workspace to sync secrets to, such as `ws-...`. Required when scope is workspace.",
+			},
+			"variable_set_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the variable set to sync secrets to, such as `varset-...`. Required when scope is variable-set.",
+			},
+			"variable_category": schema.StringAttribute{
+				Required:    true,
+				Description: "The category of the variables secrets are synced as. Supported options: env (environment variables), terraform (Terraform input variables)",
+			},
+		},
+		SyncOptionsAttributes: map[string]schema.Attribute{
+			"initial_sync_behavior": schema.StringAttribute{
+				Required:    true,
+				Description: "Specify how Infisical should resolve the initial sync to the destination. Supported options: overwrite-destination",
+			},
+			"disable_secret_deletion": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "When set to true, Infisical will not remove secrets from Terraform Cloud. Enable this option if you intend to manage some secrets manually outside of Infisical.",
+				Default:     booldefault.StaticBool(false),
+			},
+			"key_schema": schema.StringAttribute{
+				Optional:    true,
+				Description: "The format to use for structuring secret keys in the Terraform Cloud destination.",
+			},
+			"sensitive": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "When set to true, the variables Infisical creates in Terraform Cloud are marked as sensitive, so their values can't be read back from Terraform Cloud. Defaults to true.",
+				Default:     booldefault.StaticBool(true),
+			},
+		},
+
+		ReadSyncOptionsForCreateFromPlan: readTerraformCloudSyncOptionsFromPlan,
+
+		ReadSyncOptionsFromApi: func(ctx context.Context, secretSync infisical.SecretSync) (types.Object, diag.Diagnostics) {
+			syncOptionsMap := make(map[string]attr.Value)
+
+			initialSyncBehavior, ok := secretSync.SyncOptions["initialSyncBehavior"].(string)
+			if !ok {
+				initialSyncBehavior = ""
+			}
+
+			disableSecretDeletion, ok := secretSync.SyncOptions["disableSecretDeletion"].(bool)
+			if !ok {
+				disableSecretDeletion = false
+			}
+
+			syncOptionsMap["initial_sync_behavior"] = types.StringValue(initialSyncBehavior)
+			syncOptionsMap["disable_secret_deletion"] = types.BoolValue(disableSecretDeletion)
+
+			keySchema, ok := secretSync.SyncOptions["keySchema"].(string)
+			if keySchema == "" || !ok {
+				syncOptionsMap["key_schema"] = types.StringNull()
+			} else {
+				syncOptionsMap["key_schema"] = types.StringValue(keySchema)
+			}
+
+			sensitive, ok := secretSync.SyncOptions["sensitive"].(bool)
+			if !ok {
+				sensitive = true
+			}
+			syncOptionsMap["sensitive"] = types.BoolValue(sensitive)
+
+			return types.ObjectValue(map[string]attr.Type{
+				"initial_sync_behavior":   types.StringType,
+				"disable_secret_deletion": types.BoolType,
+				"key_schema":              types.StringType,
+				"sensitive":               types.BoolType,
+			}, syncOptionsMap)
+		},
+
+		ReadSyncOptionsForUpdateFromPlan: func(ctx context.Context, plan SecretSyncBaseResourceModel, _ SecretSyncBaseResourceModel) (map[string]interface{}, diag.Diagnostics) {
+			return readTerraformCloudSyncOptionsFromPlan(ctx, plan)
+		},
+
+		ReadDestinationConfigForCreateFromPlan: readTerraformCloudDestinationConfigFromPlan,
+		ReadDestinationConfigForUpdateFromPlan: func(ctx context.Context, plan SecretSyncBaseResourceModel, _ SecretSyncBaseResourceModel) (map[string]interface{}, diag.Diagnostics) {
+			return readTerraformCloudDestinationConfigFromPlan(ctx, plan)
+		},
+		ReadDestinationConfigFromApi: func(ctx context.Context, secretSync infisical.SecretSync) (types.Object, diag.Diagnostics) {
+			var diags diag.Diagnostics
+
+			scopeVal, ok := secretSync.DestinationConfig["scope"].(string)
+			if !ok {
+				diags.AddError(
+					"Invalid type",
+					"Expected 'scope' to be a string but got something else",
+				)
+				return types.ObjectNull(map[string]attr.Type{}), diags
+			}
+
+			orgVal, ok := secretSync.DestinationConfig["org"].(string)
+			if !ok {
+				diags.AddError(
+					"Invalid type",
+					"Expected 'org' to be a string but got something else",
+				)
+				return types.ObjectNull(map[string]attr.Type{}), diags
+			}
+
+			categoryVal, ok := secretSync.DestinationConfig["category"].(string)
+			if !ok {
+				diags.AddError(
+					"Invalid type",
+					"Expected 'category' to be a string but got something else",
+				)
+				return types.ObjectNull(map[string]attr.Type{}), diags
+			}
+
+			destinationConfig := map[string]attr.Value{
+				"scope":             types.StringValue(scopeVal),
+				"organization":      types.StringValue(orgVal),
+				"workspace_id":      types.StringNull(),
+				"variable_set_id":   types.StringNull(),
+				"variable_category": types.StringValue(categoryVal),
+			}
+
+			destinationIdVal, ok := secretSync.DestinationConfig["destinationId"].(string)
+			if !ok {
+				diags.AddError(
+					"Invalid type",
+					"Expected 'destinationId' to be a string but got something else",
+				)
+				return types.ObjectNull(map[string]attr.Type{}), diags
+			}
+
+			switch TerraformCloudSyncScope(scopeVal) {
+			case TerraformCloudSyncScopeWorkspace:
+				destinationConfig["workspace_id"] = types.StringValue(destinationIdVal)
+			case TerraformCloudSyncScopeVariableSet:
+				destinationConfig["variable_set_id"] = types.StringValue(destinationIdVal)
+			}
+
+			if !verifyTerraformCloudDestinationConfigState(destinationConfig, &diags) {
+				return types.ObjectNull(map[string]attr.Type{}), diags
+			}
+
+			return types.ObjectValue(map[string]attr.Type{
+				"scope":             types.StringType,
+				"organization":      types.StringType,
+				"workspace_id":      types.StringType,
+				"variable_set_id":   types.StringType,
+				"variable_category": types.StringType,
+			}, destinationConfig)
+		},
+	}
+}
@@ -0,0 +1,296 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	infisical "terraform-provider-infisical/internal/client"
+	"terraform-provider-infisical/internal/pkg/terraform"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// VercelEnvironmentPreview is the Vercel environment whose secrets can be scoped to a Git branch.
+const VercelEnvironmentPreview = "preview"
+
+func verifyVercelDestinationConfigState(destinationConfig map[string]attr.Value, diags *diag.Diagnostics) bool {
+	requiredFields := []string{"team_id", "project_id", "environment"}
+	optionalFields := []string{"preview_branch"}
+
+	// Check required fields are not empty
+	for _, field := range requiredFields {
+		value, exists := destinationConfig[field]
+		if !exists {
+			diags.AddError("Invalid destination config", fmt.Sprintf("Expected '%s' to be present", field))
+			return false
+		}
+
+		if terraform.IsAttrValueEmpty(value) {
+			diags.AddError("Invalid destination config", fmt.Sprintf("Expected '%s' to be set", field))
+			return false
+		}
+	}
+
+	if !terraform.IsAttrValueEmpty(destinationConfig["preview_branch"]) {
+		if environment, ok := destinationConfig["environment"].(types.String); !ok || environment.ValueString() != VercelEnvironmentPreview {
+			diags.AddError("Invalid destination config", fmt.Sprintf("'preview_branch' can only be set when 'environment' is '%s'", VercelEnvironmentPreview))
+			return false
+		}
+	}
+
+	// Check for unexpected fields
+	allowedFieldsMap := make(map[string]bool)
+	for _, field := range requiredFields {
+		allowedFieldsMap[field] = true
+	}
+	for _, field := range optionalFields {
+		allowedFieldsMap[field] = true
+	}
+
+	for field := range destinationConfig {
+		if !allowedFieldsMap[field] {
+			if terraform.IsAttrValueEmpty(destinationConfig[field]) {
+				continue
+			}
+
+			diags.AddError("Invalid destination config", fmt.Sprintf("Unexpected field '%s'. Supported destination_config fields are: %v", field, append(requiredFields, optionalFields...)))
+			return false
+		}
+	}
+
+	return true
+}
+
+type SecretSyncVercelDestinationConfigModel struct {
+	TeamId        types.String `tfsdk:"team_id"`
+	ProjectId     types.String `tfsdk:"project_id"`
+	Environment   types.String `tfsdk:"environment"`
+	PreviewBranch types.String `tfsdk:"preview_branch"`
+}
+
+type SecretSyncVercelSyncOptionsModel struct {
+	InitialSyncBehavior   types.String `tfsdk:"initial_sync_behavior"`
+	DisableSecretDeletion types.Bool   `tfsdk:"disable_secret_deletion"`
+	KeySchema             types.String `tfsdk:"key_schema"`
+}
+
+func NewSecretSyncVercelResource() resource.Resource {
+	return &SecretSyncBaseResource{
+		App:              infisical.SecretSyncAppVercel,
+		SyncName:         "Vercel",
+		ResourceTypeName: "_secret_sync_vercel",
+		AppConnection:    infisical.AppConnectionAppVercel,
+		DestinationConfigAttributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Vercel team the project belongs to.",
+			},
+			"project_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Vercel project to sync secrets to.",
+			},
+			"environment": schema.StringAttribute{
+				Required:    true,
+				Description: "The Vercel environment to sync secrets to. Supported options: development, preview, production, or the ID of a custom environment.",
+			},
+			"preview_branch": schema.StringAttribute{
+				Optional:    true,
+				Description: "The Git branch to sync secrets to when environment is preview. Secrets apply to every preview branch when unset.",
+			},
+		},
+		SyncOptionsAttributes: map[string]schema.Attribute{
+			"initial_sync_behavior": schema.StringAttribute{
+				Required:    true,
+				Description: "Specify how Infisical should resolve the initial sync to the destination. Supported options: overwrite-destination",
+			},
+			"disable_secret_deletion": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "When set to true, Infisical will not remove secrets from Vercel. Enable this option if you intend to manage some secrets manually outside of Infisical.",
+				Default:     booldefault.StaticBool(false),
+			},
+			"key_schema": schema.StringAttribute{
+				Optional:    true,
+				Description: "The format to use for structuring secret keys in the Vercel destination.",
+			},
+		},
+
+		ReadSyncOptionsForCreateFromPlan: func(ctx context.Context, plan SecretSyncBaseResourceModel) (map[string]interface{}, diag.Diagnostics) {
+			syncOptionsMap := make(map[string]interface{})
+
+			var syncOptions SecretSyncVercelSyncOptionsModel
+			diags := plan.SyncOptions.As(ctx, &syncOptions, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			syncOptionsMap["initialSyncBehavior"] = syncOptions.InitialSyncBehavior.ValueString()
+			syncOptionsMap["disableSecretDeletion"] = syncOptions.DisableSecretDeletion.ValueBool()
+			syncOptionsMap["keySchema"] = syncOptions.KeySchema.ValueString()
+
+			return syncOptionsMap, nil
+		},
+
+		ReadSyncOptionsFromApi: func(ctx context.Context, secretSync infisical.SecretSync) (types.Object, diag.Diagnostics) {
+			syncOptionsMap := make(map[string]attr.Value)
+
+			initialSyncBehavior, ok := secretSync.SyncOptions["initialSyncBehavior"].(string)
+			if !ok {
+				initialSyncBehavior = ""
+			}
+
+			disableSecretDeletion, ok := secretSync.SyncOptions["disableSecretDeletion"].(bool)
+			if !ok {
+				disableSecretDeletion = false
+			}
+
+			syncOptionsMap["initial_sync_behavior"] = types.StringValue(initialSyncBehavior)
+			syncOptionsMap["disable_secret_deletion"] = types.BoolValue(disableSecretDeletion)
+
+			keySchema, ok := secretSync.SyncOptions["keySchema"].(string)
+			if keySchema == "" || !ok {
+				syncOptionsMap["key_schema"] = types.StringNull()
+			} else {
+				syncOptionsMap["key_schema"] = types.StringValue(keySchema)
+			}
+
+			return types.ObjectValue(map[string]attr.Type{
+				"initial_sync_behavior":   types.StringType,
+				"disable_secret_deletion": types.BoolType,
+				"key_schema":              types.StringType,
+			}, syncOptionsMap)
+		},
+
+		ReadSyncOptionsForUpdateFromPlan: func(ctx context.Context, plan SecretSyncBaseResourceModel, state SecretSyncBaseResourceModel) (map[string]interface{}, diag.Diagnostics) {
+			syncOptionsMap := make(map[string]interface{})
+
+			var syncOptions SecretSyncVercelSyncOptionsModel
+			diags := plan.SyncOptions.As(ctx, &syncOptions, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			syncOptionsMap["initialSyncBehavior"] = syncOptions.InitialSyncBehavior.ValueString()
+			syncOptionsMap["disableSecretDeletion"] = syncOptions.DisableSecretDeletion.ValueBool()
+			syncOptionsMap["keySchema"] = syncOptions.KeySchema.ValueString()
+
+			return syncOptionsMap, nil
+		},
+
+		ReadDestinationConfigForCreateFromPlan: func(ctx context.Context, plan SecretSyncBaseResourceModel) (map[string]interface{}, diag.Diagnostics) {
+			destinationConfig := make(map[string]interface{})
+
+			var cfg SecretSyncVercelDestinationConfigModel
+			diags := plan.DestinationConfig.As(ctx, &cfg, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			rawVercelCfg := map[string]attr.Value{
+				"team_id":        cfg.TeamId,
+				"project_id":     cfg.ProjectId,
+				"environment":    cfg.Environment,
+				"preview_branch": cfg.PreviewBranch,
+			}
+
+			if !verifyVercelDestinationConfigState(rawVercelCfg, &diags) {
+				return nil, diags
+			}
+
+			destinationConfig["teamId"] = cfg.TeamId.ValueString()
+			destinationConfig["app"] = cfg.ProjectId.ValueString()
+			destinationConfig["env"] = cfg.Environment.ValueString()
+			if !cfg.PreviewBranch.IsNull() && !cfg.PreviewBranch.IsUnknown() {
+				destinationConfig["branch"] = cfg.PreviewBranch.ValueString()
+			}
+
+			return destinationConfig, diags
+		},
+		ReadDestinationConfigForUpdateFromPlan: func(ctx context.Context, plan SecretSyncBaseResourceModel, _ SecretSyncBaseResourceModel) (map[string]interface{}, diag.Diagnostics) {
+			destinationConfig := make(map[string]interface{})
+
+			var cfg SecretSyncVercelDestinationConfigModel
+			diags := plan.DestinationConfig.As(ctx, &cfg, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			rawVercelCfg := map[string]attr.Value{
+				"team_id":        cfg.TeamId,
+				"project_id":     cfg.ProjectId,
+				"environment":    cfg.Environment,
+				"preview_branch": cfg.PreviewBranch,
+			}
+
+			if !verifyVercelDestinationConfigState(rawVercelCfg, &diags) {
+				return nil, diags
+			}
+
+			destinationConfig["teamId"] = cfg.TeamId.ValueString()
+			destinationConfig["app"] = cfg.ProjectId.ValueString()
+			destinationConfig["env"] = cfg.Environment.ValueString()
+			if !cfg.PreviewBranch.IsNull() && !cfg.PreviewBranch.IsUnknown() {
+				destinationConfig["branch"] = cfg.PreviewBranch.ValueString()
+			}
+
+			return destinationConfig, diags
+		},
+		ReadDestinationConfigFromApi: func(ctx context.Context, secretSync infisical.SecretSync) (types.Object, diag.Diagnostics) {
+			var diags diag.Diagnostics
+
+			teamIdVal, ok := secretSync.DestinationConfig["teamId"].(string)
+			if !ok {
+				diags.AddError(
+					"Invalid type",
+					"Expected 'teamId' to be a string but got something else",
+				)
+				return types.ObjectNull(map[string]attr.Type{}), diags
+			}
+
+			appVal, ok := secretSync.DestinationConfig["app"].(string)
+			if !ok {
+				diags.AddError(
+					"Invalid type",
+					"Expected 'app' to be a string but got something else",
+				)
+				return types.ObjectNull(map[string]attr.Type{}), diags
+			}
+
+			envVal, ok := secretSync.DestinationConfig["env"].(string)
+			if !ok {
+				diags.AddError(
+					"Invalid type",
+					"Expected 'env' to be a string but got something else",
+				)
+				return types.ObjectNull(map[string]attr.Type{}), diags
+			}
+
+			destinationConfig := map[string]attr.Value{
+				"team_id":        types.StringValue(teamIdVal),
+				"project_id":     types.StringValue(appVal),
+				"environment":    types.StringValue(envVal),
+				"preview_branch": types.StringNull(),
+			}
+
+			if branchVal, ok := secretSync.DestinationConfig["branch"].(string); ok && branchVal != "" {
+				destinationConfig["preview_branch"] = types.StringValue(branchVal)
+			}
+
+			if !verifyVercelDestinationConfigState(destinationConfig, &diags) {
+				return types.ObjectNull(map[string]attr.Type{}), diags
+			}
+
+			return types.ObjectValue(map[string]attr.Type{
+				"team_id":        types.StringType,
+				"project_id":     types.StringType,
+				"environment":    types.StringType,
+				"preview_branch": types.StringType,
+			}, destinationConfig)
+		},
+	}
+}
@@ -0,0 +1,155 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	infisical "terraform-provider-infisical/internal/client"
+	"terraform-provider-infisical/internal/pkg/render"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource = &ephemeralSecretsBundleResource{}
+)
+
+func NewEphemeralSecretsBundleResource() ephemeral.EphemeralResourceWithConfigure {
+	return &ephemeralSecretsBundleResource{}
+}
+
+// ephemeralSecretsBundleResource is the resource implementation.
+type ephemeralSecretsBundleResource struct {
+	client *infisical.Client
+}
+
+type ephemeralSecretsBundleResourceModel struct {
+	FolderPath  types.String `tfsdk:"folder_path"`
+	EnvSlug     types.String `tfsdk:"env_slug"`
+	WorkspaceId types.String `tfsdk:"workspace_id"`
+	Format      types.String `tfsdk:"format"`
+	Template    types.String `tfsdk:"template"`
+	Content     types.String `tfsdk:"content"`
+}
+
+// Metadata returns the resource type name.
+func (r *ephemeralSecretsBundleResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secrets_bundle"
+}
+
+// Schema defines the schema for the resource.
+func (r *ephemeralSecretsBundleResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Render the secrets of a folder as a dotenv, JSON or YAML document, or through a Go template using the same functions as the Infisical agent, without storing the result in state",
+		Attributes: map[string]schema.Attribute{
+			"folder_path": schema.StringAttribute{
+				Description: "The path to the folder from where secrets should be fetched from. Defaults to the provider's `default_folder_path`.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"env_slug": schema.StringAttribute{
+				Description: "The environment from where secrets should be fetched from. Defaults to the provider's `default_environment`.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				Description: "The Infisical project ID (Required for Machine Identity auth, and service tokens with multiple scopes). Defaults to the provider's `default_project_id`.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"format": schema.StringAttribute{
+				Description: fmt.Sprintf("The output format. Possible values are: %s", strings.Join(render.Formats, ", ")),
+				Required:    true,
+				Validators:  []validator.String{stringvalidator.OneOf(render.Formats...)},
+			},
+			"template": schema.StringAttribute{
+				Description: "The Go text/template to render when format is `template`. The folder's secrets are passed as the template data, and the Infisical agent functions are available, for example `{{ range secret \"<project-id>\" \"<env>\" \"/path\" }}{{ .Key }}={{ .Value }}{{ end }}` and `{{ with getSecretByName \"<project-id>\" \"<env>\" \"/path\" \"NAME\" }}{{ .Value }}{{ end }}`.",
+				Optional:    true,
+			},
+			"content": schema.StringAttribute{
+				Description: "The rendered secrets",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ephemeralSecretsBundleResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*infisical.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *infisical.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ephemeralSecretsBundleResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Client not configured",
+			"The provider client is nil. Please report this issue to the Infisical provider developers.",
+		)
+		return
+	}
+
+	// Read configuration from the request
+	var config ephemeralSecretsBundleResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	infisicaltf.ApplyProviderDefault(&config.FolderPath, r.client.Config.DefaultFolderPath, path.Root("folder_path"), "default_folder_path", &resp.Diagnostics)
+	infisicaltf.ApplyProviderDefault(&config.EnvSlug, r.client.Config.DefaultEnvironment, path.Root("env_slug"), "default_environment", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// service tokens imply their project, so the project is only looked up with machine identities
+	if config.WorkspaceId.IsNull() && r.client.Config.DefaultProjectId != "" {
+		config.WorkspaceId = types.StringValue(r.client.Config.DefaultProjectId)
+	}
+
+	if config.Format.ValueString() == render.FormatTemplate && config.Template.ValueString() == "" {
+		resp.Diagnostics.AddError(
+			"Missing template",
+			"The template attribute must be set when format is template",
+		)
+		return
+	}
+
+	content, err := render.NewRenderer(render.ClientFetcher(ctx, r.client)).Render(
+		config.Format.ValueString(),
+		config.WorkspaceId.ValueString(),
+		config.EnvSlug.ValueString(),
+		config.FolderPath.ValueString(),
+		config.Template.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error rendering Infisical secrets",
+			"Could not render the secrets of folder "+config.FolderPath.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	config.Content = types.StringValue(content)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, config)...)
+}
@@ -0,0 +1,102 @@
+package resource
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	infisical "terraform-provider-infisical/internal/client"
+	"terraform-provider-infisical/internal/crypto"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestEphemeralSecretsBundleRendersWithAServiceToken(t *testing.T) {
+	const (
+		tokenKey     = "0123456789abcdef0123456789abcdef"
+		workspaceKey = "fedcba9876543210fedcba9876543210"
+	)
+
+	encrypt := func(plaintext string, key string) crypto.SymmetricEncryptionResult {
+		t.Helper()
+		result, err := crypto.EncryptSymmetric([]byte(plaintext), []byte(key))
+		if err != nil {
+			t.Fatalf("encrypting %q: %v", plaintext, err)
+		}
+		return result
+	}
+	encoded := base64.StdEncoding.EncodeToString
+
+	encryptedWorkspaceKey := encrypt(workspaceKey, tokenKey)
+	secretKey := encrypt("DB_URL", workspaceKey)
+	secretValue := encrypt("postgres://db", workspaceKey)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v2/service-token":
+			_ = json.NewEncoder(w).Encode(infisical.GetServiceTokenDetailsResponse{
+				Workspace:    "project-id",
+				EncryptedKey: encoded(encryptedWorkspaceKey.CipherText),
+				Iv:           encoded(encryptedWorkspaceKey.Nonce),
+				Tag:          encoded(encryptedWorkspaceKey.AuthTag),
+			})
+		case "/api/v3/secrets":
+			if got := r.URL.Query().Get("environment"); got != "dev" {
+				t.Errorf("environment = %q, want dev", got)
+			}
+			_ = json.NewEncoder(w).Encode(infisical.GetEncryptedSecretsV3Response{Secrets: []infisical.EncryptedSecretV3{{
+				Type:                  "shared",
+				SecretKeyCiphertext:   encoded(secretKey.CipherText),
+				SecretKeyIV:           encoded(secretKey.Nonce),
+				SecretKeyTag:          encoded(secretKey.AuthTag),
+				SecretValueCiphertext: encoded(secretValue.CipherText),
+				SecretValueIV:         encoded(secretValue.Nonce),
+				SecretValueTag:        encoded(secretValue.AuthTag),
+			}}})
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	r := &ephemeralSecretsBundleResource{client: &infisical.Client{Config: infisical.Config{
+		HttpClient:   resty.New().SetBaseURL(server.URL),
+		AuthStrategy: infisical.AuthStrategy.SERVICE_TOKEN,
+		ServiceToken: "st.token-id.token-secret." + tokenKey,
+	}}}
+
+	ctx := context.Background()
+	var schemaResp ephemeral.SchemaResponse
+	r.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResp)
+	schemaType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range schemaType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	values["folder_path"] = tftypes.NewValue(tftypes.String, "/")
+	values["env_slug"] = tftypes.NewValue(tftypes.String, "dev")
+	values["format"] = tftypes.NewValue(tftypes.String, "dotenv")
+	config := tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaType, values)}
+
+	resp := ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaType, nil)}}
+	r.Open(ctx, ephemeral.OpenRequest{Config: config}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var content types.String
+	resp.Diagnostics.Append(resp.Result.GetAttribute(ctx, path.Root("content"), &content)...)
+	if want := "DB_URL=\"postgres://db\"\n"; content.ValueString() != want {
+		t.Errorf("content = %q, want %q", content.ValueString(), want)
+	}
+}
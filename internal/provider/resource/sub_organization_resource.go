@@ -2,6 +2,7 @@ package resource
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	infisical "terraform-provider-infisical/internal/client"
@@ -119,7 +120,7 @@ func (r *subOrganizationResource) Create(ctx context.Context, req resource.Creat
 		createRequest.Slug = plan.Slug.ValueString()
 	}
 
-	newSubOrg, err := r.client.CreateSubOrganization(createRequest)
+	newSubOrg, err := r.client.CreateSubOrganization(ctx, createRequest)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating sub-organization",
@@ -154,10 +155,9 @@ func (r *subOrganizationResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	subOrg, err := r.client.GetSubOrganizationById(state.ID.ValueString())
+	subOrg, err := r.client.GetSubOrganizationById(ctx, state.ID.ValueString())
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
 		resp.Diagnostics.AddError(
@@ -200,7 +200,7 @@ func (r *subOrganizationResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	updatedSubOrg, err := r.client.UpdateSubOrganization(infisical.UpdateSubOrganizationRequest{
+	updatedSubOrg, err := r.client.UpdateSubOrganization(ctx, infisical.UpdateSubOrganizationRequest{
 		SubOrgID: state.ID.ValueString(),
 		Name:     plan.Name.ValueString(),
 		Slug:     plan.Slug.ValueString(),
@@ -239,12 +239,12 @@ func (r *subOrganizationResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
-	_, err := r.client.DeleteSubOrganization(infisical.DeleteSubOrganizationRequest{
+	_, err := r.client.DeleteSubOrganization(ctx, infisical.DeleteSubOrganizationRequest{
 		SubOrgID: state.ID.ValueString(),
 	})
 	if err != nil {
-		_, getErr := r.client.GetSubOrganizationById(state.ID.ValueString())
-		if getErr == infisical.ErrNotFound {
+		_, getErr := r.client.GetSubOrganizationById(ctx, state.ID.ValueString())
+		if errors.Is(getErr, infisical.ErrNotFound) {
 			return
 		}
 
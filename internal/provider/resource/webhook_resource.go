@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	infisicaltf "terraform-provider-infisical/internal/pkg/terraform"
 
 	infisical "terraform-provider-infisical/internal/client"
 
@@ -217,7 +218,7 @@ func (r *webhookResource) Create(ctx context.Context, req resource.CreateRequest
 		createRequest.WebhookSecretKey = plan.WebhookSecretKey.ValueString()
 	}
 
-	webhook, err := r.client.CreateWebhook(createRequest)
+	webhook, err := r.client.CreateWebhook(ctx, createRequest)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating webhook",
@@ -251,7 +252,7 @@ func (r *webhookResource) Create(ctx context.Context, req resource.CreateRequest
 	// Create always provisions the webhook enabled; honor a requested disabled state with a follow-up update.
 	if !desiredDisabled.IsNull() && !desiredDisabled.IsUnknown() && desiredDisabled.ValueBool() != webhook.Webhook.IsDisabled {
 		isDisabled := desiredDisabled.ValueBool()
-		updated, err := r.client.UpdateWebhook(infisical.UpdateWebhookRequest{
+		updated, err := r.client.UpdateWebhook(ctx, infisical.UpdateWebhookRequest{
 			ID:         webhook.Webhook.ID,
 			IsDisabled: &isDisabled,
 		})
@@ -274,12 +275,11 @@ func (r *webhookResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	webhook, err := r.client.GetWebhookByID(infisical.GetWebhookByIDRequest{
+	webhook, err := r.client.GetWebhookByID(ctx, infisical.GetWebhookByIDRequest{
 		ID: state.ID.ValueString(),
 	})
 	if err != nil {
-		if err == infisical.ErrNotFound {
-			resp.State.RemoveResource(ctx)
+		if infisicaltf.RemoveResourceIfNotFound(ctx, err, resp) {
 			return
 		}
 		resp.Diagnostics.AddError(
@@ -339,7 +339,7 @@ func (r *webhookResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	updated, err := r.client.UpdateWebhook(updateRequest)
+	updated, err := r.client.UpdateWebhook(ctx, updateRequest)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating webhook",
@@ -368,7 +368,7 @@ func (r *webhookResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	_, err := r.client.DeleteWebhook(infisical.DeleteWebhookRequest{
+	_, err := r.client.DeleteWebhook(ctx, infisical.DeleteWebhookRequest{
 		ID: state.ID.ValueString(),
 	})
 	if err != nil {
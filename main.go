@@ -7,10 +7,13 @@ import (
 	"context"
 	"flag"
 	"log"
+	"time"
 
+	"terraform-provider-infisical/internal/pkg/tracing"
 	"terraform-provider-infisical/internal/provider"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
 )
 
 // Run "go generate" to format example terraform files and generate the docs for the registry/website
@@ -41,13 +44,32 @@ func main() {
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	opts := providerserver.ServeOpts{
-		// TODO: Update this string with the published name of your provider.
-		Address: "registry.terraform.io/infisical/infisical",
-		Debug:   debug,
+	// Tracing is enabled by the standard OTEL_EXPORTER_OTLP_* environment variables.
+	shutdownTracing, err := tracing.Setup(context.Background(), version)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	var serveOpts []tf6server.ServeOpt
+	if debug {
+		serveOpts = append(serveOpts, tf6server.WithManagedDebug())
 	}
 
-	err := providerserver.Serve(context.Background(), provider.New(version), opts)
+	serverFunc := providerserver.NewProtocol6(provider.New(version)())
+	if tracing.Enabled() {
+		serverFunc = tracing.WrapProviderServer(serverFunc)
+	}
+
+	// TODO: Update this string with the published name of your provider.
+	err = tf6server.Serve("registry.terraform.io/infisical/infisical", serverFunc, serveOpts...)
+
+	// Terraform stops the provider shortly after asking it to exit, so the pending spans get a
+	// bounded time to be flushed.
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+	if shutdownErr := shutdownTracing(shutdownCtx); shutdownErr != nil {
+		log.Printf("unable to flush the traces: %s", shutdownErr)
+	}
+	cancel()
 
 	if err != nil {
 		log.Fatal(err.Error())
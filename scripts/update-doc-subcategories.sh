@@ -112,7 +112,7 @@ for file in "$DOCS_DIR/data-sources/"*.md; do
     
     case "$filename" in
         # Secrets
-        secrets|secret_folders|secret_tag|secret_metadata)
+        secrets|secrets_bundle|secret_folders|secret_tag|secret_metadata)
             update_subcategory "$file" "Secrets";;
         
         # Groups
@@ -148,7 +148,7 @@ for file in "$DOCS_DIR/ephemeral-resources/"*.md; do
     
     case "$filename" in
         # Secrets
-        secret)
+        secret|secrets_bundle)
             update_subcategory "$file" "Secrets";;
     esac
 done